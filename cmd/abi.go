@@ -16,8 +16,6 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
 	"github.com/spf13/cobra"
 )
 
@@ -31,17 +29,10 @@ var abiCmd = &cobra.Command{
 
 In quiet mode this will return 0 if the name has an ABI, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		// Obtain the resolver for this name
-		resolverAddress, err := ens.Resolver(registryContract, args[0])
-		cli.ErrCheck(err, quiet, "No resolver for that name")
-
-		// Set the address to which we resolve
-		resolverContract, err := ens.ResolverContractByAddress(client, resolverAddress)
-		cli.ErrCheck(err, quiet, "Failed to obtain resolver contract")
-
-		// Fetch the ABI
-		abi, err := ens.Abi(resolverContract, args[0])
-		cli.ErrCheck(err, quiet, "Failed to obtain ABI")
+		// Fetch the ABI, from a wildcard resolver of a parent if the name
+		// has no resolver of its own
+		abi, err := mgr.ABI(runCtx, args[0])
+		errCheck(err, "Failed to obtain ABI")
 		if !quiet {
 			fmt.Println(string(abi))
 		}
@@ -14,13 +14,8 @@
 package cmd
 
 import (
-	"bytes"
 	"fmt"
-	"math/big"
 
-	etherutils "github.com/orinocopay/go-etherutils"
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -40,39 +35,12 @@ The keystore for the account that owns the name must be local (i.e. listed with
 
 In quiet mode this will return 0 if the transaction to set the address is sent successfully, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		// Ensure that the name is in a suitable state
-		cli.Assert(inState(args[0], "Owned"), quiet, "Domain not in a suitable state to set an address")
-
-		// Fetch the owner of the name
-		owner, err := registryContract.Owner(nil, ens.NameHash(args[0]))
-		cli.ErrCheck(err, quiet, "Cannot obtain owner")
-		cli.Assert(bytes.Compare(owner.Bytes(), ens.UnknownAddress.Bytes()) != 0, quiet, "Owner is not set")
-
-		// Fetch the wallet and account for the owner
-		wallet, account, err := obtainWalletAndAccount(owner, passphrase)
-		cli.ErrCheck(err, quiet, "Failed to obtain account details for the owner of the name")
-
-		gasPrice, err := etherutils.StringToWei(gasPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid gas price")
-
-		// Obtain the resolver for this name
-		resolverAddress, err := ens.Resolver(registryContract, args[0])
-		cli.ErrCheck(err, quiet, "No resolver for that name")
-
-		// Set the address to which we resolve
-		resolverContract, err := ens.ResolverContractByAddress(client, resolverAddress)
-		cli.ErrCheck(err, quiet, "Failed to obtain resolver contract")
-		session := ens.CreateResolverSession(chainID, &wallet, account, passphrase, resolverContract, gasPrice)
-		if nonce != -1 {
-			session.TransactOpts.Nonce = big.NewInt(nonce)
-		}
-
-		var contentType = big.NewInt(1)
-		if abiSetCompressed {
-			contentType = big.NewInt(2)
-		}
-		tx, err := ens.SetAbi(session, args[0], abiSetAbi, contentType)
-		cli.ErrCheck(err, quiet, "Failed to set ABI for that name")
+		// Set the ABI, from the owner of the name or, for wrapped names,
+		// its owner in the name wrapper
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+		tx, err := mgr.SetABI(runCtx, args[0], abiSetAbi, abiSetCompressed, opts)
+		errCheck(err, "Failed to set ABI for that name")
 		if !quiet {
 			fmt.Println("Transaction ID is", tx.Hash().Hex())
 		}
@@ -16,8 +16,6 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
 	"github.com/spf13/cobra"
 )
 
@@ -29,10 +27,12 @@ var addressCmd = &cobra.Command{
 
 	ens address enstest.eth
 
+Names without a resolver of their own are looked up with the resolver of their closest parent that has one, if that resolver supports wildcard resolution (ENSIP-10).  Resolvers can ask for answers to be fetched from an off-chain gateway (EIP-3668).  Gateways can be restricted to a list of hosts with the 'ccip-gateways' configuration key, for example ['gateway.example.com', '*.example.org', 'localhost:8080'], and the number of lookups for a single query is limited to the 'ccip-recursion' configuration key, 4 by default; 0 refuses off-chain lookups.
+
 In quiet mode this will return 0 if the name resolves correctly, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		address, err := ens.Resolve(client, args[0])
-		cli.ErrCheck(err, quiet, "Failed to obtain address")
+		address, err := resolveName(args[0])
+		errCheck(err, "Failed to obtain address")
 		if !quiet {
 			fmt.Println(address.Hex())
 		}
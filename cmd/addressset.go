@@ -14,13 +14,8 @@
 package cmd
 
 import (
-	"bytes"
 	"fmt"
-	"math/big"
 
-	etherutils "github.com/orinocopay/go-etherutils"
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -39,39 +34,14 @@ The keystore for the account that owns the name must be local (i.e. listed with
 
 In quiet mode this will return 0 if the transaction to set the address is sent successfully, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		// Ensure that the name is in a suitable state
-		cli.Assert(inState(args[0], "Owned"), quiet, "Domain not in a suitable state to set an address")
-
-		// Fetch the owner of the name
-		owner, err := registryContract.Owner(nil, ens.NameHash(args[0]))
-		cli.ErrCheck(err, quiet, "Cannot obtain owner")
-		cli.Assert(bytes.Compare(owner.Bytes(), ens.UnknownAddress.Bytes()) != 0, quiet, "Owner is not set")
-
-		// Fetch the wallet and account for the owner
-		wallet, account, err := obtainWalletAndAccount(owner, passphrase)
-		cli.ErrCheck(err, quiet, "Failed to obtain account details for the owner of the name")
-
-		gasPrice, err := etherutils.StringToWei(gasPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid gas price")
-
-		// Obtain the resolver for this name
-		resolverAddress, err := ens.Resolver(registryContract, args[0])
-		cli.ErrCheck(err, quiet, "No resolver for that name")
-
 		// Obtain the address to which we resolve
-		resolutionAddress, err := ens.Resolve(client, addressSetAddressStr)
-		cli.ErrCheck(err, quiet, "Invalid address")
-
-		// Set the address to which we resolve
-		resolverContract, err := ens.ResolverContractByAddress(client, resolverAddress)
-		cli.ErrCheck(err, quiet, "Failed to obtain resolver contract")
-		session := ens.CreateResolverSession(chainID, &wallet, account, passphrase, resolverContract, gasPrice)
-		if nonce != -1 {
-			session.TransactOpts.Nonce = big.NewInt(nonce)
-		}
+		resolutionAddress, err := resolveName(addressSetAddressStr)
+		errCheck(err, "Invalid address")
 
-		tx, err := ens.SetResolution(session, args[0], &resolutionAddress)
-		cli.ErrCheck(err, quiet, "Failed to set resolution for that name")
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+		tx, err := mgr.SetAddress(runCtx, args[0], resolutionAddress, opts)
+		errCheck(err, "Failed to set resolution for that name")
 		if !quiet {
 			fmt.Println("Transaction ID is", tx.Hash().Hex())
 		}
@@ -79,14 +49,12 @@ In quiet mode this will return 0 if the transaction to set the address is sent s
 			"networkid": chainID,
 			"name":      args[0],
 			"address":   resolutionAddress.Hex()}).Info("Address set")
-
 	},
 }
 
 func init() {
 	addressCmd.AddCommand(addressSetCmd)
 
-	addressSetCmd.Flags().StringVarP(&passphrase, "passphrase", "p", "", "Passphrase for the account that owns the name")
 	addressSetCmd.Flags().StringVarP(&addressSetAddressStr, "address", "a", "", "Address to set for the name")
-	addressSetCmd.Flags().StringVarP(&gasPriceStr, "gasprice", "g", "4 GWei", "Gas price for the transaction")
+	addTransactionFlags(addressSetCmd, "Passphrase for the account that owns the name")
 }
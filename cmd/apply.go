@@ -0,0 +1,301 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	etherutils "github.com/orinocopay/go-etherutils"
+	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/go-etherutils/ens/resolvercontract"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var applyAccountStr string
+var applyYes bool
+
+// manifestEntry is the desired state of a single name in a manifest
+type manifestEntry struct {
+	Name     string            `mapstructure:"name" json:"name" yaml:"name"`
+	Owner    string            `mapstructure:"owner" json:"owner,omitempty" yaml:"owner,omitempty"`
+	Resolver string            `mapstructure:"resolver" json:"resolver,omitempty" yaml:"resolver,omitempty"`
+	TTL      string            `mapstructure:"ttl" json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	Address  string            `mapstructure:"address" json:"address,omitempty" yaml:"address,omitempty"`
+	Content  string            `mapstructure:"content" json:"content,omitempty" yaml:"content,omitempty"`
+	ABI      string            `mapstructure:"abi" json:"abi,omitempty" yaml:"abi,omitempty"`
+	Text     map[string]string `mapstructure:"text" json:"text,omitempty" yaml:"text,omitempty"`
+}
+
+// applyCmd represents the apply command
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Apply a manifest of ENS names",
+	Long: `Bring names registered with the Ethereum Name Service (ENS) in to line with a manifest.  For example:
+
+    ens apply --passphrase="my secret passphrase" manifest.yaml
+
+The manifest lists the account that sends the transactions and the desired owner, resolver, TTL, address, content hash, ABI and text records of each name.  For example:
+
+    account: 0x5FfC014343cd971B7eb70732021E26C35B744cc4
+    names:
+      - name: sub1.enstest.eth
+        owner: 0x5FfC014343cd971B7eb70732021E26C35B744cc4
+        resolver: public
+        address: 0x90f8bf6a479f320ead074411a4b0e7944ea8c9c1
+      - name: sub2.enstest.eth
+        owner: 0x90f8bf6a479f320ead074411a4b0e7944ea8c9c1
+        resolver: public
+        text:
+          url: https://www.example.com/
+
+Any item that is not supplied is left as-is.  To change a name that the account does not own but whose parent it does, the account takes ownership of the name and returns it to its original owner afterwards; both transactions are listed with the others.  A resolver of 'public' is the public resolver for the network.  The transactions required to bring the names in to line with the manifest are displayed and, once confirmed, sent with sequential nonces.  Later transactions can depend on earlier ones, so gas is not estimated; each transaction's gas limit is sized to the data that it stores, or given with --gaslimit.
+
+The keystore for the account must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
+
+In quiet mode this will return 0 if all transactions are sent successfully, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		applyManifest(args[0], applyAccountStr, applyYes)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(applyCmd)
+
+	applyCmd.Flags().StringVarP(&applyAccountStr, "account", "a", "", "Account from which to send transactions (overrides the manifest)")
+	applyCmd.Flags().BoolVarP(&applyYes, "yes", "y", false, "Send transactions without asking for confirmation")
+	applyCmd.Flags().Int64Var(&planGasLimitOverride, "gaslimit", 0, "Gas limit for each transaction (default is sized to the data that the transaction stores)")
+	addTransactionFlags(applyCmd, "Passphrase for the account that sends the transactions")
+}
+
+// applyManifest brings names in to line with the manifest in the given file
+func applyManifest(path string, accountStr string, yes bool) {
+	assert(!quiet || yes, "Quiet mode requires --yes")
+
+	manifest := viper.New()
+	manifest.SetConfigFile(path)
+	err := manifest.ReadInConfig()
+	errCheck(err, "Failed to read manifest")
+
+	if accountStr == "" {
+		accountStr = manifest.GetString("account")
+	}
+	if accountStr == "" {
+		accountStr = defaultAccount
+	}
+	assert(accountStr != "", "Account from which to send transactions is required")
+	account, err := resolveName(accountStr)
+	errCheck(err, "Invalid account")
+
+	var entries []*manifestEntry
+	err = manifest.UnmarshalKey("names", &entries)
+	errCheck(err, "Failed to parse names in manifest")
+
+	plan := newPlan(account)
+	for _, entry := range entries {
+		assert(entry.Name != "", "Manifest entry without a name")
+		err = planManifestEntry(plan, entry)
+		errCheck(err, fmt.Sprintf("Failed to plan changes for %s", entry.Name))
+	}
+	err = plan.finish()
+	errCheck(err, "Failed to plan return of ownership")
+
+	if !quiet {
+		plan.print()
+	}
+	if len(plan.operations) == 0 {
+		return
+	}
+	if !yes && !plan.confirm() {
+		fail("Cancelled")
+	}
+
+	gasPrice, err := etherutils.StringToWei(gasPriceStr)
+	errCheck(err, "Invalid gas price")
+	err = plan.execute(passphrase, gasPrice)
+	errCheck(err, "Failed to send transactions")
+}
+
+// planManifestEntry adds the operations required to bring a name in to line
+// with its manifest entry
+func planManifestEntry(plan *plan, entry *manifestEntry) error {
+	name := ensName(entry.Name)
+
+	var owner common.Address
+	if entry.Owner != "" {
+		var err error
+		owner, err = resolveName(entry.Owner)
+		if err != nil {
+			return fmt.Errorf("invalid owner %s", entry.Owner)
+		}
+	}
+
+	if entry.Resolver != "" {
+		var resolver common.Address
+		var err error
+		if entry.Resolver == "public" {
+			resolver, err = publicResolver()
+			if err != nil {
+				return fmt.Errorf("no public resolver for this network")
+			}
+		} else {
+			resolver, err = resolveName(entry.Resolver)
+			if err != nil {
+				return fmt.Errorf("invalid resolver %s", entry.Resolver)
+			}
+		}
+		currentResolver, err := plan.resolver(name)
+		if err != nil {
+			return err
+		}
+		if currentResolver != resolver {
+			if err = plan.setResolver(name, resolver); err != nil {
+				return err
+			}
+		}
+	}
+
+	if entry.Address != "" {
+		address, err := resolveName(entry.Address)
+		if err != nil {
+			return fmt.Errorf("invalid address %s", entry.Address)
+		}
+		currentAddress, err := resolvedAddress(plan, name)
+		if err != nil {
+			return err
+		}
+		if currentAddress != address {
+			if err = plan.setAddress(name, address); err != nil {
+				return err
+			}
+		}
+	}
+
+	if entry.TTL != "" {
+		ttl, err := strconv.ParseUint(entry.TTL, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid TTL %s", entry.TTL)
+		}
+		currentTTL, err := registryContract.Ttl(callOpts(), ens.NameHash(name))
+		if err != nil {
+			return err
+		}
+		if currentTTL != ttl {
+			if err = plan.setTTL(name, ttl); err != nil {
+				return err
+			}
+		}
+	}
+
+	if entry.Content != "" || entry.ABI != "" || len(entry.Text) > 0 {
+		if err := planManifestRecords(plan, name, entry); err != nil {
+			return err
+		}
+	}
+
+	// Owner is set last, as it may remove our ability to make other changes
+	if entry.Owner != "" {
+		currentOwner, err := plan.owner(name)
+		if err != nil {
+			return err
+		}
+		if currentOwner != owner {
+			if err = plan.setOwner(name, owner); err != nil {
+				return err
+			}
+		}
+		plan.keep(name)
+	}
+
+	return nil
+}
+
+// planManifestRecords adds the operations required to bring the content
+// hash, ABI and text records of a name in to line with its manifest entry
+func planManifestRecords(plan *plan, name string, entry *manifestEntry) error {
+	resolverAddress, err := plan.resolver(name)
+	if err != nil {
+		return err
+	}
+	if resolverAddress == ens.UnknownAddress {
+		return fmt.Errorf("cannot set records of %s: no resolver", name)
+	}
+	records, err := newResolverRecords(resolverAddress)
+	if err != nil {
+		return err
+	}
+	nameHash := ens.NameHash(name)
+
+	if entry.Content != "" {
+		content := common.HexToHash(entry.Content)
+		currentContent, err := records.Content(callOpts(), nameHash)
+		if err != nil || common.Hash(currentContent) != content {
+			if err = plan.setContent(name, content); err != nil {
+				return err
+			}
+		}
+	}
+
+	if entry.ABI != "" {
+		_, currentABI, err := records.ABI(callOpts(), nameHash, big.NewInt(1))
+		if err != nil || string(currentABI) != entry.ABI {
+			if err = plan.setABI(name, entry.ABI); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Sort the keys so that the plan is stable
+	keys := make([]string, 0, len(entry.Text))
+	for key := range entry.Text {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		currentValue, err := records.Text(callOpts(), nameHash, key)
+		if err != nil || currentValue != entry.Text[key] {
+			if err = plan.setText(name, key, entry.Text[key]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolvedAddress obtains the address of a name from the resolver that the
+// name will have once the plan has been carried out
+func resolvedAddress(plan *plan, name string) (common.Address, error) {
+	resolverAddress, err := plan.resolver(name)
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	if resolverAddress == ens.UnknownAddress {
+		return ens.UnknownAddress, nil
+	}
+	resolverContract, err := resolvercontract.NewResolverContract(resolverAddress, mgr.Backend())
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	address, err := resolverContract.Addr(callOpts(), ens.NameHash(name))
+	if err != nil {
+		// Resolver does not support addresses
+		return ens.UnknownAddress, nil
+	}
+	return address, nil
+}
@@ -0,0 +1,138 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/orinocopay/go-etherutils/ens"
+)
+
+// revealPeriod is the final part of an auction in which bids are revealed,
+// and biddingPeriod the part before it in which bids are placed
+var revealPeriod = 48 * time.Hour
+var biddingPeriod = 3 * 24 * time.Hour
+
+// expectInfo checks that the information for a name contains the given text
+func expectInfo(t *testing.T, c *simulatedChain, name string, text string) {
+	t.Helper()
+	if output := c.run(t, "info", name); !strings.Contains(output, text) {
+		t.Fatalf("info for %s does not contain %q: %s", name, text, output)
+	}
+}
+
+// expectController checks the controller of a name
+func expectController(t *testing.T, c *simulatedChain, name string, controller common.Address) {
+	t.Helper()
+	if value := lastLine(c.run(t, "owner", "--controller", name)); value != controller.Hex() {
+		t.Fatalf("controller of %s is %s, expected %s", name, value, controller.Hex())
+	}
+}
+
+// win wins the auction for a name that is open for bids, moving the chain
+// through the bidding and reveal periods
+func win(t *testing.T, c *simulatedChain, name string) {
+	t.Helper()
+	address := "--address=" + c.account.Hex()
+	c.transactWith(t, "auction", "bid", address, "--bid=0.1 Ether", "--mask=0.5 Ether", "--salt=simulated salt", name)
+	expectInfo(t, c, name, "Bidding until")
+
+	c.travel(t, biddingPeriod+time.Hour)
+	expectInfo(t, c, name, "Revealing until")
+	c.transactWith(t, "auction", "reveal", address, "--bid=0.1 Ether", "--salt=simulated salt", name)
+
+	c.travel(t, revealPeriod)
+	expectInfo(t, c, name, "Won since")
+	c.transactWith(t, "auction", "finish", name)
+	expectInfo(t, c, name, "Owned since")
+	expectController(t, c, name, c.account)
+}
+
+// TestAuction runs an auction for a name from start to finish, then sets up
+// its records and a subdomain
+func TestAuction(t *testing.T) {
+	contracts := contractsFor(t, "HashRegistrar")
+	c := newSimulatedChain(t)
+	output := c.deploy(t, "auction", contracts)
+	name := "simulatedtest.eth"
+
+	// Names shorter than the minimum cannot be auctioned
+	expectInfo(t, c, "short.eth", "Unavailable due to name length restrictions")
+
+	c.transactWith(t, "auction", "start", "--address="+c.account.Hex(), "--bid=0", name)
+	win(t, c, name)
+
+	c.transactWith(t, "resolver", "set", "--address=", name)
+	if value := lastLine(c.run(t, "resolver", name)); value != outputValue(t, output, "Public resolver is") {
+		t.Errorf("resolver is %s, expected the public resolver", value)
+	}
+	c.transactWith(t, "address", "set", "--address="+c.account.Hex(), name)
+	if value := lastLine(c.run(t, "address", name)); value != c.account.Hex() {
+		t.Errorf("address is %s, expected %s", value, c.account.Hex())
+	}
+
+	subdomainOwner := common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4")
+	c.transactWith(t, "subdomain", "owner", "--owner="+subdomainOwner.Hex(), "sub."+name)
+	expectController(t, c, "sub."+name, subdomainOwner)
+}
+
+// TestTransfer wins a name and transfers it, which moves both the deed and
+// control of the name to the new owner
+func TestTransfer(t *testing.T) {
+	contracts := contractsFor(t, "HashRegistrar")
+	c := newSimulatedChain(t)
+	c.deploy(t, "auction", contracts)
+	name := "simulatedtransfer.eth"
+
+	c.transactWith(t, "auction", "start", "--address="+c.account.Hex(), "--bid=0", name)
+	win(t, c, name)
+
+	newOwner := common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4")
+	c.transactWith(t, "transfer", "--address="+newOwner.Hex(), name)
+	expectController(t, c, name, newOwner)
+	expectInfo(t, c, name, "Deed owner is "+newOwner.Hex())
+
+	// The name can no longer be transferred by the account
+	if _, err := c.runErr(t, "transfer", "--address="+c.account.Hex(), "--passphrase="+simulatedPassphrase, name); err == nil {
+		t.Error("transfer by the previous owner succeeded")
+	}
+}
+
+// TestInvalidate wins a name that is shorter than the minimum length, which
+// the registrar allows but the command refuses to start, and invalidates it
+func TestInvalidate(t *testing.T) {
+	contracts := contractsFor(t, "HashRegistrar")
+	c := newSimulatedChain(t)
+	output := c.deploy(t, "auction", contracts)
+	name := "short.eth"
+
+	registrarABI, _, err := (&deployer{}).artifact("HashRegistrar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registrar := bind.NewBoundContract(common.HexToAddress(outputValue(t, output, "Registrar is")), registrarABI, c.backend, c.backend)
+	c.transact(t, "start auction", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return registrar.Transact(opts, "startAuction", ens.LabelHash("short"))
+	})
+	win(t, c, name)
+
+	c.transactWith(t, "invalidate", "--address="+c.account.Hex(), name)
+	expectController(t, c, name, ens.UnknownAddress)
+	expectInfo(t, c, name, "Unavailable due to name length restrictions")
+}
@@ -17,9 +17,8 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/orinocopay/ens/manager"
 	etherutils "github.com/orinocopay/go-etherutils"
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -41,42 +40,36 @@ The keystore for the address must be local (i.e. listed with 'get accounts list'
 
 In quiet mode this will return 0 if the transaction to place the bid is sent successfully, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		cli.Assert(auctionBidSalt != "", quiet, "Salt is required")
-		cli.Assert(auctionBidAddressStr != "", quiet, "Address from which to send the bid is required")
-
-		// Ensure that the name is in a suitable state
-		cli.Assert(inState(args[0], "Bidding"), quiet, "Domain not in a suitable state to bid on an auction")
+		// Default to the account from the network profile
+		if auctionBidAddressStr == "" {
+			auctionBidAddressStr = defaultAccount
+		}
 
-		// Fetch the wallet and account for the owner
-		auctionBidAddress, err := ens.Resolve(client, auctionBidAddressStr)
-		cli.ErrCheck(err, quiet, "Failed to obtain auction address")
-		wallet, account, err := obtainWalletAndAccount(auctionBidAddress, passphrase)
-		cli.ErrCheck(err, quiet, "Failed to obtain account details for the owner of the name")
+		assert(auctionBidSalt != "", "Salt is required")
+		assert(auctionBidAddressStr != "", "Address from which to send the bid is required")
 
-		gasPrice, err := etherutils.StringToWei(gasPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid gas price")
-
-		// Set up our session
-		session := ens.CreateRegistrarSession(chainID, &wallet, account, passphrase, registrarContract, gasPrice)
-		if nonce != -1 {
-			session.TransactOpts.Nonce = big.NewInt(nonce)
-		}
+		auctionBidAddress, err := resolveName(auctionBidAddressStr)
+		errCheck(err, "Failed to obtain auction address")
 
+		// Create the bid
 		bidPrice, err := etherutils.StringToWei(auctionBidBidPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid bid price")
-		// Start the auction
+		errCheck(err, "Invalid bid price")
 		bidMask, err := etherutils.StringToWei(auctionBidMaskPriceStr)
-		if err != nil {
-			bidMask = big.NewInt(0)
-			bidMask.Set(bidPrice)
-		} else if bidMask.Cmp(bidPrice) == -1 {
-			bidMask.Set(bidPrice)
+		if err != nil || bidMask.Cmp(bidPrice) == -1 {
+			bidMask = new(big.Int).Set(bidPrice)
+		}
+		bid := &manager.Bid{
+			Owner:  auctionBidAddress,
+			Amount: bidPrice,
+			Mask:   bidMask,
+			Salt:   auctionBidSalt,
 		}
 
-		session.TransactOpts.Value = bidMask
-		tx, err := ens.NewBid(session, args[0], &auctionBidAddress, *bidPrice, auctionBidSalt)
-		session.TransactOpts.Value = big.NewInt(0)
-		cli.ErrCheck(err, quiet, "Failed to send transaction")
+		// Place the bid
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+		tx, err := mgr.PlaceBid(runCtx, args[0], bid, opts)
+		errCheck(err, "Failed to place bid")
 		if !quiet {
 			fmt.Println("Transaction ID is", tx.Hash().Hex())
 		}
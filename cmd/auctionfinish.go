@@ -14,13 +14,8 @@
 package cmd
 
 import (
-	"bytes"
 	"fmt"
-	"math/big"
 
-	etherutils "github.com/orinocopay/go-etherutils"
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -38,41 +33,11 @@ The keystore for the address must be local (i.e. listed with 'get accounts list'
 In quiet mode this will return 0 if the transaction to finish the auction is sent successfully, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
 
-		// Ensure that the name is in a suitable state
-		cli.Assert(inState(args[0], "Won"), quiet, "Domain not in a suitable state to finish the auction")
-
-		// Fetch the owner of the name - must be 0 if this auction has not been finalised
-		owner, err := registryContract.Owner(nil, ens.NameHash(args[0]))
-		cli.ErrCheck(err, quiet, "Cannot obtain owner")
-		cli.Assert(bytes.Compare(owner.Bytes(), ens.UnknownAddress.Bytes()) == 0, quiet, "Auction already finished")
-
-		_, deedAddress, _, _, _, err := ens.Entry(registrarContract, client, args[0])
-		cli.ErrCheck(err, quiet, "Cannot obtain information for that auction")
-
-		// Fetch the owner of the deed that won the address
-		// Deed
-		deedContract, err := ens.DeedContract(client, &deedAddress)
-		cli.ErrCheck(err, quiet, "Failed to obtain deed contract")
-		// Deed owner
-		deedOwner, err := deedContract.Owner(nil)
-		cli.ErrCheck(err, quiet, "Failed to obtain deed owner")
-
-		// Fetch the wallet and account for the owner
-		wallet, account, err := obtainWalletAndAccount(deedOwner, passphrase)
-		cli.ErrCheck(err, quiet, "Failed to obtain account details for the owner of the name")
-
-		gasPrice, err := etherutils.StringToWei(gasPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid gas price")
-
-		// Set up our session
-		session := ens.CreateRegistrarSession(chainID, &wallet, account, passphrase, registrarContract, gasPrice)
-		if nonce != -1 {
-			session.TransactOpts.Nonce = big.NewInt(nonce)
-		}
-
-		// Finish the bid
-		tx, err := ens.FinishAuction(session, args[0])
-		cli.ErrCheck(err, quiet, "Failed to send transaction")
+		// Finish the auction, from the owner of the winning deed
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+		tx, err := mgr.FinishAuction(runCtx, args[0], opts)
+		errCheck(err, "Failed to finish auction")
 		if !quiet {
 			fmt.Println("Transaction ID is", tx.Hash().Hex())
 		}
@@ -15,11 +15,9 @@ package cmd
 
 import (
 	"fmt"
-	"math/big"
 
+	"github.com/orinocopay/ens/manager"
 	etherutils "github.com/orinocopay/go-etherutils"
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -40,32 +38,29 @@ The keystore for the address must be local (i.e. listed with 'get accounts list'
 
 In quiet mode this will return 0 if the transaction to reveal the bid is sent successfully, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		cli.Assert(auctionRevealSalt != "", quiet, "Salt is required")
-
-		// Ensure that the name is in a suitable state
-		cli.Assert(inState(args[0], "Revealing"), quiet, "Domain not in a suitable state to reveal a bid")
+		// Default to the account from the network profile
+		if auctionRevealAddressStr == "" {
+			auctionRevealAddressStr = defaultAccount
+		}
 
-		// Fetch the wallet and account for the address
-		auctionRevealAddress, err := ens.Resolve(client, auctionRevealAddressStr)
-		cli.ErrCheck(err, quiet, "Failed to obtain auction address")
-		wallet, account, err := obtainWalletAndAccount(auctionRevealAddress, passphrase)
-		cli.ErrCheck(err, quiet, "Failed to obtain account details for the owner of the name")
+		assert(auctionRevealSalt != "", "Salt is required")
 
-		gasPrice, err := etherutils.StringToWei(gasPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid gas price")
-
-		// Set up our session
-		session := ens.CreateRegistrarSession(chainID, &wallet, account, passphrase, registrarContract, gasPrice)
-		if nonce != -1 {
-			session.TransactOpts.Nonce = big.NewInt(nonce)
-		}
+		auctionRevealAddress, err := resolveName(auctionRevealAddressStr)
+		errCheck(err, "Failed to obtain auction address")
 
 		bidPrice, err := etherutils.StringToWei(auctionRevealBidPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid bid price")
+		errCheck(err, "Invalid bid price")
+		bid := &manager.Bid{
+			Owner:  auctionRevealAddress,
+			Amount: bidPrice,
+			Salt:   auctionRevealSalt,
+		}
 
 		// Reveal the bid
-		tx, err := ens.RevealBid(session, args[0], &auctionRevealAddress, *bidPrice, auctionRevealSalt)
-		cli.ErrCheck(err, quiet, "Failed to send transaction")
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+		tx, err := mgr.RevealBid(runCtx, args[0], bid, opts)
+		errCheck(err, "Failed to reveal bid")
 		if !quiet {
 			fmt.Println("Transaction ID is", tx.Hash().Hex())
 		}
@@ -16,12 +16,9 @@ package cmd
 import (
 	"fmt"
 	"math/big"
-	"strings"
 
-	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/orinocopay/ens/manager"
 	etherutils "github.com/orinocopay/go-etherutils"
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -44,51 +41,39 @@ The keystore for the address must be local (i.e. listed with 'get accounts list'
 
 In quiet mode this will return 0 if the transaction to start the auction is sent successfully, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		cli.Assert(auctionStartAddressStr != "", quiet, "Address from which to start the auction is required")
-		cli.Assert(len(args[0]) > 10, quiet, "Name must be at least 7 characters long")
-		cli.Assert(len(strings.Split(args[0], ".")) == 2, quiet, "Name must not contain . (except for ending in .eth)")
+		// Default to the account from the network profile
+		if auctionStartAddressStr == "" {
+			auctionStartAddressStr = defaultAccount
+		}
+		assert(auctionStartAddressStr != "", "Address from which to start the auction is required")
 
-		// Ensure that the name is in a suitable state
-		cli.Assert(inState(args[0], "Available"), quiet, "Domain not in a suitable state to start an auction")
+		auctionStartAddress, err := resolveName(auctionStartAddressStr)
+		errCheck(err, "Failed to obtain auction address")
 
 		// Create the bid
-
-		// Fetch the wallet and account for the address
-		auctionStartAddress, err := ens.Resolve(client, auctionStartAddressStr)
-		cli.ErrCheck(err, quiet, "Failed to obtain auction address")
-		wallet, account, err := obtainWalletAndAccount(auctionStartAddress, passphrase)
-		cli.ErrCheck(err, quiet, "Failed to obtain an account for the address")
-
-		gasPrice, err := etherutils.StringToWei(gasPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid gas price")
-
-		// Set up our session
-		session := ens.CreateRegistrarSession(chainID, &wallet, account, passphrase, registrarContract, gasPrice)
-		if nonce != -1 {
-			session.TransactOpts.Nonce = big.NewInt(nonce)
-		}
-
 		bidPrice, err := etherutils.StringToWei(auctionStartBidPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid bid price")
-		// Start the auction
+		errCheck(err, "Invalid bid price")
 		bidMask, err := etherutils.StringToWei(auctionStartMaskPriceStr)
-		if err != nil {
-			bidMask = big.NewInt(0)
-			bidMask.Set(bidPrice)
-		} else if bidMask.Cmp(bidPrice) == -1 {
-			bidMask.Set(bidPrice)
+		if err != nil || bidMask.Cmp(bidPrice) == -1 {
+			bidMask = new(big.Int).Set(bidPrice)
 		}
-
-		var tx *types.Transaction
-		if bidPrice.Cmp(zero) == 0 {
-			tx, err = ens.StartAuction(session, args[0])
-		} else {
-			cli.Assert(auctionStartSalt != "", quiet, "Salt is required")
-			session.TransactOpts.Value = bidMask
-			tx, err = ens.StartAuctionAndBid(session, args[0], &auctionStartAddress, *bidPrice, auctionStartSalt, auctionStartDummies)
-			session.TransactOpts.Value = big.NewInt(0)
+		var bid *manager.Bid
+		if bidPrice.Cmp(zero) != 0 {
+			assert(auctionStartSalt != "", "Salt is required")
+			bid = &manager.Bid{
+				Owner:   auctionStartAddress,
+				Amount:  bidPrice,
+				Mask:    bidMask,
+				Salt:    auctionStartSalt,
+				Dummies: auctionStartDummies,
+			}
 		}
-		cli.ErrCheck(err, quiet, "Failed to send transaction")
+
+		// Start the auction
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+		tx, err := mgr.StartAuction(runCtx, args[0], auctionStartAddress, bid, opts)
+		errCheck(err, "Failed to start auction")
 		if !quiet {
 			fmt.Println("Transaction ID is", tx.Hash().Hex())
 		}
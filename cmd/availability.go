@@ -14,14 +14,40 @@
 package cmd
 
 import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+	"unicode/utf8"
 
-	"github.com/orinocopay/go-etherutils/cli"
+	"github.com/orinocopay/ens/manager"
 	"github.com/orinocopay/go-etherutils/ens"
 	"github.com/spf13/cobra"
 )
 
+var availabilityFile string
+var availabilityWorkers int
+var availabilityFormat string
+
+// availabilityResult is the availability of a single name
+type availabilityResult struct {
+	Name          string     `json:"name"`
+	State         string     `json:"state,omitempty"`
+	LengthAllowed bool       `json:"lengthallowed"`
+	BiddingEnds   *time.Time `json:"biddingends,omitempty"`
+	// Expiry and GracePeriodEnd are only present with the permanent
+	// registrar
+	Expiry         *time.Time `json:"expiry,omitempty"`
+	GracePeriodEnd *time.Time `json:"graceperiodend,omitempty"`
+	Error          string     `json:"error,omitempty"`
+}
+
 // availabilityCmd represents the availability command
 var availabilityCmd = &cobra.Command{
 	Use:   "availability",
@@ -30,42 +56,33 @@ var availabilityCmd = &cobra.Command{
 
     ens availability enstest.eth
 
-In quiet mode this will return 0 if the domain is availabile, otherwise 1.`,
+Multiple names can be checked at once by supplying a file containing one name per line, or '-' to read the names from standard input.  For example:
+
+    ens availability --file=names.txt --format=csv
+
+In quiet mode this will return 0 if the domain is availabile, otherwise 1.  When checking multiple names this will return 0 if all of the domains are available, otherwise 1.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
-		registrarContract, err := ens.RegistrarContract(client)
-		cli.ErrCheck(err, quiet, "Failed to obtain registrar contract")
-		if ens.DomainLevel(args[0]) == 1 {
-			// Top-level domain
-			state, err := ens.State(registrarContract, client, args[0])
-			cli.ErrCheck(err, quiet, "Cannot obtain info")
-			if quiet {
-				if state == "Available" {
-					os.Exit(0)
-				} else {
-					os.Exit(1)
-				}
+		if availabilityFile != "" {
+			bulkAvailability()
+			return
+		}
+
+		result := availability(args[0])
+		if result.Error != "" {
+			fail(result.Error)
+		}
+		if quiet {
+			if result.State == "Available" {
+				exit(0)
 			} else {
-				fmt.Println(state)
+				exit(1)
 			}
 		} else {
-			// Subdomain
-			registry, err := ens.RegistryContract(client)
-			cli.ErrCheck(err, quiet, "Failed to obtain registry contract")
-			subdomainOwnerAddress, err := registry.Owner(nil, ens.NameHash(args[0]))
-			cli.ErrCheck(err, quiet, "Failed to obtain subdomain owner")
-			if quiet {
-				if subdomainOwnerAddress == ens.UnknownAddress {
-					os.Exit(0)
-				} else {
-					os.Exit(1)
-				}
-			} else {
-				if subdomainOwnerAddress == ens.UnknownAddress {
-					fmt.Println("Available")
-				} else {
-					fmt.Println("Owned")
-				}
+			fmt.Println(result.State)
+			if result.Expiry != nil {
+				fmt.Println("Expires", *result.Expiry)
+				fmt.Println("Grace period ends", *result.GracePeriodEnd)
 			}
 		}
 	},
@@ -73,4 +90,202 @@ In quiet mode this will return 0 if the domain is availabile, otherwise 1.`,
 
 func init() {
 	RootCmd.AddCommand(availabilityCmd)
+
+	availabilityCmd.Flags().StringVarP(&availabilityFile, "file", "f", "", "File containing names to check, one per line ('-' for standard input)")
+	availabilityCmd.Flags().IntVarP(&availabilityWorkers, "workers", "w", 10, "Number of names to check concurrently")
+	availabilityCmd.Flags().StringVar(&availabilityFormat, "format", "text", "Output format when checking multiple names (text, csv or json)")
+}
+
+// availability obtains the availability of a single name
+func availability(name string) *availabilityResult {
+	result := &availabilityResult{
+		Name:          name,
+		LengthAllowed: true,
+	}
+
+	if manager.IsETH2LD(name) {
+		// Name directly under 'eth'
+		result.LengthAllowed = utf8.RuneCountInString(strings.TrimSuffix(name, ".eth")) >= mgr.MinimumNameLength()
+		state, err := mgr.State(runCtx, name)
+		if err != nil {
+			result.Error = "Cannot obtain info"
+			return result
+		}
+		result.State = state
+		if mgr.Permanent() {
+			if state != "Available" {
+				expiry, err := mgr.Expiry(runCtx, name)
+				if err != nil {
+					result.Error = "Cannot obtain expiry"
+					return result
+				}
+				gracePeriodEnd := expiry.Add(mgr.GracePeriod())
+				result.Expiry = &expiry
+				result.GracePeriodEnd = &gracePeriodEnd
+			}
+			return result
+		}
+		if state == "Bidding" || state == "Revealing" {
+			_, _, registrationDate, _, _, err := mgr.Entry(runCtx, name)
+			if err != nil {
+				result.Error = "Cannot obtain auction status"
+				return result
+			}
+			biddingEnds := registrationDate.Add(time.Duration(-48) * time.Hour)
+			result.BiddingEnds = &biddingEnds
+		}
+	} else {
+		// Subdomain, or a name under another top-level domain
+		subdomainOwnerAddress, err := registryContract.Owner(callOpts(), ens.NameHash(name))
+		if err != nil {
+			result.Error = "Failed to obtain subdomain owner"
+			return result
+		}
+		if subdomainOwnerAddress == ens.UnknownAddress {
+			result.State = "Available"
+		} else {
+			result.State = "Owned"
+		}
+	}
+	return result
+}
+
+// bulkAvailability checks the availability of all names in the supplied file
+func bulkAvailability() {
+	assert(availabilityWorkers > 0, "Number of workers must be at least 1")
+	assert(availabilityFormat == "text" || availabilityFormat == "csv" || availabilityFormat == "json", "Format must be one of text, csv or json")
+
+	var input io.Reader
+	if availabilityFile == "-" {
+		input = os.Stdin
+	} else {
+		f, err := os.Open(availabilityFile)
+		errCheck(err, "Failed to open names file")
+		defer f.Close()
+		input = f
+	}
+	names, err := readNames(input)
+	errCheck(err, "Failed to read names")
+
+	// Check the names concurrently, keeping the results in input order
+	checked := make([]*availabilityResult, len(names))
+	var checkedMutex sync.Mutex
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < availabilityWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indices {
+				var result *availabilityResult
+				if name, err := fullName(names[index]); err != nil {
+					result = &availabilityResult{Name: names[index], Error: fmt.Sprintf("Invalid name: %v", err)}
+				} else {
+					result = availability(name)
+				}
+				checkedMutex.Lock()
+				checked[index] = result
+				checkedMutex.Unlock()
+			}
+		}()
+	}
+	for i := range names {
+		select {
+		case indices <- i:
+		case <-runCtx.Done():
+		}
+		if cancelled() {
+			break
+		}
+	}
+	close(indices)
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-runCtx.Done():
+	}
+
+	// If the run was cancelled then only the names checked so far are output
+	checkedMutex.Lock()
+	results := make([]*availabilityResult, 0, len(names))
+	for _, result := range checked {
+		if result != nil {
+			results = append(results, result)
+		}
+	}
+	checkedMutex.Unlock()
+	if cancelled() {
+		defer exitCancelled()
+	}
+
+	if quiet {
+		if cancelled() {
+			exitCancelled()
+		}
+		for _, result := range results {
+			if result.State != "Available" {
+				exit(1)
+			}
+		}
+		exit(0)
+	}
+
+	switch availabilityFormat {
+	case "csv":
+		writer := csv.NewWriter(os.Stdout)
+		writer.Write([]string{"name", "state", "lengthallowed", "biddingends", "expiry", "graceperiodend", "error"})
+		for _, result := range results {
+			writer.Write([]string{result.Name, result.State, fmt.Sprintf("%t", result.LengthAllowed), csvTime(result.BiddingEnds), csvTime(result.Expiry), csvTime(result.GracePeriodEnd), result.Error})
+		}
+		writer.Flush()
+		errCheck(writer.Error(), "Failed to write output")
+	case "json":
+		output, err := json.MarshalIndent(results, "", "  ")
+		errCheck(err, "Failed to generate output")
+		fmt.Println(string(output))
+	default:
+		writer := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		for _, result := range results {
+			switch {
+			case result.Error != "":
+				fmt.Fprintf(writer, "%s\t%s\n", result.Name, result.Error)
+			case !result.LengthAllowed:
+				fmt.Fprintf(writer, "%s\t%s\tunavailable due to name length restrictions\n", result.Name, result.State)
+			case result.Expiry != nil:
+				fmt.Fprintf(writer, "%s\t%s\texpires %v, grace period ends %v\n", result.Name, result.State, *result.Expiry, *result.GracePeriodEnd)
+			case result.BiddingEnds != nil:
+				fmt.Fprintf(writer, "%s\t%s\tbidding until %v\n", result.Name, result.State, *result.BiddingEnds)
+			default:
+				fmt.Fprintf(writer, "%s\t%s\n", result.Name, result.State)
+			}
+		}
+		writer.Flush()
+	}
+}
+
+// csvTime formats an optional time for CSV output
+func csvTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// readNames reads names one per line, ignoring blank lines and comments.
+// Names are returned as given
+func readNames(input io.Reader) ([]string, error) {
+	names := make([]string, 0)
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" || strings.HasPrefix(name, "#") {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, scanner.Err()
 }
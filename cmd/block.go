@@ -0,0 +1,56 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// blockStr is the block at which to carry out lookups
+var blockStr string
+
+// queryBlock parses the block at which to carry out lookups, which can be a
+// number, a hash, 'latest' or 'pending'.  It returns the number of the block,
+// or nil for the pending block
+func queryBlock(input string) (*big.Int, error) {
+	switch {
+	case input == "pending":
+		return nil, nil
+	case input == "latest":
+		header, err := chain.HeaderByNumber(runCtx, nil)
+		if err != nil {
+			return nil, err
+		}
+		return header.Number, nil
+	case strings.HasPrefix(input, "0x") && len(input) == 66:
+		header, err := chain.HeaderByHash(runCtx, common.HexToHash(input))
+		if err != nil {
+			return nil, fmt.Errorf("unknown block %s: %v", input, err)
+		}
+		return header.Number, nil
+	default:
+		number, ok := new(big.Int).SetString(input, 10)
+		if !ok || number.Sign() < 0 {
+			return nil, fmt.Errorf("invalid block %s", input)
+		}
+		if _, err := chain.HeaderByNumber(runCtx, number); err != nil {
+			return nil, fmt.Errorf("unknown block %s: %v", input, err)
+		}
+		return number, nil
+	}
+}
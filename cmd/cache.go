@@ -0,0 +1,52 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"path/filepath"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/orinocopay/ens/manager"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+var useCache bool
+
+// cacheBackend is the lookup cache, if in use
+var cacheBackend *manager.CacheBackend
+
+// cacheFile returns the path of the cache file
+func cacheFile() string {
+	if path := viper.GetString("cachefile"); path != "" {
+		return path
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		return ".ens-cache.json"
+	}
+	return filepath.Join(home, ".ens-cache.json")
+}
+
+// saveCache saves the lookup cache, if in use, once the command has ended
+// for any reason.  Failure to save the cache does not affect the result of
+// the command
+func saveCache() {
+	if cacheBackend == nil {
+		return
+	}
+	if err := cacheBackend.Save(); err != nil {
+		log.WithFields(log.Fields{"error": err}).Warn("Failed to save cache")
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+)
+
+// contentHashCmd represents the contenthash command
+var contentHashCmd = &cobra.Command{
+	Use:   "contenthash",
+	Short: "Obtain the content hash of an ENS name",
+	Long: `Obtain the content hash of a name registered with the Ethereum Name Service (ENS), as set out in EIP-1577.  For example:
+
+    ens contenthash enstest.eth
+
+In quiet mode this will return 0 if the name has a content hash, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		hash, err := mgr.ContentHash(runCtx, args[0])
+		errCheck(err, "Failed to obtain content hash")
+		if quiet {
+			if len(hash) == 0 {
+				exit(1)
+			}
+			exit(0)
+		}
+		fmt.Println(hexutil.Encode(hash))
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(contentHashCmd)
+}
@@ -0,0 +1,110 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/orinocopay/ens/manager"
+	log "github.com/sirupsen/logrus"
+)
+
+// runCtx is the context for all calls made during this run.  It is cancelled
+// when the timeout expires or the user interrupts the command
+var runCtx = context.Background()
+var cancelRun context.CancelFunc = func() {}
+
+// timeout is the maximum time for a command to run
+var timeout time.Duration
+
+// runTimer cancels the run when the timeout expires, at runDeadline
+var runTimer *time.Timer
+var runDeadline time.Time
+
+// timedOut is set to 1 when the run is cancelled by the timeout
+var timedOut int32
+
+// startRun sets up the context for this run.  The first interrupt cancels the
+// context; a second interrupt exits immediately
+func startRun() {
+	runCtx, cancelRun = context.WithCancel(context.Background())
+	if timeout > 0 {
+		runDeadline = time.Now().Add(timeout)
+		runTimer = time.AfterFunc(timeout, expireRun)
+	}
+
+	interrupts := make(chan os.Signal, 2)
+	signal.Notify(interrupts, os.Interrupt)
+	go func() {
+		<-interrupts
+		cancelRun()
+		<-interrupts
+		os.Exit(1)
+	}()
+}
+
+// expireRun cancels the run as its timeout has expired
+func expireRun() {
+	atomic.StoreInt32(&timedOut, 1)
+	cancelRun()
+}
+
+// pauseTimeout stops the timeout while waiting for the user, returning a
+// function that restarts it with the time that remained
+func pauseTimeout() func() {
+	if runTimer == nil || !runTimer.Stop() {
+		return func() {}
+	}
+	remaining := runDeadline.Sub(time.Now())
+	return func() {
+		runDeadline = time.Now().Add(remaining)
+		runTimer = time.AfterFunc(remaining, expireRun)
+	}
+}
+
+// cancelled returns true if the run has been cancelled or timed out
+func cancelled() bool {
+	return runCtx.Err() != nil
+}
+
+// exitCancelled ends the command with the reason that the run was cancelled
+func exitCancelled() {
+	reason := "Cancelled"
+	if atomic.LoadInt32(&timedOut) == 1 {
+		reason = fmt.Sprintf("Timed out after %v", timeout)
+	}
+	log.WithFields(log.Fields{"reason": reason}).Warn("Run cancelled")
+	fail(reason)
+}
+
+// callOpts returns the options for a contract call
+func callOpts() *bind.CallOpts {
+	return &bind.CallOpts{Context: runCtx}
+}
+
+// prepareTransaction sets the context of a transaction and its nonce from
+// the local nonce manager
+func prepareTransaction(opts *bind.TransactOpts) error {
+	if readOnly {
+		return manager.ErrReadOnly
+	}
+	opts.Context = runCtx
+	return setNonce(opts)
+}
@@ -0,0 +1,75 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/orinocopay/ens/manager"
+	"github.com/orinocopay/go-etherutils/ens"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var controllerSetAddressStr string
+
+// controllerSetCmd represents the controller set command
+var controllerSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Set the controller of an ENS name",
+	Long: `Set the controller of a name registered with the Ethereum Name Service (ENS), which owns the name in the registry and so manages its records.  For example:
+
+    ens controller set --address=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --passphrase="my secret passphrase" enstest.eth
+
+For a name directly under 'eth' with the permanent registrar the registrant reclaims control of the name from the registrar, so after a transfer the new registrant can take control; the address defaults to the registrant.  For other names the controller is set by the current controller, and the address is required.  Wrapped names are controlled by their owner in the name wrapper, so are moved with 'ens transfer' instead.
+
+The keystore for the registrant or current controller must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
+
+In quiet mode this will return 0 if the transaction to set the controller is sent successfully, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if manager.IsETH2LD(args[0]) {
+			assert(inState(args[0], "Owned"), "Name not in a suitable state to set the controller")
+		}
+
+		var controllerAddress = ens.UnknownAddress
+		var err error
+		if controllerSetAddressStr != "" {
+			controllerAddress, err = resolveName(controllerSetAddressStr)
+			errCheck(err, "Invalid controller address")
+		} else {
+			assert(manager.IsETH2LD(args[0]) && mgr.Permanent(), "Address of the new controller is required")
+			controllerAddress, err = mgr.Registrant(runCtx, args[0])
+			errCheck(err, "Cannot obtain registrant")
+		}
+
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+		tx, err := mgr.SetOwner(runCtx, args[0], controllerAddress, opts)
+		errCheck(err, "Failed to set controller")
+		if !quiet {
+			fmt.Println("Transaction ID is", tx.Hash().Hex())
+		}
+		log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+			"name":       args[0],
+			"networkid":  chainID,
+			"controller": controllerAddress.Hex()}).Info("Set controller")
+	},
+}
+
+func init() {
+	controllerCmd.AddCommand(controllerSetCmd)
+
+	controllerSetCmd.Flags().StringVarP(&controllerSetAddressStr, "address", "a", "", "Address of the new controller (default is the registrant)")
+	addTransactionFlags(controllerSetCmd, "Passphrase for the registrant or current controller of the name")
+}
@@ -0,0 +1,348 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/ens/contract"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/orinocopay/ens/manager"
+	etherutils "github.com/orinocopay/go-etherutils"
+	"github.com/orinocopay/go-etherutils/ens"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var deployAccountStr string
+var deployRegistrar string
+var deployContracts string
+var deployProfile string
+
+// deployTimeout is the time to wait for each deployment transaction to be mined
+var deployTimeout = 5 * time.Minute
+
+// releasePeriod is the period over which the auction registrar releases names.
+// Auction registrars are started this far in the past so that all names are
+// available immediately
+var releasePeriod = 8 * 7 * 24 * time.Hour
+
+// deployCmd represents the deploy command
+var deployCmd = &cobra.Command{
+	Use:   "deploy",
+	Short: "Deploy ENS contracts",
+	Long: `Deploy a complete set of Ethereum Name Service (ENS) contracts to the chain at --connection, for use on private and test networks.  For example:
+
+    ens deploy --connection=http://localhost:8545/ --account=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --passphrase="my secret passphrase" --profile=local
+
+This deploys a registry, a registrar for 'eth', a public resolver and a reverse registrar, and sets up the 'eth', 'resolver.eth', 'reverse' and 'addr.reverse' nodes.  The registrar is either a first-in-first-served registrar ('fifs') or an auction registrar ('auction').  Auction registrars release all names immediately.
+
+Compiled contracts are read from the directory given with --contracts, which should contain the output of 'solc --abi --bin' for ENSRegistry, FIFSRegistrar, HashRegistrar, PublicResolver and ReverseRegistrar.  If the directory does not contain a registry, first-in-first-served registrar or public resolver then the versions built in to go-ethereum are used instead; note that these do not support TTLs or text, ABI or name records.  The auction registrar and reverse registrar must be supplied; if there is no reverse registrar then 'addr.reverse' is left owned by the account.
+
+The resulting registry, registrar, public resolver and reverse registrar are written to the named profile in the configuration file, from where the deployment can be used by all other commands with --network.
+
+The keystore for the account must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
+
+In quiet mode this will return 0 if the contracts are deployed successfully, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if deployAccountStr == "" {
+			deployAccountStr = defaultAccount
+		}
+		assert(deployAccountStr != "", "Account from which to deploy is required")
+		address, err := manager.ParseAddress(deployAccountStr)
+		errCheck(err, "Account must be an address")
+		assert(deployRegistrar == "fifs" || deployRegistrar == "auction", "Registrar must be 'fifs' or 'auction'")
+
+		wallet, account, err := obtainWalletAndAccount(address, passphrase)
+		errCheck(err, "Failed to obtain account details for the address")
+
+		gasPrice, err := etherutils.StringToWei(gasPriceStr)
+		errCheck(err, "Invalid gas price")
+
+		d := newDeployer(wallet, account, passphrase, gasPrice)
+		if deployRegistrar == "auction" {
+			// Nothing is deployed unless the whole set can be
+			_, _, err = d.artifact("HashRegistrar")
+			assert(err != errNoArtifact, "The auction registrar requires a compiled HashRegistrar in --contracts")
+			errCheck(err, "Failed to read the auction registrar")
+		}
+
+		// Registry, owned by the account until set up
+		registry, err := d.deploy("ENSRegistry", nil, func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+			address, tx, _, err := contract.DeployENS(opts, chain, account.Address)
+			return address, tx, err
+		})
+		errCheck(err, "Failed to deploy registry")
+		ensRegistry, err := contract.NewENS(registry, chain)
+		errCheck(err, "Failed to obtain registry contract")
+
+		// Public resolver
+		resolver, err := d.deploy("PublicResolver", []interface{}{registry}, func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+			address, tx, _, err := contract.DeployPublicResolver(opts, chain, registry)
+			return address, tx, err
+		})
+		errCheck(err, "Failed to deploy public resolver")
+		resolverContract, err := contract.NewPublicResolver(resolver, chain)
+		errCheck(err, "Failed to obtain public resolver contract")
+
+		// Registrar
+		ethNode := ens.NameHash("eth")
+		var registrar common.Address
+		if deployRegistrar == "auction" {
+			startDate := big.NewInt(time.Now().Add(-releasePeriod).Unix())
+			registrar, err = d.deploy("HashRegistrar", []interface{}{registry, ethNode, startDate}, nil)
+		} else {
+			registrar, err = d.deploy("FIFSRegistrar", []interface{}{registry, ethNode}, func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+				address, tx, _, err := contract.DeployFIFSRegistrar(opts, chain, registry, ethNode)
+				return address, tx, err
+			})
+		}
+		errCheck(err, "Failed to deploy registrar")
+
+		// 'resolver.eth' is set up before 'eth' is handed to the registrar
+		rootNode := [32]byte{}
+		err = d.transact("Set owner of eth to account", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return ensRegistry.SetSubnodeOwner(opts, rootNode, ens.LabelHash("eth"), account.Address)
+		})
+		errCheck(err, "Failed to set up eth")
+		err = d.transact("Set owner of resolver.eth to account", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return ensRegistry.SetSubnodeOwner(opts, ethNode, ens.LabelHash("resolver"), account.Address)
+		})
+		errCheck(err, "Failed to set up resolver.eth")
+		err = d.transact("Set resolver of resolver.eth to public resolver", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return ensRegistry.SetResolver(opts, ens.NameHash("resolver.eth"), resolver)
+		})
+		errCheck(err, "Failed to set up resolver.eth")
+		err = d.transact("Set address of resolver.eth to public resolver", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return resolverContract.SetAddr(opts, ens.NameHash("resolver.eth"), resolver)
+		})
+		errCheck(err, "Failed to set up resolver.eth")
+		err = d.transact("Set owner of eth to registrar", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return ensRegistry.SetSubnodeOwner(opts, rootNode, ens.LabelHash("eth"), registrar)
+		})
+		errCheck(err, "Failed to set up eth")
+
+		// Reverse registrar
+		err = d.transact("Set owner of reverse to account", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return ensRegistry.SetSubnodeOwner(opts, rootNode, ens.LabelHash("reverse"), account.Address)
+		})
+		errCheck(err, "Failed to set up reverse")
+		reverseOwner := account.Address
+		reverseRegistrarAddress, err := d.deploy("ReverseRegistrar", []interface{}{registry, resolver}, nil)
+		if err == errNoArtifact {
+			if !quiet {
+				fmt.Fprintln(os.Stderr, "No compiled reverse registrar; addr.reverse is owned by the account")
+			}
+			log.WithFields(log.Fields{"account": account.Address.Hex()}).Warn("No reverse registrar deployed")
+		} else {
+			errCheck(err, "Failed to deploy reverse registrar")
+			reverseOwner = reverseRegistrarAddress
+		}
+		err = d.transact("Set owner of addr.reverse", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return ensRegistry.SetSubnodeOwner(opts, ens.NameHash("reverse"), ens.LabelHash("addr"), reverseOwner)
+		})
+		errCheck(err, "Failed to set up addr.reverse")
+
+		// Confirm that the nodes are wired up
+		owner, err := ensRegistry.Owner(callOpts(), ethNode)
+		errCheck(err, "Failed to obtain owner of eth")
+		assert(owner == registrar, "eth is not owned by the registrar")
+		owner, err = ensRegistry.Owner(callOpts(), ens.NameHash("addr.reverse"))
+		errCheck(err, "Failed to obtain owner of addr.reverse")
+		assert(owner == reverseOwner, "addr.reverse is not owned by the reverse registrar")
+
+		if !quiet {
+			fmt.Println("Registry is", registry.Hex())
+			fmt.Println("Registrar is", registrar.Hex())
+			fmt.Println("Public resolver is", resolver.Hex())
+			if reverseOwner != account.Address {
+				fmt.Println("Reverse registrar is", reverseRegistrarAddress.Hex())
+			}
+		}
+
+		if deployProfile != "" {
+			profile := &networkProfile{
+				Connection: connection,
+				ChainID:    chainID.Int64(),
+				Registry:   registry.Hex(),
+				Registrar:  registrar.Hex(),
+				Resolver:   resolver.Hex(),
+				Account:    account.Address.Hex(),
+			}
+			if reverseOwner != account.Address {
+				profile.ReverseRegistrar = reverseRegistrarAddress.Hex()
+			}
+			path, err := saveNetworkProfile(deployProfile, profile)
+			errCheck(err, "Failed to write network profile")
+			if !quiet {
+				fmt.Printf("Network profile %s written to %s\n", deployProfile, path)
+			}
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(deployCmd)
+
+	deployCmd.Flags().StringVarP(&deployAccountStr, "account", "a", "", "Account from which to deploy the contracts")
+	deployCmd.Flags().StringVar(&deployRegistrar, "registrar", "fifs", "Registrar for 'eth': 'fifs' or 'auction'")
+	deployCmd.Flags().StringVar(&deployContracts, "contracts", "", "Directory containing compiled contracts")
+	deployCmd.Flags().StringVar(&deployProfile, "profile", "", "Network profile to which to write the deployment")
+	addTransactionFlags(deployCmd, "Passphrase for the account that deploys the contracts")
+}
+
+// errNoArtifact is returned when a contract is neither compiled nor built in
+var errNoArtifact = fmt.Errorf("no compiled contract")
+
+// deployer sends the transactions for a deployment, waiting for each to be
+// mined before moving on to the next
+type deployer struct {
+	opts bind.TransactOpts
+}
+
+func newDeployer(wallet accounts.Wallet, account *accounts.Account, passphrase string, gasPrice *big.Int) *deployer {
+	return &deployer{
+		opts: bind.TransactOpts{
+			From: account.Address,
+			Signer: func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				return wallet.SignTxWithPassphrase(*account, passphrase, tx, chainID)
+			},
+			GasPrice: gasPrice,
+		},
+	}
+}
+
+// artifact loads a compiled contract from the contracts directory.  If the
+// contract is not present then this returns errNoArtifact
+func (d *deployer) artifact(name string) (abi.ABI, []byte, error) {
+	if deployContracts == "" {
+		return abi.ABI{}, nil, errNoArtifact
+	}
+	abiData, err := ioutil.ReadFile(filepath.Join(deployContracts, name+".abi"))
+	if os.IsNotExist(err) {
+		return abi.ABI{}, nil, errNoArtifact
+	}
+	if err != nil {
+		return abi.ABI{}, nil, err
+	}
+	parsed, err := abi.JSON(strings.NewReader(string(abiData)))
+	if err != nil {
+		return abi.ABI{}, nil, fmt.Errorf("invalid ABI for %s: %v", name, err)
+	}
+	binData, err := ioutil.ReadFile(filepath.Join(deployContracts, name+".bin"))
+	if err != nil {
+		return abi.ABI{}, nil, err
+	}
+	return parsed, common.FromHex(strings.TrimSpace(string(binData))), nil
+}
+
+// deploy deploys the named contract from the contracts directory with the
+// given constructor parameters, falling back to the built-in version if
+// supplied
+func (d *deployer) deploy(name string, params []interface{}, builtin func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error)) (common.Address, error) {
+	parsed, bytecode, err := d.artifact(name)
+	if err == errNoArtifact && builtin != nil {
+		err = nil
+	}
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	opts, err := d.transactOpts()
+	if err != nil {
+		return common.Address{}, err
+	}
+	var tx *types.Transaction
+	if bytecode != nil {
+		_, tx, _, err = bind.DeployContract(opts, parsed, bytecode, chain, params...)
+	} else {
+		_, tx, err = builtin(opts)
+	}
+	if err != nil {
+		return common.Address{}, err
+	}
+	noteTransaction(opts.From, tx)
+
+	receipt, err := d.wait(tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	address := receipt.ContractAddress
+	if !quiet {
+		fmt.Printf("Deployed %s at %s\n", name, address.Hex())
+	}
+	log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+		"networkid": chainID,
+		"contract":  name,
+		"address":   address.Hex()}).Info("Deploy contract")
+	return address, nil
+}
+
+// transact sends a transaction and waits for it to be mined
+func (d *deployer) transact(description string, send func(opts *bind.TransactOpts) (*types.Transaction, error)) error {
+	opts, err := d.transactOpts()
+	if err != nil {
+		return err
+	}
+	tx, err := send(opts)
+	if err != nil {
+		return err
+	}
+	noteTransaction(opts.From, tx)
+
+	if _, err = d.wait(tx); err != nil {
+		return err
+	}
+	if !quiet {
+		fmt.Println(description)
+	}
+	log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+		"networkid": chainID,
+		"operation": description}).Info("Deploy operation")
+	return nil
+}
+
+// wait waits for a transaction to be mined, returning an error if it failed
+func (d *deployer) wait(tx *types.Transaction) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(runCtx, deployTimeout)
+	defer cancel()
+	receipt, err := bind.WaitMined(ctx, chain, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		return nil, fmt.Errorf("transaction %s failed", tx.Hash().Hex())
+	}
+	return receipt, nil
+}
+
+// transactOpts returns the options for the next transaction
+func (d *deployer) transactOpts() (*bind.TransactOpts, error) {
+	opts := d.opts
+	if err := prepareTransaction(&opts); err != nil {
+		return nil, err
+	}
+	return &opts, nil
+}
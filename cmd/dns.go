@@ -0,0 +1,62 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/orinocopay/ens/manager"
+	"github.com/spf13/cobra"
+)
+
+// dnsCmd represents the dns command
+var dnsCmd = &cobra.Command{
+	Use:   "dns",
+	Short: "Manage DNS names in ENS",
+	Long: `Import DNS names in to the Ethereum Name Service (ENS) with DNSSEC.
+
+A DNS name can be claimed in ENS if its top-level domain is managed by a DNS registrar and the name has a TXT record at '_ens.<name>' containing 'a=<address>', signed with DNSSEC.  The records that prove the TXT record, from the DNS root down, are checked by the DNSSEC oracle used by the registrar and the name is given to the address in the record.  Names given to these commands are DNS names, so do not have '.eth' added.`,
+}
+
+func init() {
+	RootCmd.AddCommand(dnsCmd)
+}
+
+// dnsName returns a DNS name in the form used in proofs
+func dnsName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// obtainDNSProof reads the proof for a DNS name from a file if one is given,
+// otherwise fetches it from the DNS server (or the system's name server if
+// none is given)
+func obtainDNSProof(name string, proofFile string, server string) *manager.DNSProof {
+	if proofFile != "" {
+		data, err := ioutil.ReadFile(proofFile)
+		errCheck(err, "Failed to read proof file")
+		proof, err := manager.ParseDNSProof(data)
+		errCheck(err, "Invalid proof file")
+		assert(proof.Name == name, "Proof file is for "+proof.Name)
+		return proof
+	}
+	if server == "" {
+		var err error
+		server, err = manager.DefaultDNSServer()
+		errCheck(err, "Failed to obtain name server")
+	}
+	proof, err := mgr.FetchDNSProof(runCtx, name, server)
+	errCheck(err, "Failed to obtain proof from DNS")
+	return proof
+}
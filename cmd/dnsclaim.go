@@ -0,0 +1,87 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var dnsClaimAddressStr string
+var dnsClaimProofFile string
+var dnsClaimServer string
+
+// dnsClaimCmd represents the dns claim command
+var dnsClaimCmd = &cobra.Command{
+	Use:   "claim",
+	Short: "Claim a DNS name in ENS",
+	Long: `Claim a DNS name in the Ethereum Name Service (ENS) with a DNSSEC proof of its _ens TXT record.  For example:
+
+    ens dns claim --address=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --passphrase="my secret passphrase" example.com
+
+The name is claimed for the address in the 'a=' entry of its _ens TXT record, whichever address sends the transaction.  The proof is fetched from the name server given with --server, or the system's name server, unless it is read from a file saved by 'ens dns prove' with --proof.  RRsets of the proof that the DNSSEC oracle already holds are not submitted again.
+
+The keystore for the address must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
+
+In quiet mode this will return 0 if the transaction to claim the name is sent successfully, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		name := dnsName(args[0])
+		proof := obtainDNSProof(name, dnsClaimProofFile, dnsClaimServer)
+		err := proof.Check(time.Now())
+		errCheck(err, "Proof is not valid")
+		owner, err := proof.Owner()
+		errCheck(err, "Failed to obtain address from proof")
+		currentOwner, err := mgr.Owner(runCtx, name)
+		errCheck(err, "Cannot obtain current owner")
+		if currentOwner == owner {
+			if !quiet {
+				fmt.Println("Already claimed for", owner.Hex())
+			}
+			exit(0)
+		}
+
+		// Default to the account from the network profile
+		if dnsClaimAddressStr == "" {
+			dnsClaimAddressStr = defaultAccount
+		}
+		assert(dnsClaimAddressStr != "", "Address from which to claim is required")
+		from, err := resolveName(dnsClaimAddressStr)
+		errCheck(err, "Failed to obtain address")
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+
+		tx, err := mgr.ClaimDNSName(runCtx, from, proof, opts)
+		errCheck(err, "Failed to send transaction")
+		if !quiet {
+			fmt.Println("Claiming", name, "for", owner.Hex())
+			fmt.Println("Transaction ID is", tx.Hash().Hex())
+		}
+		log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+			"name":      name,
+			"networkid": chainID,
+			"owner":     owner.Hex()}).Info("DNS claim")
+	},
+}
+
+func init() {
+	dnsCmd.AddCommand(dnsClaimCmd)
+
+	dnsClaimCmd.Flags().StringVarP(&dnsClaimAddressStr, "address", "a", "", "Address from which to claim the name")
+	dnsClaimCmd.Flags().StringVar(&dnsClaimProofFile, "proof", "", "File from which to read the proof")
+	dnsClaimCmd.Flags().StringVar(&dnsClaimServer, "server", "", "Name server from which to fetch the proof (default is the system's name server)")
+	addTransactionFlags(dnsClaimCmd, "Passphrase for the account that claims the name")
+}
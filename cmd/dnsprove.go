@@ -0,0 +1,88 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var dnsProveProofFile string
+var dnsProveSaveFile string
+var dnsProveServer string
+var dnsProveOffline bool
+
+// dnsProveCmd represents the dns prove command
+var dnsProveCmd = &cobra.Command{
+	Use:   "prove",
+	Short: "Check the DNSSEC proof for a DNS name",
+	Long: `Build the DNSSEC proof of the _ens TXT record of a DNS name and check it with the DNSSEC oracle, without sending a transaction.  For example:
+
+    ens dns prove --save=example.json example.com
+
+The proof is fetched from the name server given with --server, or the system's name server, which must return DNSSEC records.  It can be saved with --save, and read from a saved file with --proof.  With --offline no DNS queries are made, so the proof must be read from a file; this allows a proof to be checked, or a name claimed, where DNS is not available.
+
+Each RRset of the proof is listed with the zone that signed it, when its signature expires and whether the oracle already holds it, followed by the address for which the name would be claimed.  The oracle is then asked to verify the RRsets that it does not hold.
+
+In quiet mode this will return 0 if the proof is verified, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assert(!dnsProveOffline || dnsProveProofFile != "", "--offline requires --proof")
+		name := dnsName(args[0])
+		proof := obtainDNSProof(name, dnsProveProofFile, dnsProveServer)
+		if dnsProveSaveFile != "" {
+			data, err := json.MarshalIndent(proof, "", "  ")
+			errCheck(err, "Failed to generate proof file")
+			err = ioutil.WriteFile(dnsProveSaveFile, append(data, '\n'), 0644)
+			errCheck(err, "Failed to save proof file")
+		}
+
+		held, err := mgr.HeldRRSets(runCtx, proof)
+		errCheck(err, "Failed to obtain RRsets held by the oracle")
+		owner, err := proof.Owner()
+		errCheck(err, "Failed to obtain address from proof")
+		if !quiet {
+			for i, set := range proof.RRSets {
+				_, expiration := set.Validity()
+				status := ""
+				if held[i] {
+					status = " (held by oracle)"
+				}
+				fmt.Printf("%s %s signed by %s until %v%s\n", set.Type, set.Name, set.Signer(), expiration, status)
+			}
+			fmt.Println("Address is", owner.Hex())
+		}
+
+		err = proof.Check(time.Now())
+		errCheck(err, "Proof is not valid")
+		err = mgr.VerifyDNSProof(runCtx, proof)
+		errCheck(err, "Proof is not verified")
+		if quiet {
+			exit(0)
+		}
+		fmt.Println("Proof verified by the oracle")
+	},
+}
+
+func init() {
+	dnsCmd.AddCommand(dnsProveCmd)
+
+	dnsProveCmd.Flags().StringVar(&dnsProveProofFile, "proof", "", "File from which to read the proof")
+	dnsProveCmd.Flags().StringVar(&dnsProveSaveFile, "save", "", "File in which to save the proof")
+	dnsProveCmd.Flags().StringVar(&dnsProveServer, "server", "", "Name server from which to fetch the proof (default is the system's name server)")
+	dnsProveCmd.Flags().BoolVar(&dnsProveOffline, "offline", false, "Make no DNS queries; the proof must be read with --proof")
+}
@@ -0,0 +1,231 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/orinocopay/ens/manager"
+	log "github.com/sirupsen/logrus"
+)
+
+// endpoints are the connections that are in use, in order of preference, and
+// clients and rpcClients the matching clients.  The first client is the
+// primary client
+var endpoints []string
+var clients []*ethclient.Client
+var rpcClients []*rpc.Client
+
+// chain is the backend for all requests, which fails over between the
+// endpoints if there are more than one
+var chain manager.ChainBackend
+
+// quorum is the number of endpoints that must agree on lookups
+var quorum int
+
+// quorumCommands are the lookup commands whose answers are checked by quorum
+var quorumCommands = map[string]bool{
+	"address":  true,
+	"info":     true,
+	"name":     true,
+	"owner":    true,
+	"rawinfo":  true,
+	"resolver": true,
+}
+
+// connectionEndpoints returns the endpoints listed in the connection, which
+// can hold several endpoints separated by commas
+func connectionEndpoints() []string {
+	res := make([]string, 0)
+	for _, endpoint := range strings.Split(connection, ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint != "" {
+			res = append(res, endpoint)
+		}
+	}
+	return res
+}
+
+// resetConnections closes the connections of an earlier run, if any, so that
+// each run connects afresh
+func resetConnections() {
+	for _, rpcClient := range rpcClients {
+		rpcClient.Close()
+	}
+	endpoints = nil
+	rpcClients = nil
+	clients = nil
+	client = nil
+	chainID = nil
+	chain = nil
+	mgr = nil
+	cacheBackend = nil
+	defaultAccount = ""
+}
+
+// connect connects to each endpoint in turn, keeping those that respond and
+// are on the required chain.  If requiredChainID is 0 then the chain of the
+// first endpoint to respond is required
+func connect(requiredChainID int64) error {
+	var lastErr error
+	for _, endpoint := range connectionEndpoints() {
+		rpcClient, endpointChainID, err := dialEndpoint(endpoint)
+		if err == nil && requiredChainID != 0 && endpointChainID.Cmp(big.NewInt(requiredChainID)) != 0 {
+			err = fmt.Errorf("connection is to chain %v but the network profile requires chain %d", endpointChainID, requiredChainID)
+		}
+		if err == nil && chainID != nil && endpointChainID.Cmp(chainID) != 0 {
+			err = fmt.Errorf("connection is to chain %v but %s is on chain %v", endpointChainID, endpoints[0], chainID)
+		}
+		if err != nil {
+			if !quiet {
+				fmt.Fprintf(os.Stderr, "Endpoint %s unavailable: %v\n", endpoint, err)
+			}
+			log.WithFields(log.Fields{"endpoint": endpoint, "error": err}).Warn("Endpoint unavailable")
+			lastErr = err
+			continue
+		}
+		if chainID == nil {
+			chainID = endpointChainID
+		}
+		endpoints = append(endpoints, endpoint)
+		rpcClients = append(rpcClients, rpcClient)
+		clients = append(clients, ethclient.NewClient(rpcClient))
+	}
+	if len(clients) == 0 {
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no connection")
+		}
+		return lastErr
+	}
+	client = clients[0]
+
+	// Batch concurrent calls, failing over between the endpoints if there
+	// are more than one
+	backends := make([]manager.ChainBackend, len(rpcClients))
+	for i := range rpcClients {
+		backends[i] = manager.NewBatchBackend(rpcClients[i])
+	}
+	chain = backends[0]
+	if len(backends) > 1 {
+		failoverBackend := manager.NewFailoverBackend(backends)
+		failoverBackend.Failed = endpointFailed
+		chain = failoverBackend
+	}
+	return nil
+}
+
+// dialEndpoint connects to an endpoint and obtains its chain ID
+func dialEndpoint(endpoint string) (*rpc.Client, *big.Int, error) {
+	rpcClient, err := dialRPC(endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(runCtx, 5*time.Second)
+	defer cancel()
+	endpointChainID, err := ethclient.NewClient(rpcClient).NetworkID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rpcClient, endpointChainID, nil
+}
+
+// endpointFailed notes that an endpoint failed a request
+func endpointFailed(index int, err error) {
+	if !quiet {
+		fmt.Fprintf(os.Stderr, "Endpoint %s failed: %v\n", endpoints[index], err)
+	}
+	log.WithFields(log.Fields{"endpoint": endpoints[index], "error": err}).Warn("Endpoint failed")
+}
+
+// quorumAnswer is the answer from a single endpoint to a quorum lookup
+type quorumAnswer struct {
+	owner    string
+	resolver string
+	address  string
+}
+
+// checkQuorum looks up the owner, resolver and address of a name, or the
+// reverse resolution of an address, with the first quorum endpoints and fails
+// if they do not all agree.  If pinned is true then lookups are made against
+// the given block
+func checkQuorum(input string, block *big.Int, pinned bool) {
+	if len(clients) < quorum {
+		fail(fmt.Sprintf("Quorum of %d requires at least %d available endpoints", quorum, quorum))
+	}
+
+	answers := make([]*quorumAnswer, quorum)
+	for i := 0; i < quorum; i++ {
+		endpointManager, err := manager.New(runCtx, clients[i], mgr.RegistryAddress())
+		if err == nil && pinned {
+			endpointManager, err = endpointManager.AtBlock(runCtx, block)
+		}
+		errCheck(err, fmt.Sprintf("Cannot obtain ENS contracts from %s", endpoints[i]))
+		answers[i] = quorumLookup(endpointManager, input)
+	}
+
+	agree := true
+	for _, answer := range answers[1:] {
+		if *answer != *answers[0] {
+			agree = false
+		}
+	}
+	if agree {
+		return
+	}
+	if !quiet {
+		writer := tabwriter.NewWriter(os.Stderr, 0, 8, 2, ' ', 0)
+		fmt.Fprintf(writer, "Endpoint\tOwner\tResolver\tAddress\n")
+		for i, answer := range answers {
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", endpoints[i], answer.owner, answer.resolver, answer.address)
+		}
+		writer.Flush()
+	}
+	log.WithFields(log.Fields{"name": input, "quorum": quorum}).Warn("Endpoints disagree")
+	fail("Endpoints disagree")
+}
+
+// quorumLookup carries out the lookups for a quorum check with one endpoint
+func quorumLookup(endpointManager *manager.Manager, input string) *quorumAnswer {
+	answer := &quorumAnswer{}
+	if manager.IsAddress(input) {
+		// Reverse resolution
+		name, err := endpointManager.ReverseResolve(runCtx, common.HexToAddress(input))
+		answer.address = quorumValue(name, err)
+		return answer
+	}
+	owner, err := endpointManager.Owner(runCtx, input)
+	answer.owner = quorumValue(owner.Hex(), err)
+	resolver, err := endpointManager.Resolver(runCtx, input)
+	answer.resolver = quorumValue(resolver.Hex(), err)
+	address, err := endpointManager.Resolve(runCtx, input)
+	answer.address = quorumValue(address.Hex(), err)
+	return answer
+}
+
+// quorumValue returns the value of an answer, or the error if there is one
+func quorumValue(value string, err error) string {
+	if err != nil {
+		return fmt.Sprintf("error: %v", err)
+	}
+	return value
+}
@@ -0,0 +1,117 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// commandError is the error that ends a command, with the message for the
+// user
+type commandError struct {
+	msg string
+	err error
+}
+
+func (e *commandError) Error() string {
+	if e.err == nil {
+		return e.msg
+	}
+	return fmt.Sprintf("%s: %v", e.msg, e.err)
+}
+
+// exitStatus ends a command with the given status, which in quiet mode is
+// the result of the command
+type exitStatus int
+
+func (s exitStatus) Error() string {
+	return fmt.Sprintf("exit status %d", int(s))
+}
+
+// errCheck ends the command if there is an error
+func errCheck(err error, msg string) {
+	if err != nil {
+		panic(&commandError{msg: msg, err: err})
+	}
+}
+
+// assert ends the command if the condition does not hold
+func assert(condition bool, msg string) {
+	if !condition {
+		fail(msg)
+	}
+}
+
+// errAssert ends the command if there is an error or the condition does not
+// hold
+func errAssert(condition bool, err error, msg string) {
+	errCheck(err, msg)
+	assert(condition, msg)
+}
+
+// fail ends the command
+func fail(msg string) {
+	panic(&commandError{msg: msg})
+}
+
+// exit ends the command with the given status
+func exit(status int) {
+	panic(exitStatus(status))
+}
+
+// execute runs the root command, returning the error that ended it, if
+// any.  Commands end early through errCheck, assert, fail and exit rather
+// than leaving the process, and each run connects afresh, so commands can be
+// run more than once in a process as long as their flags are reset between
+// runs
+func execute() (err error) {
+	defer func() {
+		saveCache()
+		switch r := recover().(type) {
+		case nil:
+		case *commandError:
+			err = r
+		case exitStatus:
+			if r != 0 {
+				err = r
+			}
+		default:
+			panic(r)
+		}
+	}()
+	return RootCmd.Execute()
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the rootCmd.
+func Execute() {
+	err := execute()
+	switch e := err.(type) {
+	case nil:
+	case exitStatus:
+		os.Exit(int(e))
+	case *commandError:
+		if !quiet {
+			fmt.Fprintln(os.Stderr, e)
+		}
+		log.WithFields(log.Fields{"error": e.Error()}).Warn("Command failed")
+		os.Exit(1)
+	default:
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
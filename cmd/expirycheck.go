@@ -0,0 +1,218 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/spf13/cobra"
+)
+
+var expiryCheckFile string
+var expiryCheckWithinStr string
+var expiryCheckFormat string
+
+// expiryResult is the expiry check of a single name.  Status is one of
+// expiring, grace, lapsed, unregistered, unmigrated, unowned or error
+type expiryResult struct {
+	Name           string     `json:"name"`
+	Registration   string     `json:"registration"`
+	Status         string     `json:"status"`
+	Expiry         *time.Time `json:"expiry,omitempty"`
+	GracePeriodEnd *time.Time `json:"graceperiodend,omitempty"`
+	Deed           string     `json:"deed,omitempty"`
+	Error          string     `json:"error,omitempty"`
+}
+
+// expiryCheckCmd represents the expiry check command
+var expiryCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check ENS names for upcoming expiry",
+	Long: `Report names that expire within a period, are in their grace period or have been lost.  Names can be given as arguments or in a file containing one name per line, or '-' to read the names from standard input.  For example:
+
+    ens expiry check --file=ours.txt --within=60d --format=json
+
+The registration checked for each name is that of the name directly under 'eth' that contains it.  With the permanent registrar a name is reported if it expires within the period (expiring), is in its grace period (grace), has expired (lapsed) or was never registered (unregistered); names still held as deeds in the auction registrar that preceded it are reported as unmigrated.  With the auction registrar names do not expire, so a name is reported if it is not owned (unowned).  Names that cannot be checked are reported as errors.
+
+Only reported names are output.  This will return 0 if no names are reported, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assert(expiryCheckFormat == "text" || expiryCheckFormat == "csv" || expiryCheckFormat == "json", "Format must be one of text, csv or json")
+		within, err := parsePeriod(expiryCheckWithinStr)
+		errCheck(err, "Invalid period")
+
+		names := args
+		if expiryCheckFile != "" {
+			var input io.Reader
+			if expiryCheckFile == "-" {
+				input = os.Stdin
+			} else {
+				f, err := os.Open(expiryCheckFile)
+				errCheck(err, "Failed to open names file")
+				defer f.Close()
+				input = f
+			}
+			fileNames, err := readNames(input)
+			errCheck(err, "Failed to read names")
+			names = append(names, fileNames...)
+		}
+		assert(len(names) > 0, "No names to check")
+
+		// Check the names concurrently, so that their lookups are batched
+		checked := make([]*expiryResult, len(names))
+		var wg sync.WaitGroup
+		workers := make(chan struct{}, 10)
+		for i := range names {
+			wg.Add(1)
+			workers <- struct{}{}
+			go func(i int) {
+				defer wg.Done()
+				checked[i] = checkExpiry(names[i], within)
+				<-workers
+			}(i)
+		}
+		wg.Wait()
+		if cancelled() {
+			exitCancelled()
+		}
+
+		results := make([]*expiryResult, 0)
+		for _, result := range checked {
+			if result.Status != "" {
+				results = append(results, result)
+			}
+		}
+		if !quiet {
+			outputExpiryResults(results)
+		}
+		if len(results) > 0 {
+			exit(1)
+		}
+	},
+}
+
+func init() {
+	expiryCmd.AddCommand(expiryCheckCmd)
+
+	expiryCheckCmd.Flags().StringVarP(&expiryCheckFile, "file", "f", "", "File containing names to check, one per line ('-' for standard input)")
+	expiryCheckCmd.Flags().StringVarP(&expiryCheckWithinStr, "within", "w", "30d", "Period within which expiring names are reported")
+	expiryCheckCmd.Flags().StringVar(&expiryCheckFormat, "format", "text", "Output format (text, csv or json)")
+}
+
+// checkExpiry checks the registration of a name as given, returning a
+// result with an empty status if there is nothing to report
+func checkExpiry(input string, within time.Duration) *expiryResult {
+	name, err := fullName(input)
+	if err != nil {
+		return &expiryResult{Name: input, Status: "error", Error: fmt.Sprintf("Invalid name: %v", err)}
+	}
+	result := &expiryResult{Name: name}
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 || labels[len(labels)-1] != "eth" {
+		result.Status = "error"
+		result.Error = "Not under eth"
+		return result
+	}
+	result.Registration = strings.Join(labels[len(labels)-2:], ".")
+
+	state, err := mgr.State(runCtx, result.Registration)
+	if err != nil {
+		result.Status = "error"
+		result.Error = "Cannot obtain state"
+		return result
+	}
+	if !mgr.Permanent() {
+		if state != "Owned" {
+			result.Status = "unowned"
+		}
+		return result
+	}
+
+	expiry, err := mgr.Expiry(runCtx, result.Registration)
+	if err != nil {
+		result.Status = "error"
+		result.Error = "Cannot obtain expiry"
+		return result
+	}
+	if !expiry.IsZero() {
+		gracePeriodEnd := expiry.Add(mgr.GracePeriod())
+		result.Expiry = &expiry
+		result.GracePeriodEnd = &gracePeriodEnd
+	}
+	switch {
+	case state == "Owned" && expiry.Sub(mgr.Now()) < within:
+		result.Status = "expiring"
+	case state == "Expired":
+		result.Status = "grace"
+	case state == "Available" && !expiry.IsZero():
+		result.Status = "lapsed"
+	case state == "Available":
+		deed, err := mgr.UnmigratedDeed(runCtx, result.Registration)
+		if err != nil {
+			result.Status = "error"
+			result.Error = "Cannot obtain deed"
+		} else if deed != ens.UnknownAddress {
+			result.Status = "unmigrated"
+			result.Deed = deed.Hex()
+		} else {
+			result.Status = "unregistered"
+		}
+	}
+	return result
+}
+
+// outputExpiryResults outputs the reported names in the selected format
+func outputExpiryResults(results []*expiryResult) {
+	switch expiryCheckFormat {
+	case "csv":
+		writer := csv.NewWriter(os.Stdout)
+		writer.Write([]string{"name", "registration", "status", "expiry", "graceperiodend", "deed", "error"})
+		for _, result := range results {
+			writer.Write([]string{result.Name, result.Registration, result.Status, csvTime(result.Expiry), csvTime(result.GracePeriodEnd), result.Deed, result.Error})
+		}
+		writer.Flush()
+		errCheck(writer.Error(), "Failed to write output")
+	case "json":
+		output, err := json.MarshalIndent(results, "", "  ")
+		errCheck(err, "Failed to generate output")
+		fmt.Println(string(output))
+	default:
+		writer := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		for _, result := range results {
+			switch {
+			case result.Error != "":
+				fmt.Fprintf(writer, "%s\t%s\t%s\n", result.Name, result.Status, result.Error)
+			case result.Status == "expiring":
+				fmt.Fprintf(writer, "%s\t%s\texpires %v\n", result.Name, result.Status, *result.Expiry)
+			case result.Status == "grace":
+				fmt.Fprintf(writer, "%s\t%s\tgrace period ends %v\n", result.Name, result.Status, *result.GracePeriodEnd)
+			case result.Status == "lapsed":
+				fmt.Fprintf(writer, "%s\t%s\tgrace period ended %v\n", result.Name, result.Status, *result.GracePeriodEnd)
+			case result.Status == "unmigrated":
+				fmt.Fprintf(writer, "%s\t%s\tdeed %s\n", result.Name, result.Status, result.Deed)
+			default:
+				fmt.Fprintf(writer, "%s\t%s\n", result.Name, result.Status)
+			}
+		}
+		writer.Flush()
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/orinocopay/ens/manager"
+	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var exportLabelsFile string
+var exportOutput string
+var exportFormat string
+var exportFromBlock int64
+
+// newOwnerTopic is the topic of the NewOwner event emitted by the registry
+var newOwnerTopic = crypto.Keccak256Hash([]byte("NewOwner(bytes32,bytes32,address)"))
+
+// zone is the configuration of a name and its subdomains
+type zone struct {
+	Names []*manifestEntry `json:"names" yaml:"names"`
+}
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export an ENS name and its subdomains",
+	Long: `Export the configuration of a name registered with the Ethereum Name Service (ENS) and all of its known subdomains to a zone file.  For example:
+
+    ens export --labels=labels.txt --output=enstest.yaml enstest.eth
+
+Subdomains are found from the registry's event logs.  As the logs only contain the hash of each subdomain's label, candidate labels must be supplied one per line in the labels file; subdomains with unknown labels are reported but not exported.
+
+The zone file can be used to bring the names back in to line with 'ens reconcile'.
+
+In quiet mode this will return 0 if the zone file is written successfully, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		format := exportFormat
+		if format == "" {
+			if strings.HasSuffix(exportOutput, ".json") {
+				format = "json"
+			} else {
+				format = "yaml"
+			}
+		}
+		assert(format == "yaml" || format == "json", "Format must be one of yaml or json")
+
+		// Candidate labels for subdomains
+		labels := make(map[common.Hash]string)
+		if exportLabelsFile != "" {
+			f, err := os.Open(exportLabelsFile)
+			errCheck(err, "Failed to open labels file")
+			defer f.Close()
+			candidates, err := readNames(f)
+			errCheck(err, "Failed to read labels")
+			for _, candidate := range candidates {
+				// Labels are normalized but not completed
+				label, err := manager.Normalize(candidate)
+				if err != nil {
+					if !quiet {
+						fmt.Fprintf(os.Stderr, "Ignoring invalid label %s: %v\n", candidate, err)
+					}
+					continue
+				}
+				labels[common.Hash(ens.LabelHash(label))] = label
+			}
+		}
+
+		zone := &zone{Names: make([]*manifestEntry, 0)}
+		names := []string{args[0]}
+		for len(names) > 0 {
+			name := names[0]
+			names = names[1:]
+
+			entry, err := exportEntry(name)
+			errCheck(err, fmt.Sprintf("Failed to export %s", name))
+			if entry.Owner == "" && name != args[0] {
+				// Subdomain has been removed
+				continue
+			}
+			zone.Names = append(zone.Names, entry)
+
+			subdomains, err := subdomains(registryAddress, name, labels)
+			errCheck(err, fmt.Sprintf("Failed to obtain subdomains of %s", name))
+			names = append(names, subdomains...)
+		}
+
+		var output []byte
+		var err error
+		if format == "json" {
+			output, err = json.MarshalIndent(zone, "", "  ")
+		} else {
+			output, err = yaml.Marshal(zone)
+		}
+		errCheck(err, "Failed to generate zone file")
+		if exportOutput == "" {
+			if !quiet {
+				fmt.Println(string(output))
+			}
+		} else {
+			err = ioutil.WriteFile(exportOutput, output, 0644)
+			errCheck(err, "Failed to write zone file")
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringVar(&exportLabelsFile, "labels", "", "File containing candidate labels for subdomains, one per line")
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "File to which to write the zone (default is standard output)")
+	exportCmd.Flags().StringVar(&exportFormat, "format", "", "Format of the zone file (yaml or json; default is based on the output file name)")
+	exportCmd.Flags().Int64Var(&exportFromBlock, "fromblock", 0, "Block from which to search for subdomains and text records")
+}
+
+// exportEntry obtains the current configuration of a name
+func exportEntry(name string) (*manifestEntry, error) {
+	nameHash := ens.NameHash(name)
+	entry := &manifestEntry{Name: name}
+
+	registryOwner, err := registryContract.Owner(callOpts(), nameHash)
+	if err != nil {
+		return nil, err
+	}
+	if registryOwner == ens.UnknownAddress {
+		return entry, nil
+	}
+	// Wrapped names are exported with their owner in the name wrapper
+	owner, err := mgr.EffectiveOwner(runCtx, name)
+	if err != nil {
+		return nil, err
+	}
+	if owner != ens.UnknownAddress {
+		entry.Owner = owner.Hex()
+	}
+	ttl, err := registryContract.Ttl(callOpts(), nameHash)
+	if err != nil {
+		return nil, err
+	}
+	entry.TTL = strconv.FormatUint(ttl, 10)
+	resolverAddress, err := registryContract.Resolver(callOpts(), nameHash)
+	if err != nil {
+		return nil, err
+	}
+	if resolverAddress == ens.UnknownAddress {
+		return entry, nil
+	}
+	entry.Resolver = resolverAddress.Hex()
+
+	// Records are optional, so failures to obtain them are ignored
+	resolverContract, err := ens.ResolverContractByAddress(client, resolverAddress)
+	if err == nil {
+		address, err := resolverContract.Addr(callOpts(), nameHash)
+		if err == nil && address != ens.UnknownAddress {
+			entry.Address = address.Hex()
+		}
+	}
+	records, err := newResolverRecords(resolverAddress)
+	if err != nil {
+		return nil, err
+	}
+	content, err := records.Content(callOpts(), nameHash)
+	if err == nil && common.Hash(content) != (common.Hash{}) {
+		entry.Content = common.Hash(content).Hex()
+	}
+	contentType, abi, err := records.ABI(callOpts(), nameHash, big.NewInt(1))
+	if err == nil && contentType != nil && contentType.Cmp(big.NewInt(1)) == 0 && len(abi) > 0 {
+		entry.ABI = string(abi)
+	}
+	keys, err := textKeys(resolverAddress, nameHash)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		value, err := records.Text(callOpts(), nameHash, key)
+		if err == nil && value != "" {
+			if entry.Text == nil {
+				entry.Text = make(map[string]string)
+			}
+			entry.Text[key] = value
+		}
+	}
+
+	return entry, nil
+}
+
+// subdomains obtains the known subdomains of a name from the registry's logs
+func subdomains(registryAddress common.Address, name string, labels map[common.Hash]string) ([]string, error) {
+	logs, err := chain.FilterLogs(runCtx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(exportFromBlock),
+		Addresses: []common.Address{registryAddress},
+		Topics:    [][]common.Hash{{newOwnerTopic}, {common.Hash(ens.NameHash(name))}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subdomains := make([]string, 0)
+	seen := make(map[common.Hash]bool)
+	for _, log := range logs {
+		if len(log.Topics) < 3 || seen[log.Topics[2]] {
+			continue
+		}
+		seen[log.Topics[2]] = true
+		label, exists := labels[log.Topics[2]]
+		if !exists {
+			if !quiet {
+				fmt.Fprintf(os.Stderr, "Unknown label %s for subdomain of %s\n", log.Topics[2].Hex(), name)
+			}
+			continue
+		}
+		subdomains = append(subdomains, fmt.Sprintf("%s.%s", label, name))
+	}
+	return subdomains, nil
+}
+
+// textKeys obtains the keys of the text records set for a name from the
+// resolver's logs
+func textKeys(resolverAddress common.Address, nameHash [32]byte) ([]string, error) {
+	logs, err := chain.FilterLogs(runCtx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(exportFromBlock),
+		Addresses: []common.Address{resolverAddress},
+		Topics:    [][]common.Hash{{textChangedTopic}, {common.Hash(nameHash)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	keys := make([]string, 0)
+	for _, log := range logs {
+		// Data is the ABI-encoded key
+		if len(log.Data) < 64 {
+			continue
+		}
+		length := binary.BigEndian.Uint64(log.Data[56:64])
+		if length > uint64(len(log.Data)-64) {
+			// Any resolver can emit the event, so the length is not trusted
+			continue
+		}
+		key := string(log.Data[64 : 64+length])
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
@@ -0,0 +1,62 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/orinocopay/ens/manager"
+	"github.com/spf13/cobra"
+)
+
+// fusesCmd represents the fuses command
+var fusesCmd = &cobra.Command{
+	Use:   "fuses",
+	Short: "Obtain the fuses of a wrapped ENS name",
+	Long: `Obtain the fuses burned for a name wrapped in the Ethereum Name Service (ENS) name wrapper.  For example:
+
+    ens fuses enstest.eth
+
+Each burned fuse is listed by name, followed by the wrapper expiry of the name.  Fuses no longer apply once the wrapper expiry has passed.
+
+In quiet mode this will return 0 if the name is wrapped, otherwise 1.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		wrapped, err := mgr.Wrapped(runCtx, args[0])
+		errCheck(err, "Cannot obtain name wrapper data")
+		if quiet {
+			if wrapped == nil {
+				exit(1)
+			}
+			exit(0)
+		}
+		if wrapped == nil {
+			fmt.Println("Not wrapped")
+			return
+		}
+		if wrapped.Fuses == 0 {
+			fmt.Println("No fuses burned")
+		}
+		for _, fuse := range manager.FuseNames(wrapped.Fuses) {
+			fmt.Println(fuse)
+		}
+		if !wrapped.Expiry.IsZero() {
+			fmt.Println("Wrapper expiry is", wrapped.Expiry)
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(fusesCmd)
+}
@@ -0,0 +1,69 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/orinocopay/ens/manager"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var fusesBurnFuses []string
+
+// fusesBurnCmd represents the fuses burn command
+var fusesBurnCmd = &cobra.Command{
+	Use:   "burn",
+	Short: "Burn fuses of a wrapped ENS name",
+	Long: `Burn fuses of a name wrapped in the Ethereum Name Service (ENS) name wrapper.  For example:
+
+    ens fuses burn --fuse=CANNOT_UNWRAP --fuse=CANNOT_TRANSFER --passphrase="my secret passphrase" enstest.eth
+
+The fuses are CANNOT_UNWRAP, CANNOT_BURN_FUSES, CANNOT_TRANSFER, CANNOT_SET_RESOLVER, CANNOT_SET_TTL, CANNOT_CREATE_SUBDOMAIN and CANNOT_APPROVE, which are burned by the owner of the wrapped name, and PARENT_CANNOT_CONTROL and CAN_EXTEND_EXPIRY, which are burned by the owner of its wrapped parent.  The two kinds cannot be burned together.  The name wrapper only allows the owner's fuses to be burned once PARENT_CANNOT_CONTROL is burned, and other fuses only along with or after CANNOT_UNWRAP.
+
+Burned fuses cannot be restored until the wrapper expiry of the name.
+
+The keystore for the owner of the wrapped name, or of its parent, must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
+
+In quiet mode this will return 0 if the transaction to burn the fuses is sent successfully, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assert(len(fusesBurnFuses) > 0, "At least one fuse is required")
+		var fuses uint32
+		for _, fuseName := range fusesBurnFuses {
+			fuse, err := manager.ParseFuse(fuseName)
+			errCheck(err, "Invalid fuse")
+			fuses |= fuse
+		}
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+
+		tx, err := mgr.BurnFuses(runCtx, args[0], fuses, opts)
+		errCheck(err, "Failed to burn fuses")
+		if !quiet {
+			fmt.Println("Transaction ID is", tx.Hash().Hex())
+		}
+		log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+			"name":      args[0],
+			"networkid": chainID,
+			"fuses":     manager.FuseNames(fuses)}).Info("Burn fuses")
+	},
+}
+
+func init() {
+	fusesCmd.AddCommand(fusesBurnCmd)
+
+	fusesBurnCmd.Flags().StringSliceVarP(&fusesBurnFuses, "fuse", "f", nil, "Fuse to burn (can be given more than once)")
+	addTransactionFlags(fusesBurnCmd, "Passphrase for the account that owns the wrapped name")
+}
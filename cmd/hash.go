@@ -16,7 +16,6 @@ package cmd
 import (
 	"encoding/hex"
 	"fmt"
-	"os"
 
 	"github.com/orinocopay/go-etherutils/ens"
 	"github.com/spf13/cobra"
@@ -30,11 +29,15 @@ var hashCmd = &cobra.Command{
 
 	ens hash foo.eth
 
+Names are completed with '.eth' unless they end with a top-level domain, which is a name with an owner in the registry; for example 'enstest' is 'enstest.eth' and 'example.xyz' is left as it is if 'xyz' has an owner.  A single label that is both a top-level domain and registered under 'eth' is ambiguous, and must be given in full.  With --no-suffix names are used exactly as given.  Addresses must start with 0x, and addresses in mixed case must have a valid EIP-55 checksum; 40 hex characters without 0x could be a name or an address so are refused.
+
+Names are normalized following ENSIP-15 before they are used: letters are mapped to lower case and to their standard forms, and emoji are kept without presentation selectors.  Names with disallowed characters, labels that mix scripts or are written in another script with letters that look like Latin letters are refused with the reason.  A warning is given if a name uses letters that look like others, for example 'pɑypal.eth' with a Latin alpha looks like 'paypal.eth'; accented letters are distinct so are not warned about.
+
 In quiet mode this will return 0 if the name can be hashed, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		name := ens.NameHash(args[0])
 		if quiet {
-			os.Exit(0)
+			exit(0)
 		} else {
 			fmt.Println(hex.EncodeToString(name[:]))
 		}
@@ -14,14 +14,15 @@
 package cmd
 
 import (
-	"bytes"
 	"fmt"
 	"math/big"
-	"os"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/orinocopay/ens/manager"
 	etherutils "github.com/orinocopay/go-etherutils"
-	"github.com/orinocopay/go-etherutils/cli"
 	"github.com/orinocopay/go-etherutils/ens"
 	"github.com/spf13/cobra"
 )
@@ -39,33 +40,35 @@ var infoCmd = &cobra.Command{
 In quiet mode this will return 0 if the domain is owned, otherwise 1.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
-		if ens.DomainLevel(args[0]) == 1 {
-			state, err := ens.State(registrarContract, client, args[0])
-			cli.ErrCheck(err, quiet, "Cannot obtain info")
+		info, err := mgr.Info(runCtx, args[0])
+		errCheck(err, "Cannot obtain info")
+		if manager.IsETH2LD(info.Name) {
 			if quiet {
-				if state == "Owned" {
-					os.Exit(0)
+				if info.State == "Owned" {
+					exit(0)
 				} else {
-					os.Exit(1)
+					exit(1)
 				}
 			} else {
-				switch state {
+				switch info.State {
 				case "Available":
-					availableInfo(args[0])
+					availableInfo(info)
 				case "Bidding":
-					biddingInfo(args[0])
+					biddingInfo(info)
 				case "Revealing":
-					revealingInfo(args[0])
+					revealingInfo(info)
 				case "Won":
-					wonInfo(args[0])
+					wonInfo(info)
 				case "Owned":
-					ownedInfo(args[0])
+					ownedInfo(info)
+				case "Expired":
+					expiredInfo(info)
 				default:
-					fmt.Println(state)
+					fmt.Println(info.State)
 				}
 			}
 		} else {
-			subdomainInfo(args[0])
+			registryInfo(info)
 		}
 	},
 }
@@ -74,182 +77,113 @@ func init() {
 	RootCmd.AddCommand(infoCmd)
 }
 
-func availableInfo(name string) {
-	if len(name) < 11 { // 7 + 4 for '.eth'
+func availableInfo(info *manager.NameInfo) {
+	if utf8.RuneCountInString(strings.TrimSuffix(info.Name, ".eth")) < mgr.MinimumNameLength() {
 		fmt.Println("Unavailable due to name length restrictions")
 	} else {
 		fmt.Println("Available")
 	}
 }
 
-func biddingInfo(name string) {
-	_, _, registrationDate, _, _, err := ens.Entry(registrarContract, client, name)
-	cli.ErrCheck(err, quiet, "Cannot obtain auction status")
+func biddingInfo(info *manager.NameInfo) {
 	twoDaysAgo := time.Duration(-48) * time.Hour
-	fmt.Println("Bidding until", registrationDate.Add(twoDaysAgo))
+	fmt.Println("Bidding until", info.RegistrationDate.Add(twoDaysAgo))
 }
 
-func revealingInfo(name string) {
-	_, _, registrationDate, value, highestBid, err := ens.Entry(registrarContract, client, name)
-	cli.ErrCheck(err, quiet, "Cannot obtain information for that name")
-	fmt.Println("Revealing until", registrationDate)
-	// If the value is 0 then it is is minvalue instead
-	if value.Cmp(zero) == 0 {
-		value, _ = etherutils.StringToWei("0.01 ether")
-	}
-	fmt.Println("Locked value is", etherutils.WeiToString(value, true))
-	fmt.Println("Highest bid is", etherutils.WeiToString(highestBid, true))
+func revealingInfo(info *manager.NameInfo) {
+	fmt.Println("Revealing until", info.RegistrationDate)
+	fmt.Println("Locked value is", etherutils.WeiToString(info.Value, true))
+	fmt.Println("Highest bid is", etherutils.WeiToString(info.HighestBid, true))
 	// TODO number of bids revealed?
 }
 
-func wonInfo(name string) {
-	_, deedAddress, registrationDate, value, highestBid, err := ens.Entry(registrarContract, client, name)
-	cli.ErrCheck(err, quiet, "Cannot obtain information for that name")
-	fmt.Println("Won since", registrationDate)
-	if value.Cmp(zero) == 0 {
-		value, _ = etherutils.StringToWei("0.01 ether")
-	}
-	fmt.Println("Locked value is", etherutils.WeiToString(value, true))
-	fmt.Println("Highest bid was", etherutils.WeiToString(highestBid, true))
-
-	// Deed
-	deedContract, err := ens.DeedContract(client, &deedAddress)
-	cli.ErrCheck(err, quiet, "Failed to obtain deed contract")
-	// Deed owner
-	deedOwner, err := ens.Owner(deedContract)
-	cli.ErrCheck(err, quiet, "Failed to obtain deed owner")
-	deedOwnerName, _ := ens.ReverseResolve(client, &deedOwner)
-	if deedOwnerName == "" {
-		fmt.Println("Deed owner is", deedOwner.Hex())
-	} else {
-		fmt.Printf("Deed owner is %s (%s)\n", deedOwnerName, deedOwner.Hex())
-	}
+func wonInfo(info *manager.NameInfo) {
+	fmt.Println("Won since", info.RegistrationDate)
+	fmt.Println("Locked value is", etherutils.WeiToString(info.Value, true))
+	fmt.Println("Highest bid was", etherutils.WeiToString(info.HighestBid, true))
+	printAddress(info, "Deed owner", info.DeedOwner)
 }
 
-func ownedInfo(name string) {
-	_, deedAddress, registrationDate, value, highestBid, err := ens.Entry(registrarContract, client, name)
-	cli.ErrCheck(err, quiet, "Cannot obtain information for that name")
-	fmt.Println("Owned since", registrationDate)
-	fmt.Println("Locked value is", etherutils.WeiToString(value, true))
-	fmt.Println("Highest bid was", etherutils.WeiToString(highestBid, true))
-
-	// Deed
-	deedContract, err := ens.DeedContract(client, &deedAddress)
-	cli.ErrCheck(err, quiet, "Failed to obtain deed contract")
-	// Deed owner
-	deedOwner, err := deedContract.Owner(nil)
-	cli.ErrCheck(err, quiet, "Failed to obtain deed owner")
-	deedOwnerName, _ := ens.ReverseResolve(client, &deedOwner)
-	if deedOwnerName == "" {
-		fmt.Println("Deed owner is", deedOwner.Hex())
-	} else {
-		fmt.Printf("Deed owner is %s (%s)\n", deedOwnerName, deedOwner.Hex())
+func ownedInfo(info *manager.NameInfo) {
+	if !info.Expiry.IsZero() {
+		// Permanent registrar
+		fmt.Println("Registered until", info.Expiry)
+		fmt.Println("Grace period ends", info.GracePeriodEnd)
+		printAddress(info, "Registrant", info.Registrant)
+		registryInfo(info)
+		return
 	}
-
-	previousDeedOwner, err := deedContract.PreviousOwner(nil)
-	cli.ErrCheck(err, quiet, "Failed to obtain deed owner")
-	if bytes.Compare(previousDeedOwner.Bytes(), ens.UnknownAddress.Bytes()) != 0 {
-		previousDeedOwnerName, _ := ens.ReverseResolve(client, &previousDeedOwner)
-		if previousDeedOwnerName == "" {
-			fmt.Println("Previous deed owner is", previousDeedOwner.Hex())
-		} else {
-			fmt.Printf("Previous deed owner is %s (%s)\n", previousDeedOwnerName, previousDeedOwner.Hex())
-		}
+	fmt.Println("Owned since", info.RegistrationDate)
+	fmt.Println("Locked value is", etherutils.WeiToString(info.Value, true))
+	fmt.Println("Highest bid was", etherutils.WeiToString(info.HighestBid, true))
+	printAddress(info, "Deed owner", info.DeedOwner)
+	if info.PreviousDeedOwner != ens.UnknownAddress {
+		printAddress(info, "Previous deed owner", info.PreviousDeedOwner)
 	}
+	registryInfo(info)
+}
+
+func expiredInfo(info *manager.NameInfo) {
+	fmt.Println("Expired", info.Expiry)
+	fmt.Println("In grace period until", info.GracePeriodEnd)
+	registryInfo(info)
+}
 
-	// Address owner
-	registry, err := ens.RegistryContract(client)
-	cli.ErrCheck(err, quiet, "Failed to obtain registry contract")
-	domainOwnerAddress, err := registry.Owner(nil, ens.NameHash(name))
-	cli.ErrCheck(err, quiet, "Failed to obtain domain owner")
-	if domainOwnerAddress == ens.UnknownAddress {
+// registryInfo prints the information held in the registry and resolver
+func registryInfo(info *manager.NameInfo) {
+	if info.Owner == ens.UnknownAddress {
 		fmt.Println("Address owner not set")
 		return
 	}
-	domainOwnerName, _ := ens.ReverseResolve(client, &domainOwnerAddress)
-	if domainOwnerName == "" {
-		fmt.Println("Address owner is", domainOwnerAddress.Hex())
+	if info.Wrapped {
+		printAddress(info, "Name wrapper", info.Owner)
+		if info.WrappedOwner == ens.UnknownAddress {
+			fmt.Println("Wrapped owner not set")
+			return
+		}
+		printAddress(info, "Wrapped owner", info.WrappedOwner)
+		if info.Fuses == 0 {
+			fmt.Println("No fuses burned")
+		} else {
+			fmt.Println("Fuses burned are", strings.Join(manager.FuseNames(info.Fuses), ", "))
+		}
+		if !info.WrapperExpiry.IsZero() {
+			fmt.Println("Wrapper expiry is", info.WrapperExpiry)
+		}
 	} else {
-		fmt.Printf("Address owner is %s (%s)\n", domainOwnerName, domainOwnerAddress.Hex())
+		printAddress(info, "Address owner", info.Owner)
 	}
 
-	// Resolver
-	resolverAddress, err := ens.Resolver(registry, name)
-	if err != nil {
+	if info.Resolver == ens.UnknownAddress {
 		fmt.Println("Resolver not configured")
 		return
 	}
-	resolverName, _ := ens.ReverseResolve(client, &resolverAddress)
-	if resolverName == "" {
-		fmt.Println("Resolver is", resolverAddress.Hex())
-	} else {
-		fmt.Printf("Resolver is %s (%s)\n", resolverName, resolverAddress.Hex())
+	printAddress(info, "Resolver", info.Resolver)
+	if info.ResolverName != info.Name {
+		fmt.Println("Resolver is a wildcard resolver for", info.ResolverName)
 	}
 
-	// Address
-	address, err := ens.Resolve(client, name)
-	if err != nil || address == ens.UnknownAddress {
+	if info.Address == ens.UnknownAddress {
 		fmt.Println("Name does not resolve to an address")
 		return
 	}
-	fmt.Println("Domain resolves to", address.Hex())
+	fmt.Println("Domain resolves to", info.Address.Hex())
 
-	// Reverse resolution
-	reverseDomain, err := ens.ReverseResolve(client, &address)
-	if err != nil || reverseDomain == "" {
+	if info.ReverseName == "" {
 		fmt.Println("Address does not resolve to a domain")
 		return
 	}
-	fmt.Println("Address resolves to", reverseDomain)
+	fmt.Println("Address resolves to", info.ReverseName)
 
 	// TODO Other common fields (addr, abi, etc.) (if configured)
 }
 
-func subdomainInfo(name string) {
-	// Address owner
-	registry, err := ens.RegistryContract(client)
-	cli.ErrCheck(err, quiet, "Failed to obtain registry contract")
-	domainOwnerAddress, err := registry.Owner(nil, ens.NameHash(name))
-	cli.ErrCheck(err, quiet, "Failed to obtain domain owner")
-	if domainOwnerAddress == ens.UnknownAddress {
-		fmt.Println("Address owner not set")
-		return
-	}
-	domainOwnerName, _ := ens.ReverseResolve(client, &domainOwnerAddress)
-	if domainOwnerName == "" {
-		fmt.Println("Address owner is", domainOwnerAddress.Hex())
-	} else {
-		fmt.Printf("Address owner is %s (%s)\n", domainOwnerName, domainOwnerAddress.Hex())
-	}
-
-	// Resolver
-	resolverAddress, err := ens.Resolver(registry, name)
-	if err != nil {
-		fmt.Println("Resolver not configured")
-		return
-	}
-	resolverName, _ := ens.ReverseResolve(client, &resolverAddress)
-	if resolverName == "" {
-		fmt.Println("Resolver is", resolverAddress.Hex())
+// printAddress prints an address along with its reverse resolution, if any
+func printAddress(info *manager.NameInfo, description string, address common.Address) {
+	name := info.Names[address]
+	if name == "" {
+		fmt.Printf("%s is %s\n", description, address.Hex())
 	} else {
-		fmt.Printf("Resolver is %s (%s)\n", resolverName, resolverAddress.Hex())
-	}
-
-	// Address
-	address, err := ens.Resolve(client, name)
-	if err != nil || address == ens.UnknownAddress {
-		fmt.Println("Name does not resolve to an address")
-		return
+		fmt.Printf("%s is %s (%s)\n", description, name, address.Hex())
 	}
-	fmt.Println("Domain resolves to", address.Hex())
-
-	// Reverse resolution
-	reverseDomain, err := ens.ReverseResolve(client, &address)
-	if err != nil || reverseDomain == "" {
-		fmt.Println("Address does not resolve to a domain")
-		return
-	}
-	fmt.Println("Address resolves to", reverseDomain)
-
-	// TODO Other common fields (addr, abi, etc.) (if configured)
 }
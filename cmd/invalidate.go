@@ -15,11 +15,7 @@ package cmd
 
 import (
 	"fmt"
-	"math/big"
 
-	etherutils "github.com/orinocopay/go-etherutils"
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -37,30 +33,18 @@ var invalidateCmd = &cobra.Command{
 In quiet mode this will return 0 if the invalidate transaction has been submitted, otherwise 1.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
-		// Ensure that the name is in a suitable state
-		registrarContract, err := ens.RegistrarContract(client)
-		state, err := ens.State(registrarContract, client, args[0])
-		cli.Assert(state == "Won" || state == "Owned", quiet, "Name not in a suitable state to invalidate")
-
-		// Fetch the wallet and account for the address
-		invalidateAddress, err := ens.Resolve(client, invalidateAddressStr)
-		cli.ErrCheck(err, quiet, "Failed to obtain invalidate address")
-		wallet, err := cli.ObtainWallet(chainID, invalidateAddress)
-		cli.ErrCheck(err, quiet, "Failed to obtain a wallet for the address")
-		account, err := cli.ObtainAccount(&wallet, &invalidateAddress, passphrase)
-		cli.ErrCheck(err, quiet, "Failed to obtain an account for the address")
-
-		gasPrice, err := etherutils.StringToWei(gasPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid gas price")
-
-		// Set up our session
-		session := ens.CreateRegistrarSession(chainID, &wallet, account, passphrase, registrarContract, gasPrice)
-		if nonce != -1 {
-			session.TransactOpts.Nonce = big.NewInt(nonce)
+		// Default to the account from the network profile
+		if invalidateAddressStr == "" {
+			invalidateAddressStr = defaultAccount
 		}
 
-		tx, err := ens.InvalidateName(session, args[0])
-		cli.ErrCheck(err, quiet, "Failed to send transaction")
+		invalidateAddress, err := resolveName(invalidateAddressStr)
+		errCheck(err, "Failed to obtain invalidate address")
+
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+		tx, err := mgr.Invalidate(runCtx, args[0], invalidateAddress, opts)
+		errCheck(err, "Failed to invalidate name")
 		if !quiet {
 			fmt.Println("Transaction ID is", tx.Hash().Hex())
 		}
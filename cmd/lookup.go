@@ -0,0 +1,52 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/go-etherutils/ens/reverseregistrarcontract"
+)
+
+// The lookups in this file use the registry selected for this run rather than
+// the well-known deployment for the chain, so that they work with private and
+// test networks
+
+// resolveName resolves a name to an address, completing the name as
+// fullName.  If the input is already a hex address then it is returned
+// as-is
+func resolveName(input string) (common.Address, error) {
+	name, err := fullName(input)
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	return mgr.Resolve(runCtx, name)
+}
+
+// reverseResolve resolves an address to a name
+func reverseResolve(address common.Address) (string, error) {
+	return mgr.ReverseResolve(runCtx, address)
+}
+
+// publicResolver obtains the address of the public resolver, which is the
+// address of 'resolver.eth'
+func publicResolver() (common.Address, error) {
+	return mgr.PublicResolver(runCtx)
+}
+
+// reverseRegistrar obtains the reverse registrar, which is the owner of the
+// 'addr.reverse' node
+func reverseRegistrar() (*reverseregistrarcontract.ReverseRegistrarContract, error) {
+	return mgr.ReverseRegistrar(runCtx)
+}
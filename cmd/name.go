@@ -16,9 +16,7 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/ens/manager"
 	"github.com/spf13/cobra"
 )
 
@@ -32,9 +30,10 @@ var nameCmd = &cobra.Command{
 
 In quiet mode this will return 0 if the address resolves correctly, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		address := common.HexToAddress(args[0])
-		name, err := ens.ReverseResolve(client, &address)
-		cli.ErrCheck(err, quiet, "Failed to obtain name")
+		address, err := manager.ParseAddress(args[0])
+		errCheck(err, "Invalid address")
+		name, err := reverseResolve(address)
+		errCheck(err, "Failed to obtain name")
 		if !quiet {
 			fmt.Println(name)
 		}
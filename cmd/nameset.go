@@ -15,12 +15,8 @@ package cmd
 
 import (
 	"fmt"
-	"math/big"
 
-	"github.com/ethereum/go-ethereum/common"
-	etherutils "github.com/orinocopay/go-etherutils"
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/ens/manager"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -39,31 +35,18 @@ The keystore for the account that owns the name must be local (i.e. listed with
 
 In quiet mode this will return 0 if the transaction to set the name is sent successfully, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		cli.Assert(nameSetName != "", quiet, "Name is required")
+		assert(nameSetName != "", "Name is required")
 
-		// Obtain the reverse registrar contract
-		reverseRegistrar, err := ens.ReverseRegistrarContract(client)
-		cli.ErrCheck(err, quiet, "Failed to obtain reverse registrar contract")
-
-		address := common.HexToAddress(args[0])
-
-		// Fetch the wallet and account for the address
-		wallet, account, err := obtainWalletAndAccount(address, passphrase)
-		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain account details for the address %s", args[0]))
-
-		gasPrice, err := etherutils.StringToWei(gasPriceStr)
-		cli.ErrCheck(err, quiet, fmt.Sprintf("Invalid gas price %s", gasPriceStr))
-
-		session := ens.CreateReverseRegistrarSession(chainID, &wallet, account, passphrase, reverseRegistrar, gasPrice)
-		if nonce != -1 {
-			session.TransactOpts.Nonce = big.NewInt(nonce)
-		}
+		address, err := manager.ParseAddress(args[0])
+		errCheck(err, "Invalid address")
 
 		// Clean up the name prior to setting
-		nameSetName = ens.Normalize(nameSetName)
+		nameSetName = ensName(nameSetName)
 
-		tx, err := ens.SetName(session, nameSetName)
-		cli.ErrCheck(err, quiet, "Failed to set name for that address")
+		opts, err := transactionOptions()
+		errCheck(err, fmt.Sprintf("Invalid gas price %s", gasPriceStr))
+		tx, err := mgr.SetReverseName(runCtx, address, nameSetName, opts)
+		errCheck(err, "Failed to set name for that address")
 		if !quiet {
 			fmt.Println("Transaction ID is", tx.Hash().Hex())
 		}
@@ -0,0 +1,108 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// networkProfile is a named set of defaults for a network, held in the
+// configuration file under 'networks'
+type networkProfile struct {
+	Connection string `mapstructure:"connection" yaml:"connection,omitempty"`
+	// Connections are alternative endpoints, tried in order, for failover
+	Connections []string `mapstructure:"connections" yaml:"connections,omitempty"`
+	ChainID     int64    `mapstructure:"chainid" yaml:"chainid,omitempty"`
+	Registry    string   `mapstructure:"registry" yaml:"registry,omitempty"`
+	// Registrar, Resolver and ReverseRegistrar are written by 'ens deploy'
+	// to record the deployment; lookups find them from the registry
+	Registrar        string `mapstructure:"registrar" yaml:"registrar,omitempty"`
+	Resolver         string `mapstructure:"resolver" yaml:"resolver,omitempty"`
+	ReverseRegistrar string `mapstructure:"reverseregistrar" yaml:"reverseregistrar,omitempty"`
+	Controller       string `mapstructure:"controller" yaml:"controller,omitempty"`
+	NameWrapper      string `mapstructure:"namewrapper" yaml:"namewrapper,omitempty"`
+	GasPrice         string `mapstructure:"gasprice" yaml:"gasprice,omitempty"`
+	Account          string `mapstructure:"account" yaml:"account,omitempty"`
+}
+
+// loadNetworkProfile loads the profile for the selected network.  The network
+// is selected with --network or, failing that, the 'network' configuration
+// key.  If no network is selected then this returns nil
+func loadNetworkProfile() (*networkProfile, error) {
+	name := network
+	if name == "" {
+		name = viper.GetString("network")
+	}
+	if name == "" {
+		return nil, nil
+	}
+	key := fmt.Sprintf("networks.%s", name)
+	if !viper.IsSet(key) {
+		return nil, fmt.Errorf("unknown network %s", name)
+	}
+	profile := &networkProfile{}
+	if err := viper.UnmarshalKey(key, profile); err != nil {
+		return nil, fmt.Errorf("invalid configuration for network %s: %v", name, err)
+	}
+	return profile, nil
+}
+
+// saveNetworkProfile adds or replaces the named profile in the configuration
+// file, leaving the rest of the file as-is.  It returns the path of the file
+func saveNetworkProfile(name string, profile *networkProfile) (string, error) {
+	path := viper.ConfigFileUsed()
+	if path == "" {
+		path = cfgFile
+	}
+	if path == "" {
+		home, err := homedir.Dir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(home, ".cmd.yaml")
+	}
+	if ext := filepath.Ext(path); ext != ".yaml" && ext != ".yml" {
+		return "", fmt.Errorf("cannot update %s: only YAML configuration files can be updated", path)
+	}
+
+	config := make(map[string]interface{})
+	data, err := ioutil.ReadFile(path)
+	if err == nil {
+		if err = yaml.Unmarshal(data, &config); err != nil {
+			return "", fmt.Errorf("invalid configuration in %s: %v", path, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	networks, ok := config["networks"].(map[interface{}]interface{})
+	if !ok {
+		networks = make(map[interface{}]interface{})
+	}
+	networks[name] = profile
+	config["networks"] = networks
+
+	data, err = yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return path, ioutil.WriteFile(path, data, 0600)
+}
@@ -0,0 +1,49 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// nodeCmd represents the node command
+var nodeCmd = &cobra.Command{
+	Use:   "node",
+	Short: "Manage Ethereum node connections",
+	Long: `Check the Ethereum nodes used for connections.
+
+Settings for different networks can be held as named profiles in the configuration file and selected with --network, for example:
+
+    network: mainnet
+    networks:
+      mainnet:
+        connection: https://api.orinocopay.com:8546/
+        chainid: 1
+        gasprice: 4 GWei
+        account: 0x5FfC014343cd971B7eb70732021E26C35B744cc4
+      local:
+        connection: http://localhost:8545/
+        chainid: 1337
+        registry: 0x2B9D6c5Ab7E1B9C3E9f2E7b1B5C0A4D3E2F1a0b9
+
+'network' selects the profile used when --network is not supplied.  Each profile can contain the connection to the Ethereum node, the chain ID that the node must report, the address of the ENS registry, the default gas price for transactions and the default account from which to send transactions.  Command-line flags override the profile.
+
+Several endpoints can be given to --connection separated by commas, or listed under 'connections' in a network profile as alternatives to its 'connection'.  Endpoints that cannot be reached or report a different chain are skipped, and a request that fails on one endpoint is retried on the others.  With --quorum N lookups of owner, resolver and address are also made with the first N endpoints and the command fails if they disagree.
+
+A custom ENS registry can be selected with --registry or the 'registry' configuration key.  The registrar is the owner of the 'eth' node in the registry, the reverse registrar the owner of the 'addr.reverse' node and the public resolver the address of 'resolver.eth'.  The registrar controller used to register names is the one published by the resolver for 'eth', unless it is given with the 'controller' configuration or profile key.  Likewise the name wrapper is the one published by the resolver for 'eth' unless given with the 'namewrapper' key; names owned in the registry by a name wrapper are managed through it, by their owner in the wrapper.`,
+}
+
+func init() {
+	RootCmd.AddCommand(nodeCmd)
+}
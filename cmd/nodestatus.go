@@ -0,0 +1,68 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+// nodeStatusCmd represents the node status command
+var nodeStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the status of each Ethereum node endpoint",
+	Long: `Show the chain ID, head block and latency of each endpoint given in the connection.  For example:
+
+    ens node status --connection=https://api.orinocopay.com:8546/,http://localhost:8545/
+
+In quiet mode this will return 0 if all endpoints are available, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		healthy := true
+		writer := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintf(writer, "Endpoint\tChain ID\tHead block\tLatency\n")
+		for _, endpoint := range connectionEndpoints() {
+			rpcClient, endpointChainID, err := dialEndpoint(endpoint)
+			if err != nil {
+				healthy = false
+				fmt.Fprintf(writer, "%s\terror: %v\t\t\n", endpoint, err)
+				continue
+			}
+			start := time.Now()
+			header, err := ethclient.NewClient(rpcClient).HeaderByNumber(runCtx, nil)
+			latency := time.Since(start)
+			if err != nil {
+				healthy = false
+				fmt.Fprintf(writer, "%v\t%v\terror: %v\t\n", endpoint, endpointChainID, err)
+				continue
+			}
+			fmt.Fprintf(writer, "%s\t%v\t%v\t%v\n", endpoint, endpointChainID, header.Number, latency.Round(time.Millisecond))
+		}
+		if cancelled() {
+			exitCancelled()
+		}
+		if !quiet {
+			writer.Flush()
+		}
+		assert(healthy, "Not all endpoints are available")
+	},
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeStatusCmd)
+}
@@ -18,11 +18,11 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
 	"github.com/spf13/cobra"
 )
 
+var nonceReset bool
+
 // nonceCmd represents the nonce command
 var nonceCmd = &cobra.Command{
 	Use:   "nonce",
@@ -31,17 +31,24 @@ var nonceCmd = &cobra.Command{
 
     ens nonce 0x5FfC014343cd971B7eb70732021E26C35B744cc4
 
+Transactions sent by this tool obtain their nonces from a local nonce manager, so that multiple transactions can be sent in quick succession.  If the local state becomes confused it can be cleared with the --reset flag.
+
 In quiet mode this will return 0 if the nonce can be obtained, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
 
-		nonceAddress, err := ens.Resolve(client, args[0])
-		cli.ErrCheck(err, quiet, "Failed to obtain nonce address")
+		nonceAddress, err := resolveName(args[0])
+		errCheck(err, "Failed to obtain nonce address")
 
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if nonceReset {
+			err = resetNonceState(nonceAddress)
+			errCheck(err, "Failed to reset local nonce state")
+		}
+
+		ctx, cancel := context.WithTimeout(runCtx, 5*time.Second)
 		defer cancel()
 
-		nonce, err := client.PendingNonceAt(ctx, nonceAddress)
-		cli.ErrCheck(err, quiet, "Failed to obtain nonce")
+		nonce, err := chain.PendingNonceAt(ctx, nonceAddress)
+		errCheck(err, "Failed to obtain nonce")
 
 		if !quiet {
 			fmt.Println(nonce)
@@ -51,4 +58,6 @@ In quiet mode this will return 0 if the nonce can be obtained, otherwise 1.`,
 
 func init() {
 	RootCmd.AddCommand(nonceCmd)
+
+	nonceCmd.Flags().BoolVar(&nonceReset, "reset", false, "Clear the local nonce state for the address")
 }
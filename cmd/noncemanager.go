@@ -0,0 +1,240 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/prometheus/prometheus/util/flock"
+	log "github.com/sirupsen/logrus"
+)
+
+// nextNonce is the nonce for the next transaction of the run if the user
+// gave a starting nonce with --nonce, otherwise -1 for nonces from the local
+// nonce state.  Each transaction after the first takes the nonce following
+// the one before
+var nextNonce int64 = -1
+
+// nonceReservationGrace is the time after which a nonce that has been handed
+// out without a transaction being recorded against it is considered unused
+var nonceReservationGrace = 2 * time.Minute
+
+// nonceLockTimeout is the time to wait to obtain the lock on the nonce state
+var nonceLockTimeout = 30 * time.Second
+
+// nonceEntry is a nonce that has been handed out
+type nonceEntry struct {
+	Reserved    int64  `json:"reserved"`
+	Transaction string `json:"transaction,omitempty"`
+}
+
+// nonceState is the local nonce state for an address on a chain
+type nonceState struct {
+	Next    uint64                 `json:"next"`
+	Entries map[uint64]*nonceEntry `json:"entries"`
+}
+
+// nonceStatePath returns the path of the nonce state file for an address,
+// without extension
+func nonceStatePath(address common.Address) (string, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(home, ".ens", "nonces")
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, fmt.Sprintf("%v-%s", chainID, address.Hex())), nil
+}
+
+// withNonceState runs the supplied function with the nonce state for the
+// address locked against use by other processes, saving any changes
+func withNonceState(address common.Address, fn func(state *nonceState) error) error {
+	path, err := nonceStatePath(address)
+	if err != nil {
+		return err
+	}
+
+	var lock flock.Releaser
+	deadline := time.Now().Add(nonceLockTimeout)
+	for {
+		lock, _, err = flock.New(path + ".lock")
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("failed to lock nonce state: %v", err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	defer lock.Release()
+
+	state := &nonceState{Entries: make(map[uint64]*nonceEntry)}
+	data, err := ioutil.ReadFile(path + ".json")
+	if err == nil {
+		if err = json.Unmarshal(data, state); err != nil {
+			return fmt.Errorf("invalid nonce state in %s: %v", path+".json", err)
+		}
+		if state.Entries == nil {
+			state.Entries = make(map[uint64]*nonceEntry)
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	if err = fn(state); err != nil {
+		return err
+	}
+
+	data, err = json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path+".json")
+}
+
+// reserveNonce hands out the next nonce for the address.  The local state is
+// reconciled with the node's view of mined and pending transactions, and gaps
+// left by transactions that never reached the node are refilled
+func reserveNonce(address common.Address, requested int64) (uint64, error) {
+	var reserved uint64
+	err := withNonceState(address, func(state *nonceState) error {
+		ctx, cancel := context.WithTimeout(runCtx, 30*time.Second)
+		defer cancel()
+
+		mined, err := chain.NonceAt(ctx, address, nil)
+		if err != nil {
+			return err
+		}
+		pending, err := chain.PendingNonceAt(ctx, address)
+		if err != nil {
+			return err
+		}
+
+		// Forget about nonces that have been mined
+		for entryNonce, entry := range state.Entries {
+			if entryNonce >= mined {
+				continue
+			}
+			if entry.Transaction != "" {
+				receipt, err := chain.TransactionReceipt(ctx, common.HexToHash(entry.Transaction))
+				if err != nil && err != ethereum.NotFound {
+					// Cannot tell yet; check again next time
+					continue
+				}
+				if receipt == nil {
+					log.WithFields(log.Fields{"address": address.Hex(),
+						"nonce":         entryNonce,
+						"transactionid": entry.Transaction}).Warn("Nonce used by a different transaction")
+				}
+			}
+			delete(state.Entries, entryNonce)
+		}
+
+		if requested != -1 {
+			// Nonce supplied by the user
+			reserved = uint64(requested)
+		} else {
+			reserved = state.Next
+			if reserved < pending {
+				// Transactions have been sent from elsewhere
+				reserved = pending
+			}
+			// Look for nonces that have been handed out but never reached the node
+			for candidate := pending; candidate < state.Next; candidate++ {
+				if nonceGap(ctx, state.Entries[candidate]) {
+					if !quiet {
+						fmt.Fprintf(os.Stderr, "Nonce %d was handed out but not used; reusing it\n", candidate)
+					}
+					log.WithFields(log.Fields{"address": address.Hex(),
+						"nonce": candidate}).Warn("Nonce gap")
+					reserved = candidate
+					break
+				}
+			}
+		}
+
+		state.Entries[reserved] = &nonceEntry{Reserved: time.Now().Unix()}
+		if reserved >= state.Next {
+			state.Next = reserved + 1
+		}
+		return nil
+	})
+	return reserved, err
+}
+
+// nonceGap returns true if a nonce that has been handed out was not used
+func nonceGap(ctx context.Context, entry *nonceEntry) bool {
+	if entry == nil {
+		return true
+	}
+	if entry.Transaction == "" {
+		return time.Since(time.Unix(entry.Reserved, 0)) > nonceReservationGrace
+	}
+	_, _, err := chain.TransactionByHash(ctx, common.HexToHash(entry.Transaction))
+	return err == ethereum.NotFound
+}
+
+// setNonce sets the nonce of a transaction from the local nonce manager
+func setNonce(opts *bind.TransactOpts) error {
+	reserved, err := reserveNonce(opts.From, nextNonce)
+	if err != nil {
+		return err
+	}
+	if nextNonce != -1 {
+		nextNonce = int64(reserved) + 1
+	}
+	opts.Nonce = new(big.Int).SetUint64(reserved)
+	return nil
+}
+
+// noteTransaction records a sent transaction with the local nonce manager
+func noteTransaction(from common.Address, tx *types.Transaction) {
+	err := withNonceState(from, func(state *nonceState) error {
+		state.Entries[tx.Nonce()] = &nonceEntry{
+			Reserved:    time.Now().Unix(),
+			Transaction: tx.Hash().Hex(),
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithFields(log.Fields{"address": from.Hex(),
+			"transactionid": tx.Hash().Hex()}).Warn("Failed to record transaction nonce")
+	}
+}
+
+// resetNonceState clears the local nonce state for an address
+func resetNonceState(address common.Address) error {
+	return withNonceState(address, func(state *nonceState) error {
+		state.Next = 0
+		state.Entries = make(map[uint64]*nonceEntry)
+		return nil
+	})
+}
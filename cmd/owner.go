@@ -15,46 +15,71 @@ package cmd
 
 import (
 	"fmt"
-	"os"
 
-	"github.com/orinocopay/go-etherutils/cli"
+	"github.com/orinocopay/ens/manager"
 	"github.com/orinocopay/go-etherutils/ens"
 	"github.com/spf13/cobra"
 )
 
+var ownerRegistrant bool
+var ownerController bool
+
 // ownerCmd represents the owner command
 var ownerCmd = &cobra.Command{
 	Use:   "owner",
 	Short: "Obtain owner of an ENS domain",
 	Long: `Obtain owner of a domain registered with the Ethereum Name Service (ENS).  For example:
 
-    ens owner enstest.eth
+    ens owner --registrant --controller enstest.eth
+
+A domain directly under 'eth' has a registrant, which holds the registration with the registrar (with the auction registrar this is the deed owner), and a controller, which owns the domain in the registry and so manages its records.  Other domains only have a controller.  The controller of a wrapped domain is its owner in the name wrapper.
+
+With --registrant or --controller only that owner is shown, and with both each is shown labelled.  Otherwise the registrant is shown for domains directly under 'eth' and the controller for other domains.
 
-In quiet mode this will return 0 if the domain is owned, otherwise 1.`,
+In quiet mode this will return 0 if the owners shown are set, otherwise 1.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
-		registrarContract, err := ens.RegistrarContract(client)
-		cli.ErrCheck(err, quiet, "Failed to obtain registrar contract")
-		state, deedAddress, _, _, _, err := ens.Entry(registrarContract, client, args[0])
-		cli.ErrCheck(err, quiet, fmt.Sprintf("Cannot obtain raw info for %s", args[0]))
+		showRegistrant := ownerRegistrant
+		showController := ownerController
+		if !showRegistrant && !showController {
+			showRegistrant = manager.IsETH2LD(args[0])
+			showController = !showRegistrant
+		}
+
+		registrant := ens.UnknownAddress
+		controller := ens.UnknownAddress
+		var err error
+		if showRegistrant {
+			assert(manager.IsETH2LD(args[0]), "Only domains directly under eth have a registrant")
+			registrant, err = mgr.Registrant(runCtx, args[0])
+			errCheck(err, fmt.Sprintf("Cannot obtain registrant for %s", args[0]))
+		}
+		if showController {
+			controller, err = mgr.EffectiveOwner(runCtx, args[0])
+			errCheck(err, fmt.Sprintf("Cannot obtain controller for %s", args[0]))
+		}
+
 		if quiet {
-			if state == "Owned" {
-				os.Exit(0)
-			} else {
-				os.Exit(1)
+			if (showRegistrant && registrant == ens.UnknownAddress) || (showController && controller == ens.UnknownAddress) {
+				exit(1)
 			}
-		} else {
-			// Deed
-			deedContract, err := ens.DeedContract(client, &deedAddress)
-			cli.ErrCheck(err, quiet, "Failed to obtain deed contract")
-			// Deed owner
-			deedOwner, err := ens.Owner(deedContract)
-			cli.ErrCheck(err, quiet, "Failed to obtain deed owner")
-			fmt.Println(deedOwner.Hex())
+			exit(0)
+		}
+		switch {
+		case showRegistrant && showController:
+			fmt.Println("Registrant is", registrant.Hex())
+			fmt.Println("Controller is", controller.Hex())
+		case showRegistrant:
+			fmt.Println(registrant.Hex())
+		default:
+			fmt.Println(controller.Hex())
 		}
 	},
 }
 
 func init() {
 	RootCmd.AddCommand(ownerCmd)
+
+	ownerCmd.Flags().BoolVar(&ownerRegistrant, "registrant", false, "Show the registrant of the domain")
+	ownerCmd.Flags().BoolVar(&ownerController, "controller", false, "Show the controller of the domain")
 }
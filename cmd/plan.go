@@ -0,0 +1,456 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/orinocopay/ens/manager"
+	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/go-etherutils/ens/resolvercontract"
+	log "github.com/sirupsen/logrus"
+)
+
+// planGasLimit is the gas limit for each transaction in a plan, before the
+// gas for its data.  Later transactions can depend on earlier ones that have
+// not yet been mined, so gas cannot be estimated
+var planGasLimit = big.NewInt(200000)
+
+// planWordGas is the gas allowed for each 32-byte word of data that an
+// operation stores: a new storage slot and the calldata to fill it
+var planWordGas int64 = 20000 + 32*68
+
+// planGasLimitOverride is the gas limit for every transaction in a plan if
+// given, in place of the limit from its data
+var planGasLimitOverride int64
+
+// operation is a single transaction in a plan.  Size is the number of bytes
+// of variable-length data, such as text or an ABI, that it stores
+type operation struct {
+	description string
+	size        int
+	send        func(opts *bind.TransactOpts) (*types.Transaction, error)
+}
+
+// gasLimit returns the gas limit for the operation
+func (o *operation) gasLimit() *big.Int {
+	if planGasLimitOverride > 0 {
+		return big.NewInt(planGasLimitOverride)
+	}
+	words := int64((o.size + 31) / 32)
+	return new(big.Int).Add(planGasLimit, big.NewInt(words*planWordGas))
+}
+
+// plan is an ordered list of operations sent from a single account.  It
+// tracks the owner and resolver of each name as they will be once all
+// operations have been carried out.  Wrapped names are managed through their
+// name wrapper, and the owner of a wrapped name is its owner in the wrapper.
+// Names that the account takes ownership of to make changes are returned to
+// their original owners when the plan is finished
+type plan struct {
+	account    common.Address
+	operations []*operation
+	owners     map[[32]byte]common.Address
+	resolvers  map[[32]byte]common.Address
+	wrappers   map[[32]byte]*manager.NameWrapperContract
+	taken      []string
+	originals  map[[32]byte]common.Address
+}
+
+func newPlan(account common.Address) *plan {
+	return &plan{
+		account:    account,
+		operations: make([]*operation, 0),
+		owners:     make(map[[32]byte]common.Address),
+		resolvers:  make(map[[32]byte]common.Address),
+		wrappers:   make(map[[32]byte]*manager.NameWrapperContract),
+		taken:      make([]string, 0),
+		originals:  make(map[[32]byte]common.Address),
+	}
+}
+
+// owner returns the owner of a name once the plan has been carried out
+func (p *plan) owner(name string) (common.Address, error) {
+	nameHash := ens.NameHash(name)
+	if owner, exists := p.owners[nameHash]; exists {
+		return owner, nil
+	}
+	return mgr.EffectiveOwner(runCtx, name)
+}
+
+// wrapper returns the name wrapper of a name once the plan has been carried
+// out, or nil if the name is not wrapped
+func (p *plan) wrapper(name string) (*manager.NameWrapperContract, error) {
+	nameHash := ens.NameHash(name)
+	if wrapper, exists := p.wrappers[nameHash]; exists {
+		return wrapper, nil
+	}
+	wrapped, err := mgr.Wrapped(runCtx, name)
+	if err != nil {
+		return nil, err
+	}
+	var wrapper *manager.NameWrapperContract
+	if wrapped != nil {
+		wrapper = mgr.NameWrapperContract(wrapped.NameWrapper)
+	}
+	p.wrappers[nameHash] = wrapper
+	return wrapper, nil
+}
+
+// resolver returns the resolver of a name once the plan has been carried out
+func (p *plan) resolver(name string) (common.Address, error) {
+	nameHash := ens.NameHash(name)
+	if resolver, exists := p.resolvers[nameHash]; exists {
+		return resolver, nil
+	}
+	return registryContract.Resolver(callOpts(), nameHash)
+}
+
+// setOwner adds an operation to set the owner of a name
+func (p *plan) setOwner(name string, owner common.Address) error {
+	return p.changeOwner(name, owner, fmt.Sprintf("Set owner of %s to %s", name, owner.Hex()))
+}
+
+// changeOwner adds an operation with the given description to set the owner
+// of a name.  Names directly under 'eth' have their owner set by the manager,
+// which reclaims ownership from the permanent registrar as the registrant;
+// other names have their owner set through their parent
+func (p *plan) changeOwner(name string, owner common.Address, description string) error {
+	if manager.IsETH2LD(name) {
+		return p.changeETH2LDOwner(name, owner, description)
+	}
+	nameBits := strings.Split(name, ".")
+	if len(nameBits) < 3 {
+		return fmt.Errorf("cannot set owner of %s: not a subdomain", name)
+	}
+	parent := strings.Join(nameBits[1:], ".")
+	parentOwner, err := p.owner(parent)
+	if err != nil {
+		return err
+	}
+	if parentOwner != p.account {
+		return fmt.Errorf("cannot set owner of %s: %s is not owned by %s", name, parent, p.account.Hex())
+	}
+
+	// Subdomains of wrapped names are created wrapped
+	wrapper, err := p.wrapper(parent)
+	if err != nil {
+		return err
+	}
+
+	parentHash := ens.NameHash(parent)
+	labelHash := ens.LabelHash(nameBits[0])
+	p.operations = append(p.operations, &operation{
+		description: description,
+		send: func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			if wrapper != nil {
+				return wrapper.SetSubnodeOwner(opts, parentHash, nameBits[0], owner)
+			}
+			return registryContract.SetSubnodeOwner(opts, parentHash, labelHash, owner)
+		},
+	})
+	p.owners[ens.NameHash(name)] = owner
+	p.wrappers[ens.NameHash(name)] = wrapper
+	return nil
+}
+
+// changeETH2LDOwner adds an operation to set the owner of a name directly
+// under 'eth'.  With the permanent registrar the account must be the name's
+// registrant, otherwise its owner
+func (p *plan) changeETH2LDOwner(name string, owner common.Address, description string) error {
+	wrapper, err := p.wrapper(name)
+	if err != nil {
+		return err
+	}
+	if wrapper != nil {
+		return fmt.Errorf("cannot set owner of %s: wrapped names are controlled by their owner in the name wrapper", name)
+	}
+	var controller common.Address
+	if mgr.Permanent() {
+		controller, err = mgr.Registrant(runCtx, name)
+	} else {
+		controller, err = p.owner(name)
+	}
+	if err != nil {
+		return err
+	}
+	if controller != p.account {
+		return fmt.Errorf("cannot set owner of %s: not controlled by %s", name, p.account.Hex())
+	}
+
+	p.operations = append(p.operations, &operation{
+		description: description,
+		send: func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return mgr.SetOwner(runCtx, name, owner, &manager.TxOpts{
+				Passphrase: passphrase,
+				GasPrice:   opts.GasPrice,
+				Account:    obtainWalletAndAccount,
+				// The plan has already chosen the nonce and gas limit
+				Nonce: func(transactOpts *bind.TransactOpts) error {
+					transactOpts.Nonce = opts.Nonce
+					transactOpts.GasLimit = opts.GasLimit
+					return nil
+				},
+			})
+		},
+	})
+	p.owners[ens.NameHash(name)] = owner
+	return nil
+}
+
+// control ensures that the account will own the name at this point in the
+// plan.  A name that the account has to take ownership of is noted, to be
+// returned to its original owner when the plan is finished
+func (p *plan) control(name string) error {
+	owner, err := p.owner(name)
+	if err != nil {
+		return err
+	}
+	if owner == p.account {
+		return nil
+	}
+	description := fmt.Sprintf("Take ownership of %s from %s to make changes", name, owner.Hex())
+	if err = p.changeOwner(name, p.account, description); err != nil {
+		return err
+	}
+	nameHash := ens.NameHash(name)
+	if _, exists := p.originals[nameHash]; !exists {
+		p.taken = append(p.taken, name)
+		p.originals[nameHash] = owner
+	}
+	return nil
+}
+
+// keep notes that the owner of a name is as required once the plan has been
+// carried out, so a name the account took ownership of is not returned
+func (p *plan) keep(name string) {
+	delete(p.originals, ens.NameHash(name))
+}
+
+// finish adds operations to return the names that the account took
+// ownership of to their original owners, unless their owner is kept
+func (p *plan) finish() error {
+	for _, name := range p.taken {
+		nameHash := ens.NameHash(name)
+		original, exists := p.originals[nameHash]
+		if !exists || p.owners[nameHash] != p.account {
+			continue
+		}
+		description := fmt.Sprintf("Return ownership of %s to %s", name, original.Hex())
+		if err := p.changeOwner(name, original, description); err != nil {
+			return err
+		}
+	}
+	p.taken = p.taken[:0]
+	p.originals = make(map[[32]byte]common.Address)
+	return nil
+}
+
+// setResolver adds an operation to set the resolver of a name
+func (p *plan) setResolver(name string, resolver common.Address) error {
+	if err := p.control(name); err != nil {
+		return err
+	}
+	wrapper, err := p.wrapper(name)
+	if err != nil {
+		return err
+	}
+	nameHash := ens.NameHash(name)
+	p.operations = append(p.operations, &operation{
+		description: fmt.Sprintf("Set resolver of %s to %s", name, resolver.Hex()),
+		send: func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			if wrapper != nil {
+				return wrapper.SetResolver(opts, nameHash, resolver)
+			}
+			return registryContract.SetResolver(opts, nameHash, resolver)
+		},
+	})
+	p.resolvers[nameHash] = resolver
+	return nil
+}
+
+// setAddress adds an operation to set the address of a name with its resolver
+func (p *plan) setAddress(name string, address common.Address) error {
+	if err := p.control(name); err != nil {
+		return err
+	}
+	resolverAddress, err := p.resolver(name)
+	if err != nil {
+		return err
+	}
+	if resolverAddress == ens.UnknownAddress {
+		return fmt.Errorf("cannot set address of %s: no resolver", name)
+	}
+	resolverContract, err := resolvercontract.NewResolverContract(resolverAddress, mgr.Backend())
+	if err != nil {
+		return err
+	}
+	nameHash := ens.NameHash(name)
+	p.operations = append(p.operations, &operation{
+		description: fmt.Sprintf("Set address of %s to %s", name, address.Hex()),
+		send: func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return resolverContract.SetAddr(opts, nameHash, address)
+		},
+	})
+	return nil
+}
+
+// setTTL adds an operation to set the TTL of a name
+func (p *plan) setTTL(name string, ttl uint64) error {
+	if err := p.control(name); err != nil {
+		return err
+	}
+	wrapper, err := p.wrapper(name)
+	if err != nil {
+		return err
+	}
+	nameHash := ens.NameHash(name)
+	p.operations = append(p.operations, &operation{
+		description: fmt.Sprintf("Set TTL of %s to %d", name, ttl),
+		send: func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			if wrapper != nil {
+				return wrapper.SetTTL(opts, nameHash, ttl)
+			}
+			return registryContract.SetTTL(opts, nameHash, ttl)
+		},
+	})
+	return nil
+}
+
+// records obtains the records of the resolver that a name will have once the
+// plan has been carried out
+func (p *plan) records(name string) (*resolverRecords, error) {
+	if err := p.control(name); err != nil {
+		return nil, err
+	}
+	resolverAddress, err := p.resolver(name)
+	if err != nil {
+		return nil, err
+	}
+	if resolverAddress == ens.UnknownAddress {
+		return nil, fmt.Errorf("cannot set records of %s: no resolver", name)
+	}
+	return newResolverRecords(resolverAddress)
+}
+
+// setText adds an operation to set a text record of a name with its resolver
+func (p *plan) setText(name string, key string, value string) error {
+	records, err := p.records(name)
+	if err != nil {
+		return err
+	}
+	nameHash := ens.NameHash(name)
+	p.operations = append(p.operations, &operation{
+		description: fmt.Sprintf("Set text %q of %s to %q", key, name, value),
+		size:        len(key) + len(value),
+		send: func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return records.SetText(opts, nameHash, key, value)
+		},
+	})
+	return nil
+}
+
+// setABI adds an operation to set the JSON ABI of a name with its resolver
+func (p *plan) setABI(name string, data string) error {
+	records, err := p.records(name)
+	if err != nil {
+		return err
+	}
+	nameHash := ens.NameHash(name)
+	p.operations = append(p.operations, &operation{
+		description: fmt.Sprintf("Set ABI of %s", name),
+		size:        len(data),
+		send: func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return records.SetABI(opts, nameHash, big.NewInt(1), []byte(data))
+		},
+	})
+	return nil
+}
+
+// setContent adds an operation to set the content hash of a name with its resolver
+func (p *plan) setContent(name string, hash common.Hash) error {
+	records, err := p.records(name)
+	if err != nil {
+		return err
+	}
+	nameHash := ens.NameHash(name)
+	p.operations = append(p.operations, &operation{
+		description: fmt.Sprintf("Set content hash of %s to %s", name, hash.Hex()),
+		send: func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return records.SetContent(opts, nameHash, hash)
+		},
+	})
+	return nil
+}
+
+// print prints the operations in the plan
+func (p *plan) print() {
+	if len(p.operations) == 0 {
+		fmt.Println("No changes required")
+		return
+	}
+	fmt.Printf("%d transaction(s) will be sent from %s:\n", len(p.operations), p.account.Hex())
+	for i, operation := range p.operations {
+		fmt.Printf("  %d. %s\n", i+1, operation.description)
+	}
+}
+
+// confirm asks the user to confirm that the plan should be carried out
+func (p *plan) confirm() bool {
+	defer pauseTimeout()()
+	fmt.Print("Send these transactions? [y/N] ")
+	response, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false
+	}
+	response = strings.ToLower(strings.TrimSpace(response))
+	return response == "y" || response == "yes"
+}
+
+// execute sends the transactions in the plan with sequential nonces
+func (p *plan) execute(passphrase string, gasPrice *big.Int) error {
+	wallet, account, err := obtainWalletAndAccount(p.account, passphrase)
+	if err != nil {
+		return err
+	}
+	session := ens.CreateRegistrySession(chainID, &wallet, account, passphrase, registryContract, gasPrice)
+
+	for _, operation := range p.operations {
+		opts := session.TransactOpts
+		opts.GasLimit = operation.gasLimit()
+		if err = prepareTransaction(&opts); err != nil {
+			return err
+		}
+		tx, err := operation.send(&opts)
+		if err != nil {
+			return fmt.Errorf("%s: %v", operation.description, err)
+		}
+		noteTransaction(opts.From, tx)
+		if !quiet {
+			fmt.Printf("%s: transaction ID is %s\n", operation.description, tx.Hash().Hex())
+		}
+		log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+			"networkid": chainID,
+			"nonce":     tx.Nonce(),
+			"operation": operation.description}).Info("Plan operation")
+	}
+	return nil
+}
@@ -16,10 +16,8 @@ package cmd
 import (
 	"encoding/hex"
 	"fmt"
-	"os"
 	"strings"
 
-	"github.com/orinocopay/go-etherutils/cli"
 	"github.com/orinocopay/go-etherutils/ens"
 	"github.com/spf13/cobra"
 )
@@ -35,39 +33,27 @@ var rawInfoCmd = &cobra.Command{
 In quiet mode this will return 0 if the domain is owned, otherwise 1.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
-		registryContract, err := ens.RegistryContract(client)
-		cli.ErrCheck(err, quiet, "Failed to obtain registry contract")
 		if !quiet {
-			registryContractAddress, err := ens.RegistryContractAddress(client)
-			if err == nil {
-				fmt.Println("Registry contract at", registryContractAddress.Hex())
-			}
-		}
-		registrarContract, err := ens.RegistrarContract(client)
-		cli.ErrCheck(err, quiet, "Failed to obtain registrar contract")
-		if !quiet {
-			registrarContractAddress, err := ens.RegistrarContractAddress(client)
-			if err == nil {
-				fmt.Println("Registrar contract at", registrarContractAddress.Hex())
-			}
+			fmt.Println("Registry contract at", registryAddress.Hex())
+			fmt.Println("Registrar contract at", registrarAddress.Hex())
 		}
-		state, deedAddress, registrationDate, value, highestBid, err := ens.Entry(registrarContract, client, args[0])
-		cli.ErrCheck(err, quiet, fmt.Sprintf("Cannot obtain raw info for %s", args[0]))
+		state, deedAddress, registrationDate, value, highestBid, err := mgr.Entry(runCtx, args[0])
+		errCheck(err, fmt.Sprintf("Cannot obtain raw info for %s", args[0]))
 		if quiet {
 			if state == "Owned" {
-				os.Exit(0)
+				exit(0)
 			} else {
-				os.Exit(1)
+				exit(1)
 			}
 		} else {
 			nameHash := ens.NameHash(args[0])
 			fmt.Println("\nRegistry")
 			fmt.Println("~~~~~~~~")
-			registryOwner, err := registryContract.Owner(nil, nameHash)
+			registryOwner, err := registryContract.Owner(callOpts(), nameHash)
 			if err == nil {
 				fmt.Println("Owner:", registryOwner.Hex())
 			}
-			resolver, err := registryContract.Resolver(nil, nameHash)
+			resolver, err := registryContract.Resolver(callOpts(), nameHash)
 			if err == nil {
 				fmt.Println("Resolver:", resolver.Hex())
 			}
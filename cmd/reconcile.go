@@ -0,0 +1,47 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var reconcileAccountStr string
+var reconcileYes bool
+
+// reconcileCmd represents the reconcile command
+var reconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Reconcile ENS names with a zone file",
+	Long: `Bring names registered with the Ethereum Name Service (ENS) in to line with a zone file created by 'ens export'.  For example:
+
+    ens reconcile --account=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --passphrase="my secret passphrase" enstest.yaml
+
+The registry and resolver transactions required to bring the names in to line with the zone file are displayed and, once confirmed, sent with sequential nonces.  Items that are not present in the zone file are left as-is.
+
+The keystore for the account must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
+
+In quiet mode this will return 0 if all transactions are sent successfully, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		applyManifest(args[0], reconcileAccountStr, reconcileYes)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(reconcileCmd)
+
+	reconcileCmd.Flags().StringVarP(&reconcileAccountStr, "account", "a", "", "Account from which to send transactions")
+	reconcileCmd.Flags().BoolVarP(&reconcileYes, "yes", "y", false, "Send transactions without asking for confirmation")
+	addTransactionFlags(reconcileCmd, "Passphrase for the account that sends the transactions")
+}
@@ -0,0 +1,96 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// resolverRecordsABI is the ABI of the record functions of a public resolver
+// that are not covered by the standard resolver contract
+const resolverRecordsABI = `[
+{"constant":true,"inputs":[{"name":"node","type":"bytes32"},{"name":"key","type":"string"}],"name":"text","outputs":[{"name":"ret","type":"string"}],"type":"function"},
+{"constant":false,"inputs":[{"name":"node","type":"bytes32"},{"name":"key","type":"string"},{"name":"value","type":"string"}],"name":"setText","outputs":[],"type":"function"},
+{"constant":true,"inputs":[{"name":"node","type":"bytes32"},{"name":"contentTypes","type":"uint256"}],"name":"ABI","outputs":[{"name":"contentType","type":"uint256"},{"name":"data","type":"bytes"}],"type":"function"},
+{"constant":false,"inputs":[{"name":"node","type":"bytes32"},{"name":"contentType","type":"uint256"},{"name":"data","type":"bytes"}],"name":"setABI","outputs":[],"type":"function"},
+{"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"content","outputs":[{"name":"ret","type":"bytes32"}],"type":"function"},
+{"constant":false,"inputs":[{"name":"node","type":"bytes32"},{"name":"hash","type":"bytes32"}],"name":"setContent","outputs":[],"type":"function"}
+]`
+
+// textChangedTopic is the topic of the TextChanged event emitted by resolvers
+var textChangedTopic = crypto.Keccak256Hash([]byte("TextChanged(bytes32,string,string)"))
+
+// resolverRecords provides access to the text, ABI and content records of a
+// resolver
+type resolverRecords struct {
+	address  common.Address
+	contract *bind.BoundContract
+}
+
+func newResolverRecords(address common.Address) (*resolverRecords, error) {
+	parsed, err := abi.JSON(strings.NewReader(resolverRecordsABI))
+	if err != nil {
+		return nil, err
+	}
+	return &resolverRecords{
+		address:  address,
+		contract: bind.NewBoundContract(address, parsed, chain, chain),
+	}, nil
+}
+
+// Text obtains a text record
+func (r *resolverRecords) Text(opts *bind.CallOpts, node [32]byte, key string) (string, error) {
+	var ret string
+	err := r.contract.Call(opts, &ret, "text", node, key)
+	return ret, err
+}
+
+// SetText sets a text record
+func (r *resolverRecords) SetText(opts *bind.TransactOpts, node [32]byte, key string, value string) (*types.Transaction, error) {
+	return r.contract.Transact(opts, "setText", node, key, value)
+}
+
+// ABI obtains an ABI record of one of the requested content types
+func (r *resolverRecords) ABI(opts *bind.CallOpts, node [32]byte, contentTypes *big.Int) (*big.Int, []byte, error) {
+	ret := new(struct {
+		ContentType *big.Int
+		Data        []byte
+	})
+	err := r.contract.Call(opts, ret, "ABI", node, contentTypes)
+	return ret.ContentType, ret.Data, err
+}
+
+// SetABI sets an ABI record
+func (r *resolverRecords) SetABI(opts *bind.TransactOpts, node [32]byte, contentType *big.Int, data []byte) (*types.Transaction, error) {
+	return r.contract.Transact(opts, "setABI", node, contentType, data)
+}
+
+// Content obtains the content hash
+func (r *resolverRecords) Content(opts *bind.CallOpts, node [32]byte) ([32]byte, error) {
+	var ret [32]byte
+	err := r.contract.Call(opts, &ret, "content", node)
+	return ret, err
+}
+
+// SetContent sets the content hash
+func (r *resolverRecords) SetContent(opts *bind.TransactOpts, node [32]byte, hash [32]byte) (*types.Transaction, error) {
+	return r.contract.Transact(opts, "setContent", node, hash)
+}
@@ -0,0 +1,126 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/ens/contract"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/orinocopay/go-etherutils/ens"
+)
+
+// takeName gives the account a name, taking each of its labels in turn from
+// the root node that the account owns after deployment
+func takeName(t *testing.T, c *simulatedChain, output string, name string) string {
+	t.Helper()
+	registry := common.HexToAddress(outputValue(t, output, "Registry is"))
+	ensRegistry, err := contract.NewENS(registry, c.backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		parent := [32]byte{}
+		if i < len(labels)-1 {
+			parent = ens.NameHash(strings.Join(labels[i+1:], "."))
+		}
+		label := labels[i]
+		c.transact(t, "set up "+label, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return ensRegistry.SetSubnodeOwner(opts, parent, ens.LabelHash(label), c.account)
+		})
+	}
+	return name
+}
+
+// TestRecords sets the resolver and address of a name with the contracts
+// built in to go-ethereum.  The name is below a top-level domain other than
+// 'eth' so that it is not subject to the registrar
+func TestRecords(t *testing.T) {
+	c := newSimulatedChain(t)
+	output := c.deploy(t, "fifs", "")
+	resolver := outputValue(t, output, "Public resolver is")
+	name := takeName(t, c, output, "records.sim.test")
+
+	if owner := lastLine(c.run(t, "owner", name)); owner != c.account.Hex() {
+		t.Errorf("owner is %s, expected %s", owner, c.account.Hex())
+	}
+
+	// The resolver defaults to the public resolver
+	c.transactWith(t, "resolver", "set", "--address=", name)
+	if value := lastLine(c.run(t, "resolver", name)); value != resolver {
+		t.Errorf("resolver is %s, expected %s", value, resolver)
+	}
+
+	address := common.HexToAddress("0x90f8bf6a479f320ead074411a4b0e7944ea8c9c1")
+	c.transactWith(t, "address", "set", "--address="+address.Hex(), name)
+	if value := lastLine(c.run(t, "address", name)); value != address.Hex() {
+		t.Errorf("address is %s, expected %s", value, address.Hex())
+	}
+
+	// A command that fails returns its error
+	if _, err := c.runErr(t, "address", "set", "--address="+address.Hex(), "--passphrase="+simulatedPassphrase, "unowned.sim.test"); err == nil {
+		t.Error("setting the address of a name without an owner succeeded")
+	}
+}
+
+// TestReverse sets the name of the account with the reverse registrar, and
+// looks it up
+func TestReverse(t *testing.T) {
+	contracts := contractsFor(t, "ReverseRegistrar")
+	c := newSimulatedChain(t)
+	output := c.deploy(t, "fifs", contracts)
+	if !strings.Contains(output, "Reverse registrar is") {
+		t.Fatalf("no reverse registrar in %s", contracts)
+	}
+	name := takeName(t, c, output, "records.sim.test")
+	c.transactWith(t, "resolver", "set", "--address=", name)
+	c.transactWith(t, "address", "set", "--address="+c.account.Hex(), name)
+
+	c.transactWith(t, "name", "set", "--name="+name, c.account.Hex())
+	if value := lastLine(c.run(t, "name", c.account.Hex())); value != name {
+		t.Errorf("name is %s, expected %s", value, name)
+	}
+}
+
+// TestDNSName manages a name directly under a top-level domain other than
+// 'eth', as a name imported from DNS is, which is not subject to the
+// registrar
+func TestDNSName(t *testing.T) {
+	c := newSimulatedChain(t)
+	output := c.deploy(t, "fifs", "")
+	name := takeName(t, c, output, "example.xyz")
+
+	if value := lastLine(c.run(t, "availability", name)); value != "Owned" {
+		t.Errorf("availability is %s, expected Owned", value)
+	}
+	if info := c.run(t, "info", name); !strings.Contains(info, c.account.Hex()) {
+		t.Errorf("info does not show the owner: %s", info)
+	}
+
+	c.transactWith(t, "resolver", "set", "--address=", name)
+	address := common.HexToAddress("0x90f8bf6a479f320ead074411a4b0e7944ea8c9c1")
+	c.transactWith(t, "address", "set", "--address="+address.Hex(), name)
+	if value := lastLine(c.run(t, "address", name)); value != address.Hex() {
+		t.Errorf("address is %s, expected %s", value, address.Hex())
+	}
+
+	c.transactWith(t, "controller", "set", "--address="+address.Hex(), name)
+	if owner := lastLine(c.run(t, "owner", name)); owner != address.Hex() {
+		t.Errorf("owner is %s, expected %s", owner, address.Hex())
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/orinocopay/ens/manager"
+	etherutils "github.com/orinocopay/go-etherutils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var registerAddressStr string
+var registerOwnerStr string
+var registerDurationStr string
+
+// rentMargin is the percentage added to the rent price when registering or
+// renewing, to allow for the price changing before the transaction is mined.
+// Any excess is refunded
+var rentMargin = big.NewInt(5)
+
+// registerCmd represents the register command
+var registerCmd = &cobra.Command{
+	Use:   "register",
+	Short: "Register an ENS name with the permanent registrar",
+	Long: `Register a name with the Ethereum Name Service (ENS) permanent registrar.  For example:
+
+    ens register --address=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --passphrase="my secret passphrase" --duration=1y enstest.eth
+
+Registration is in two steps.  First a commitment to the name is sent, then once the controller's minimum commitment age has passed the name is registered with the rent for the duration.  The secret behind the commitment is kept locally until the name is registered, so if the command is interrupted or times out running it again resumes the registration.  The minimum commitment age is usually a minute, so unless a shorter --timeout is given the registration completes in a single run.
+
+The duration is given in years (y), days (d) or hours (h), for example '1y' or '90d', and must be at least 28 days.  The rent is sent with a small margin to allow for price changes; the excess is refunded.
+
+The keystore for the address must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
+
+In quiet mode this will return 0 if the name is registered, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+
+		// Default to the account from the network profile
+		if registerAddressStr == "" {
+			registerAddressStr = defaultAccount
+		}
+		assert(registerAddressStr != "", "Address from which to register is required")
+		from, err := resolveName(registerAddressStr)
+		errCheck(err, "Failed to obtain address")
+		owner := from
+		if registerOwnerStr != "" {
+			owner, err = resolveName(registerOwnerStr)
+			errCheck(err, "Failed to obtain owner")
+		}
+		duration, err := parsePeriod(registerDurationStr)
+		errCheck(err, "Invalid duration")
+		assert(duration >= manager.MinRegistrationDuration, "Duration must be at least 28 days")
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+
+		minAge, maxAge, err := mgr.CommitmentAges(runCtx)
+		errCheck(err, "Failed to obtain registrar controller")
+
+		// Resume a pending registration if there is one
+		registration, err := loadRegistration(name)
+		errCheck(err, "Failed to load pending registration")
+		var commitTime time.Time
+		if registration != nil && registration.Owner != owner {
+			// The commitment is to a different owner
+			registration = nil
+		}
+		if registration != nil {
+			commitTime = pendingCommitTime(name, registration)
+			if !commitTime.IsZero() && time.Since(commitTime) > maxAge {
+				// Too late to use
+				registration = nil
+				commitTime = time.Time{}
+			}
+		}
+
+		if registration == nil {
+			available, err := mgr.Available(runCtx, name)
+			errCheck(err, "Failed to obtain availability")
+			assert(available, "Name is not available")
+
+			registration = &pendingRegistration{Name: name, Owner: owner}
+			_, err = rand.Read(registration.Secret[:])
+			errCheck(err, "Failed to generate secret")
+			errCheck(saveRegistration(registration), "Failed to save pending registration")
+		} else if !quiet {
+			fmt.Println("Resuming registration")
+		}
+
+		if commitTime.IsZero() {
+			commitment, err := mgr.MakeCommitment(runCtx, name, owner, registration.Secret)
+			errCheck(err, "Failed to make commitment")
+			tx, err := mgr.Commit(runCtx, from, commitment, opts)
+			errCheck(err, "Failed to send commitment")
+			registration.CommitTx = tx.Hash().Hex()
+			errCheck(saveRegistration(registration), "Failed to save pending registration")
+			if !quiet {
+				fmt.Println("Commitment transaction ID is", tx.Hash().Hex())
+			}
+			log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+				"name":      name,
+				"networkid": chainID,
+				"address":   from.Hex(),
+				"owner":     owner.Hex()}).Info("Registration commit")
+
+			_, err = mgr.WaitMined(runCtx, tx)
+			checkWait(err, "Commitment failed")
+			commitTime, err = mgr.CommitmentTime(runCtx, commitment)
+			errCheck(err, "Failed to obtain commitment")
+			assert(!commitTime.IsZero(), "Commitment not found")
+		}
+
+		// The commitment must reach the minimum age on the chain
+		checkWait(waitForChainTime(commitTime.Add(minAge)), "Failed to wait for commitment")
+
+		price, err := mgr.RentPrice(runCtx, name, duration)
+		errCheck(err, "Failed to obtain rent price")
+		value := withRentMargin(price)
+		if !quiet {
+			fmt.Println("Rent is", etherutils.WeiToString(price, true))
+		}
+
+		tx, err := mgr.Register(runCtx, from, name, owner, duration, registration.Secret, value, opts)
+		errCheck(err, "Failed to send registration")
+		if !quiet {
+			fmt.Println("Registration transaction ID is", tx.Hash().Hex())
+		}
+		log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+			"name":      name,
+			"networkid": chainID,
+			"address":   from.Hex(),
+			"owner":     owner.Hex(),
+			"duration":  duration,
+			"value":     value}).Info("Registration register")
+
+		_, err = mgr.WaitMined(runCtx, tx)
+		checkWait(err, "Registration failed")
+		if err = removeRegistration(name); err != nil {
+			log.WithFields(log.Fields{"name": name, "error": err}).Warn("Failed to remove pending registration")
+		}
+		if !quiet {
+			fmt.Println("Registered", name)
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(registerCmd)
+
+	registerCmd.Flags().StringVarP(&registerAddressStr, "address", "a", "", "Address from which to register the name")
+	registerCmd.Flags().StringVarP(&registerOwnerStr, "owner", "o", "", "Owner of the name (default is the registering address)")
+	registerCmd.Flags().StringVarP(&registerDurationStr, "duration", "d", "1y", "Duration of the registration")
+	addTransactionFlags(registerCmd, "Passphrase for the account that registers the name")
+}
+
+// pendingCommitTime returns the time at which the commitment of a pending
+// registration was mined, waiting for the commitment transaction if it is
+// still pending.  It returns the zero time if the commitment needs to be sent
+func pendingCommitTime(name string, registration *pendingRegistration) time.Time {
+	commitment, err := mgr.MakeCommitment(runCtx, name, registration.Owner, registration.Secret)
+	errCheck(err, "Failed to make commitment")
+	if registration.CommitTx != "" {
+		tx, isPending, err := chain.TransactionByHash(runCtx, common.HexToHash(registration.CommitTx))
+		if err == nil && isPending {
+			if !quiet {
+				fmt.Println("Waiting for commitment transaction", registration.CommitTx)
+			}
+			_, err = mgr.WaitMined(runCtx, tx)
+			checkWait(err, "Commitment failed")
+		}
+	}
+	commitTime, err := mgr.CommitmentTime(runCtx, commitment)
+	errCheck(err, "Failed to obtain commitment")
+	return commitTime
+}
+
+// waitForChainTime waits until the latest block is at or after the given
+// time
+func waitForChainTime(target time.Time) error {
+	waiting := false
+	for {
+		header, err := chain.HeaderByNumber(runCtx, nil)
+		if err != nil {
+			return err
+		}
+		if header.Time.Int64() >= target.Unix() {
+			return nil
+		}
+		if !waiting && !quiet {
+			fmt.Println("Waiting until", target)
+			waiting = true
+		}
+		select {
+		case <-runCtx.Done():
+			return runCtx.Err()
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+// checkWait checks the result of waiting for the chain, exiting as cancelled
+// if the run was cancelled
+func checkWait(err error, msg string) {
+	if err != nil && cancelled() {
+		exitCancelled()
+	}
+	errCheck(err, msg)
+}
+
+// parsePeriod parses a period given in years, days or hours, for example
+// '1y' or '90d'.  A year is 365 days
+func parsePeriod(input string) (time.Duration, error) {
+	input = strings.TrimSpace(input)
+	units := map[string]time.Duration{
+		"y": 365 * 24 * time.Hour,
+		"d": 24 * time.Hour,
+		"h": time.Hour,
+	}
+	for suffix, unit := range units {
+		if strings.HasSuffix(input, suffix) {
+			count, err := strconv.ParseFloat(strings.TrimSuffix(input, suffix), 64)
+			if err != nil || count <= 0 {
+				return 0, fmt.Errorf("invalid period %s", input)
+			}
+			return time.Duration(count * float64(unit)), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid period %s", input)
+}
@@ -0,0 +1,99 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// pendingRegistration is a registration that has been started but not
+// completed.  The secret must be kept until the name is registered, so it is
+// held locally to allow an interrupted registration to resume
+type pendingRegistration struct {
+	Name     string         `json:"name"`
+	Owner    common.Address `json:"owner"`
+	Secret   common.Hash    `json:"secret"`
+	CommitTx string         `json:"committx,omitempty"`
+}
+
+// registrationPath returns the path of the pending registration file for a
+// name
+func registrationPath(name string) (string, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(home, ".ens", "registrations")
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, fmt.Sprintf("%v-%s.json", chainID, name)), nil
+}
+
+// loadRegistration loads the pending registration for a name.  If there is
+// no pending registration then this returns nil
+func loadRegistration(name string) (*pendingRegistration, error) {
+	path, err := registrationPath(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	registration := &pendingRegistration{}
+	if err = json.Unmarshal(data, registration); err != nil {
+		return nil, fmt.Errorf("invalid pending registration in %s: %v", path, err)
+	}
+	return registration, nil
+}
+
+// saveRegistration saves a pending registration
+func saveRegistration(registration *pendingRegistration) error {
+	path, err := registrationPath(registration.Name)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(registration)
+	if err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// removeRegistration removes the pending registration for a name
+func removeRegistration(name string) error {
+	path, err := registrationPath(name)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,125 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	etherutils "github.com/orinocopay/go-etherutils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var renewAddressStr string
+var renewDurationStr string
+var renewQuote bool
+
+// renewCmd represents the renew command
+var renewCmd = &cobra.Command{
+	Use:   "renew",
+	Short: "Renew ENS names with the permanent registrar",
+	Long: `Extend the registration of one or more names with the Ethereum Name Service (ENS) permanent registrar.  For example:
+
+    ens renew --address=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --passphrase="my secret passphrase" --duration=1y enstest.eth enstest2.eth
+
+The rent is quoted before the renewal is sent, or with --quote only the rent is shown.  Multiple names are renewed in a single transaction if a bulk renewal contract is published for 'eth', otherwise with a transaction for each name.  The rent is sent with a small margin to allow for price changes; the excess is refunded.
+
+The duration is given in years (y), days (d) or hours (h), for example '1y' or '90d', and must be at least 28 days.  Any address can renew a name.
+
+The keystore for the address must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
+
+In quiet mode this will return 0 if the renewal transactions are sent successfully, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		for i := range args {
+			args[i] = ensName(args[i])
+		}
+		assert(mgr.Permanent(), "Names can only be renewed with the permanent registrar")
+		duration, err := parsePeriod(renewDurationStr)
+		errCheck(err, "Invalid duration")
+
+		// Quote the rent, with the bulk renewal contract if there is one
+		bulk := false
+		var price *big.Int
+		if len(args) > 1 {
+			price, err = mgr.BulkRentPrice(runCtx, args, duration)
+			bulk = err == nil
+		}
+		prices := make([]*big.Int, len(args))
+		if !bulk {
+			price = big.NewInt(0)
+			for i, name := range args {
+				prices[i], err = mgr.RentPrice(runCtx, name, duration)
+				errCheck(err, fmt.Sprintf("Failed to obtain rent price for %s", name))
+				price.Add(price, prices[i])
+			}
+		}
+		if !quiet {
+			fmt.Printf("Rent for %d name(s) for %s is %s\n", len(args), renewDurationStr, etherutils.WeiToString(price, true))
+		}
+		if renewQuote {
+			exit(0)
+		}
+
+		// Default to the account from the network profile
+		if renewAddressStr == "" {
+			renewAddressStr = defaultAccount
+		}
+		assert(renewAddressStr != "", "Address from which to renew is required")
+		from, err := resolveName(renewAddressStr)
+		errCheck(err, "Failed to obtain address")
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+
+		if bulk {
+			tx, err := mgr.RenewAll(runCtx, from, args, duration, withRentMargin(price), opts)
+			errCheck(err, "Failed to send renewal")
+			noteRenewal(tx, args, duration.String())
+			return
+		}
+		for i, name := range args {
+			tx, err := mgr.Renew(runCtx, from, name, duration, withRentMargin(prices[i]), opts)
+			errCheck(err, fmt.Sprintf("Failed to send renewal for %s", name))
+			noteRenewal(tx, []string{name}, duration.String())
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(renewCmd)
+
+	renewCmd.Flags().StringVarP(&renewAddressStr, "address", "a", "", "Address from which to renew the names")
+	renewCmd.Flags().StringVarP(&renewDurationStr, "duration", "d", "1y", "Duration by which to extend the registrations")
+	renewCmd.Flags().BoolVar(&renewQuote, "quote", false, "Only quote the rent")
+	addTransactionFlags(renewCmd, "Passphrase for the account that renews the names")
+}
+
+// withRentMargin returns a price with the rent margin added
+func withRentMargin(price *big.Int) *big.Int {
+	value := new(big.Int).Mul(price, rentMargin)
+	value.Div(value, big.NewInt(100))
+	return value.Add(value, price)
+}
+
+// noteRenewal notes a sent renewal transaction
+func noteRenewal(tx *types.Transaction, names []string, duration string) {
+	if !quiet {
+		fmt.Println("Transaction ID is", tx.Hash().Hex())
+	}
+	log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+		"names":     names,
+		"networkid": chainID,
+		"duration":  duration}).Info("Renew")
+}
@@ -16,7 +16,6 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/orinocopay/go-etherutils/cli"
 	"github.com/orinocopay/go-etherutils/ens"
 	"github.com/spf13/cobra"
 )
@@ -32,14 +31,12 @@ var resolverCmd = &cobra.Command{
 In quiet mode this will return 0 if the name has a resolver, otherwise 1.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
-		registrarContract, err := ens.RegistrarContract(client)
-		inState, err := ens.NameInState(registrarContract, client, args[0], "Owned")
-		cli.ErrAssert(inState, err, quiet, "Name not in a suitable state to obtain the resolver")
-
-		registryContract, err := ens.RegistryContract(client)
-		cli.ErrCheck(err, quiet, "Failed to obtain registry contract")
-		resolver, err := ens.Resolver(registryContract, args[0])
-		cli.ErrCheck(err, quiet, "No resolver for that name")
+		inState, err := nameInState(args[0], "Owned")
+		errAssert(inState, err, "Name not in a suitable state to obtain the resolver")
+
+		resolver, err := mgr.Resolver(runCtx, args[0])
+		errCheck(err, "Failed to obtain resolver")
+		assert(resolver != ens.UnknownAddress, "No resolver for that name")
 		if !quiet {
 			fmt.Println(resolver.Hex())
 		}
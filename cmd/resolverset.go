@@ -14,13 +14,9 @@
 package cmd
 
 import (
-	"bytes"
 	"fmt"
-	"math/big"
 
-	etherutils "github.com/orinocopay/go-etherutils"
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/ens/manager"
 	"github.com/spf13/cobra"
 )
 
@@ -36,41 +32,29 @@ var resolverSetCmd = &cobra.Command{
 
 If the address is not supplied then the public resolver for the network will be used.
 
+If the name is wrapped then the resolver is set with the name wrapper, by the owner of the wrapped name.
+
 The keystore for the account that owns the name must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
 
 In quiet mode this will return 0 if the transaction to set the resolver is sent successfully, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		// Ensure that the name is in a suitable state
-		if ens.DomainLevel(args[0]) == 1 {
-			cli.Assert(inState(args[0], "Owned"), quiet, "Domain not in a suitable state to set a resolver")
-		}
-
-		// Fetch the owner of the name
-		owner, err := registryContract.Owner(nil, ens.NameHash(args[0]))
-		cli.ErrCheck(err, quiet, "Cannot obtain owner")
-		cli.Assert(bytes.Compare(owner.Bytes(), ens.UnknownAddress.Bytes()) != 0, quiet, "Owner is not set")
-
-		// Fetch the wallet and account for the owner
-		wallet, account, err := obtainWalletAndAccount(owner, passphrase)
-		cli.ErrCheck(err, quiet, "Failed to obtain account details for the owner of the domain")
-
-		gasPrice, err := etherutils.StringToWei(gasPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid gas price")
-
-		// Set up our session
-		session := ens.CreateRegistrySession(chainID, &wallet, account, passphrase, registryContract, gasPrice)
-		if nonce != -1 {
-			session.TransactOpts.Nonce = big.NewInt(nonce)
+		if manager.IsETH2LD(args[0]) {
+			assert(inState(args[0], "Owned"), "Domain not in a suitable state to set a resolver")
 		}
 
 		// Set the resolver from either command-line or default
-		resolverAddress, err := ens.Resolve(client, resolverAddressStr)
+		resolverAddress, err := resolveName(resolverAddressStr)
 		if err != nil {
-			resolverAddress, err = ens.PublicResolver(client)
-			cli.ErrCheck(err, quiet, "No public resolver for that network")
+			resolverAddress, err = publicResolver()
+			errCheck(err, "No public resolver for that network")
 		}
-		tx, err := ens.SetResolver(session, args[0], &resolverAddress)
-		cli.ErrCheck(err, quiet, "Failed to send transaction")
+
+		// The owner of the name, or of the wrapped name, sets the resolver
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+		tx, err := mgr.SetResolver(runCtx, args[0], resolverAddress, opts)
+		errCheck(err, "Failed to send transaction")
 		if !quiet {
 			fmt.Println("Transaction ID is", tx.Hash().Hex())
 		}
@@ -14,8 +14,6 @@
 package cmd
 
 import (
-	"context"
-	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"math/big"
@@ -26,7 +24,10 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	homedir "github.com/mitchellh/go-homedir"
+	"github.com/orinocopay/ens/manager"
+	etherutils "github.com/orinocopay/go-etherutils"
 	"github.com/orinocopay/go-etherutils/cli"
 	"github.com/orinocopay/go-etherutils/ens"
 	"github.com/orinocopay/go-etherutils/ens/registrarcontract"
@@ -40,6 +41,12 @@ var cfgFile string
 var logFile string
 var quiet bool
 var connection string
+var network string
+var readOnly bool
+var noSuffix bool
+
+// Default account from the network profile
+var defaultAccount string
 
 var client *ethclient.Client
 var chainID *big.Int
@@ -50,14 +57,25 @@ var gasPriceStr string
 var nonce int64
 
 // Common contracts
+var mgr *manager.Manager
+var registryStr string
+var registryAddress common.Address
 var registryContract *registrycontract.RegistryContract
+var registrarAddress common.Address
 var registrarContract *registrarcontract.RegistrarContract
 
+// dialRPC creates the RPC client for a connection.  It can be replaced to run
+// commands against an alternative backend, for example a simulated chain
+// served with rpc.DialInProc
+var dialRPC = rpc.Dial
+
 // RootCmd represents the base command when called without any subcommands
 var RootCmd = &cobra.Command{
-	Use:              "ens",
-	Short:            "manage ENS entries",
-	Long:             `Manage entries for the Ethereum Name Service (ENS).  Details of each indiidual command are available in the help files for the relevant command`,
+	Use:   "ens",
+	Short: "manage ENS entries",
+	Long: `Manage entries for the Ethereum Name Service (ENS).  Details of each indiidual command are available in the help files for the relevant command.
+
+Names are completed with '.eth' unless they end with a top-level domain; see 'ens hash --help' for how names are completed and normalized.  Network profiles and the connection to the Ethereum node are described in 'ens node --help'.`,
 	PersistentPreRun: persistentPreRun,
 }
 
@@ -66,71 +84,183 @@ func persistentPreRun(cmd *cobra.Command, args []string) {
 		// User just wants help
 		return
 	}
+	resetConnections()
 
-	// Ensure that the first argument is present
-	if len(args) == 0 {
-		cli.Err(quiet, "This command requires a name")
-	}
-	if args[0] == "" {
-		cli.Err(quiet, "This command requires a name")
-	}
-
-	if cmd.Name() != "nonce" {
-		// Add '.eth' to the end of the name if not present
-		if !strings.HasSuffix(args[0], ".eth") {
-			// Might be a hex address
-			if len(args[0]) == 40 || len(args[0]) == 42 {
-				_, err := hex.DecodeString(args[0])
-				if err != nil {
-					// Might be a hex address with leading 0x
-					if len(args[0]) > 2 && strings.HasPrefix(args[0], "0x") {
-						_, err = hex.DecodeString(args[0][2:])
-					}
-					if err != nil {
-						// Not a valid hex string
-						args[0] += ".eth"
-					}
-				}
-			} else {
-				// Not a hex string
-				args[0] += ".eth"
-			}
+	// Ensure that the first argument is present, unless names are supplied in
+	// bulk or not required
+	if !namesOptional(cmd) {
+		if len(args) == 0 {
+			fail("This command requires a name")
+		}
+		if args[0] == "" {
+			fail("This command requires a name")
 		}
 	}
 
 	// Set the log file if set, otherwise ignore
 	if logFile != "" {
 		f, err := os.OpenFile(logFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
-		cli.ErrCheck(err, quiet, "Failed to open log file")
+		errCheck(err, "Failed to open log file")
 		log.SetOutput(f)
 		log.SetFormatter(&log.JSONFormatter{})
 	} else {
 		log.SetOutput(ioutil.Discard)
 	}
 
-	// Create a connection to an Ethereum node
-	var err error
-	client, err = ethclient.Dial(connection)
-	cli.ErrCheck(err, quiet, "Failed to connect to Ethereum")
-	// Fetch the chain ID
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	chainID, err = client.NetworkID(ctx)
-	cli.ErrCheck(err, quiet, "Failed to obtain chain ID")
-
-	// Set up the common contracts
-	registryContract, err = ens.RegistryContract(client)
-	cli.ErrCheck(err, quiet, "Cannot obtain ENS registry contract")
-	registrarContract, err = ens.RegistrarContract(client)
-	cli.ErrCheck(err, quiet, "Cannot obtain ENS registrar contract")
+	// Refuse commands that send transactions in read-only mode
+	if !cmd.Flags().Changed("read-only") {
+		readOnly = viper.GetBool("read-only")
+	}
+	if readOnly {
+		assert(cmd.Flags().Lookup("passphrase") == nil, "This command sends transactions so cannot be used in read-only mode")
+	}
+
+	// Bound the run by the timeout and allow it to be interrupted.  Commands
+	// that send transactions wait for them to be mined so are only bound if
+	// asked
+	if cmd.Flags().Lookup("passphrase") != nil && !cmd.Flags().Changed("timeout") {
+		timeout = 0
+	}
+	startRun()
+
+	// Transactions start from the nonce given, if any
+	nextNonce = nonce
+
+	// Apply the network profile, if any
+	profile, err := loadNetworkProfile()
+	errCheck(err, "Failed to load network profile")
+	if profile != nil {
+		if !cmd.Flags().Changed("connection") {
+			if len(profile.Connections) > 0 {
+				connection = strings.Join(append([]string{profile.Connection}, profile.Connections...), ",")
+			} else if profile.Connection != "" {
+				connection = profile.Connection
+			}
+		}
+		if !readOnly {
+			// Transaction defaults
+			if profile.GasPrice != "" && !cmd.Flags().Changed("gasprice") {
+				gasPriceStr = profile.GasPrice
+			}
+			defaultAccount = profile.Account
+		}
+	}
+
+	if cmd == nodeStatusCmd {
+		// Endpoints are contacted individually
+		return
+	}
+
+	// Create connections to the Ethereum nodes
+	var requiredChainID int64
+	if profile != nil {
+		requiredChainID = profile.ChainID
+	}
+	err = connect(requiredChainID)
+	errCheck(err, "Failed to connect to Ethereum")
+
+	if cmd.Name() == "deploy" {
+		// Contracts do not exist yet
+		return
+	}
+
+	// Set up the manager for the registry
+	if registryStr == "" && profile != nil {
+		registryStr = profile.Registry
+	}
+	if registryStr == "" {
+		registryStr = viper.GetString("registry")
+	}
+	registryAddress = ens.UnknownAddress
+	if registryStr != "" {
+		registryAddress, err = manager.ParseAddress(registryStr)
+		errCheck(err, "Invalid registry address")
+	}
+	mgr, err = manager.New(runCtx, client, registryAddress)
+	errCheck(err, "Cannot obtain ENS contracts")
+	var backend manager.Backend = chain
+	if !cmd.Flags().Changed("cache") {
+		useCache = viper.GetBool("cache")
+	}
+	if useCache && cmd.Flags().Lookup("passphrase") == nil {
+		// Lookups only, as results are at the block when the command started
+		cacheBackend, err = manager.NewCacheBackend(backend, chain, chainID, cacheFile())
+		errCheck(err, "Failed to load cache")
+		backend = cacheBackend
+	}
+	mgr, err = mgr.WithBackend(backend)
+	errCheck(err, "Cannot obtain ENS contracts")
+	var block *big.Int
+	if blockStr != "" {
+		// Lookups are made against the given block
+		assert(cmd.Flags().Lookup("passphrase") == nil, "--block cannot be used with commands that send transactions")
+		block, err = queryBlock(blockStr)
+		errCheck(err, "Failed to obtain block")
+		mgr, err = mgr.AtBlock(runCtx, block)
+		errCheck(err, "Cannot obtain ENS contracts")
+		if !quiet {
+			if block == nil {
+				fmt.Println("At pending block")
+			} else {
+				fmt.Println("At block", block)
+			}
+		}
+	}
+	if !namesOptional(cmd) && cmd.Name() != "nonce" && cmd.Name() != "apply" && cmd.Name() != "reconcile" && cmd.Parent() != dnsCmd {
+		// Names are completed once the registry is available
+		args[0] = ensName(args[0])
+	}
+	if quorum > 1 && quorumCommands[cmd.Name()] && cmd.Flags().Lookup("passphrase") == nil {
+		checkQuorum(args[0], block, blockStr != "")
+	}
+	if readOnly {
+		mgr = mgr.ReadOnly()
+	}
+	controllerStr := viper.GetString("controller")
+	if profile != nil && profile.Controller != "" {
+		controllerStr = profile.Controller
+	}
+	if controllerStr != "" {
+		// Registrar controller other than that published for 'eth'
+		controllerAddress, err := manager.ParseAddress(controllerStr)
+		errCheck(err, "Invalid registrar controller address")
+		mgr = mgr.WithController(controllerAddress)
+	}
+	nameWrapperStr := viper.GetString("namewrapper")
+	if profile != nil && profile.NameWrapper != "" {
+		nameWrapperStr = profile.NameWrapper
+	}
+	if nameWrapperStr != "" {
+		// Name wrapper other than that published for 'eth'
+		nameWrapperAddress, err := manager.ParseAddress(nameWrapperStr)
+		errCheck(err, "Invalid name wrapper address")
+		mgr = mgr.WithNameWrapper(nameWrapperAddress)
+	}
+	if viper.IsSet("ccip-gateways") || viper.IsSet("ccip-recursion") {
+		// Restrictions on off-chain lookups
+		maxLookups := 4
+		if viper.IsSet("ccip-recursion") {
+			maxLookups = viper.GetInt("ccip-recursion")
+		}
+		mgr = mgr.WithOffchainLookups(viper.GetStringSlice("ccip-gateways"), maxLookups)
+	}
+	registryAddress = mgr.RegistryAddress()
+	registryContract = mgr.Registry()
+	registrarAddress = mgr.RegistrarAddress()
+	registrarContract = mgr.Registrar()
 }
 
-// Execute adds all child commands to the root command and sets flags appropriately.
-// This is called by main.main(). It only needs to happen once to the rootCmd.
-func Execute() {
-	if err := RootCmd.Execute(); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+// namesOptional returns true if a command can run without a name
+func namesOptional(cmd *cobra.Command) bool {
+	switch cmd {
+	case deployCmd, nodeStatusCmd:
+		return true
+	case availabilityCmd:
+		return availabilityFile != ""
+	case expiryCheckCmd:
+		return expiryCheckFile != ""
+	default:
+		return false
 	}
 }
 
@@ -141,7 +271,15 @@ func init() {
 	RootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.cmd.yaml)")
 	RootCmd.PersistentFlags().StringVarP(&logFile, "log", "l", "", "log activity to the named file")
 	RootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "no output")
-	RootCmd.PersistentFlags().StringVarP(&connection, "connection", "c", "https://api.orinocopay.com:8546/", "path to the Ethereum connection")
+	RootCmd.PersistentFlags().StringVarP(&connection, "connection", "c", "https://api.orinocopay.com:8546/", "path to the Ethereum connection; separate several paths with commas for failover")
+	RootCmd.PersistentFlags().IntVar(&quorum, "quorum", 1, "number of endpoints that must agree on lookups")
+	RootCmd.PersistentFlags().StringVar(&network, "network", "", "network profile from the config file; see 'ens node --help'")
+	RootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 2*time.Minute, "maximum time for the command to run, or 0 for no limit; commands that send transactions have no limit unless given, and time spent confirming a plan is not counted.  A command that times out or is interrupted stops with a non-zero exit status; a second Ctrl-C stops it immediately")
+	RootCmd.PersistentFlags().BoolVar(&readOnly, "read-only", false, "refuse to load keystores or send transactions (also the 'read-only' configuration key)")
+	RootCmd.PersistentFlags().BoolVar(&useCache, "cache", false, "cache the results of lookups on disk (also the 'cache' configuration key), in $HOME/.ens-cache.json or the 'cachefile' configuration key.  Cached results are looked up again if the registry, registrar or resolver logs since they were obtained show that they might have changed")
+	RootCmd.PersistentFlags().StringVar(&blockStr, "block", "", "block at which to look up information: number, hash, 'latest' or 'pending' (default is the latest block).  The block queried is stated before any other output")
+	RootCmd.PersistentFlags().StringVar(&registryStr, "registry", "", "address of the ENS registry (default is the registry for the network); see 'ens node --help'")
+	RootCmd.PersistentFlags().BoolVar(&noSuffix, "no-suffix", false, "use names exactly as given, without adding '.eth'")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -181,16 +319,84 @@ func addTransactionFlags(cmd *cobra.Command, passphraseExplanation string) {
 	cmd.Flags().Int64VarP(&nonce, "nonce", "n", -1, "Nonce for the transaction; -1 is auto-select")
 }
 
+// fullName returns the full name for a name given on the command line,
+// normalized and with '.eth' added where it is meant, warning if the name
+// looks like another.  Addresses are checked and returned as given, and
+// names with --no-suffix are only normalized
+func fullName(input string) (string, error) {
+	if manager.IsAddress(input) && (strings.HasPrefix(input, "0x") || !noSuffix) {
+		_, err := manager.ParseAddress(input)
+		return input, err
+	}
+	name, err := manager.Normalize(input)
+	if err != nil {
+		return "", err
+	}
+	if lookalike, confusable := manager.Confusable(name); confusable && !quiet {
+		fmt.Fprintf(os.Stderr, "Warning: %s looks like %s\n", name, lookalike)
+	}
+	if noSuffix {
+		return name, nil
+	}
+	return mgr.FullName(runCtx, name)
+}
+
+// ensName returns the full name for a name given on the command line, as
+// fullName, exiting if the name is invalid or ambiguous
+func ensName(input string) string {
+	name, err := fullName(input)
+	errCheck(err, fmt.Sprintf("Invalid name %s", input))
+	return name
+}
+
+// transactionOptions returns the manager options for sending transactions
+// with the common command-line arguments and the local nonce manager
+func transactionOptions() (*manager.TxOpts, error) {
+	gasPrice, err := etherutils.StringToWei(gasPriceStr)
+	if err != nil {
+		return nil, err
+	}
+	return &manager.TxOpts{
+		Passphrase: passphrase,
+		GasPrice:   gasPrice,
+		Account:    obtainWalletAndAccount,
+		Nonce:      setNonce,
+		Sent:       noteTransaction,
+	}, nil
+}
+
 func inState(name string, state string) (inState bool) {
 	// Ensure that the name is in a suitable state
-	inState, err := ens.NameInState(registrarContract, client, name, state)
+	inState, err := nameInState(name, state)
 	if err != nil {
 		inState = false
 	}
 	return
 }
 
+// nameInState returns true if a name is in the given registrar state.  Only
+// names under 'eth' have a state, and with the permanent registrar only names
+// directly under 'eth', so other names are taken to be in any state
+func nameInState(name string, state string) (bool, error) {
+	if !strings.HasSuffix(name, ".eth") {
+		return true, nil
+	}
+	if !manager.IsETH2LD(name) {
+		if mgr.Permanent() {
+			return true, nil
+		}
+		// The auction registrar holds the state of the name under 'eth'
+		labels := strings.Split(name, ".")
+		name = strings.Join(labels[len(labels)-2:], ".")
+	}
+	current, err := mgr.State(runCtx, name)
+	return current == state, err
+}
+
 func obtainWalletAndAccount(address common.Address, passphrase string) (wallet accounts.Wallet, account *accounts.Account, err error) {
+	if readOnly {
+		return nil, nil, manager.ErrReadOnly
+	}
 	wallet, err = cli.ObtainWallet(chainID, address)
 	if err == nil {
 		account, err = cli.ObtainAccount(&wallet, &address, passphrase)
@@ -0,0 +1,435 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The tests in this package run commands against a simulated chain, served
+// through dialRPC, and a command that fails returns its error to the test
+// rather than exiting.  Tests that need contracts not built in to
+// go-ethereum, the auction and reverse registrars, read them from
+// testdata/contracts, or the directory given by ENS_CONTRACTS, in the same
+// form as 'ens deploy --contracts', and are skipped if they are not there
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// simulatedPassphrase is the passphrase of the keystores for the tests
+var simulatedPassphrase = "simulated passphrase"
+
+// simulatedHome is the home directory for the tests, holding the keystores,
+// nonce state and configuration
+var simulatedHome string
+
+func TestMain(m *testing.M) {
+	var err error
+	simulatedHome, err = ioutil.TempDir("", "ens-simulated")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Setenv("HOME", simulatedHome)
+	homedir.DisableCache = true
+	result := m.Run()
+	os.RemoveAll(simulatedHome)
+	os.Exit(result)
+}
+
+// simulatedChain is a simulated chain served to commands over an in-process
+// RPC connection.  Each transaction is mined as soon as it is sent.  The
+// simulated backend only accepts transactions without replay protection, so
+// transactions are signed again with the key of their sender before they are
+// mined
+type simulatedChain struct {
+	backend *backends.SimulatedBackend
+	key     *ecdsa.PrivateKey
+	account common.Address
+	server  *rpc.Server
+
+	mutex sync.Mutex
+	// mined maps the hashes of transactions as sent to their hashes as mined
+	mined map[common.Hash]common.Hash
+}
+
+// newSimulatedChain creates a simulated chain with a funded account, whose
+// keystore is local, and connects commands to it
+func newSimulatedChain(t *testing.T) *simulatedChain {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := crypto.PubkeyToAddress(key.PublicKey)
+	balance := new(big.Int).Exp(big.NewInt(10), big.NewInt(24), nil)
+	c := &simulatedChain{
+		backend: backends.NewSimulatedBackend(core.GenesisAlloc{account: {Balance: balance}}),
+		key:     key,
+		account: account,
+		server:  rpc.NewServer(),
+		mined:   make(map[common.Hash]common.Hash),
+	}
+	ks := keystore.NewKeyStore(filepath.Join(simulatedHome, ".ethereum", "keystore"), keystore.LightScryptN, keystore.LightScryptP)
+	if _, err = ks.ImportECDSA(key, simulatedPassphrase); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.server.RegisterName("eth", &SimulatedEth{chain: c}); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.server.RegisterName("net", &SimulatedNet{}); err != nil {
+		t.Fatal(err)
+	}
+	dialRPC = func(endpoint string) (*rpc.Client, error) {
+		return rpc.DialInProc(c.server), nil
+	}
+
+	// The chain starts at the current time rather than at the epoch
+	c.travel(t, time.Duration(time.Now().Unix())*time.Second)
+	return c
+}
+
+// travel moves the time of the chain forward
+func (c *simulatedChain) travel(t *testing.T, period time.Duration) {
+	if err := c.backend.AdjustTime(period); err != nil {
+		t.Fatal(err)
+	}
+	c.backend.Commit()
+}
+
+// transactor returns options for sending transactions directly to the chain
+// from the account, for setting up state that commands cannot
+func (c *simulatedChain) transactor() *bind.TransactOpts {
+	return bind.NewKeyedTransactor(c.key)
+}
+
+// transact sends a transaction directly to the chain and mines it
+func (c *simulatedChain) transact(t *testing.T, description string, send func(opts *bind.TransactOpts) (*types.Transaction, error)) {
+	if _, err := send(c.transactor()); err != nil {
+		t.Fatalf("%s: %v", description, err)
+	}
+	c.backend.Commit()
+}
+
+// send mines a transaction sent over RPC
+func (c *simulatedChain) send(ctx context.Context, tx *types.Transaction) (err error) {
+	var signer types.Signer = types.HomesteadSigner{}
+	if tx.Protected() {
+		signer = types.NewEIP155Signer(tx.ChainId())
+	}
+	sender, err := types.Sender(signer, tx)
+	if err != nil {
+		return err
+	}
+	if sender != c.account {
+		return fmt.Errorf("unknown sender %s", sender.Hex())
+	}
+	var unprotected *types.Transaction
+	if tx.To() == nil {
+		unprotected = types.NewContractCreation(tx.Nonce(), tx.Value(), tx.Gas(), tx.GasPrice(), tx.Data())
+	} else {
+		unprotected = types.NewTransaction(tx.Nonce(), *tx.To(), tx.Value(), tx.Gas(), tx.GasPrice(), tx.Data())
+	}
+	signed, err := types.SignTx(unprotected, types.HomesteadSigner{}, c.key)
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	defer func() {
+		// The simulated backend panics on invalid transactions
+		if r := recover(); r != nil {
+			c.backend.Rollback()
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	if err = c.backend.SendTransaction(ctx, signed); err != nil {
+		return err
+	}
+	c.backend.Commit()
+	c.mined[tx.Hash()] = signed.Hash()
+	return nil
+}
+
+// receipt returns the receipt of a transaction sent over RPC
+func (c *simulatedChain) receipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	c.mutex.Lock()
+	mined, exists := c.mined[hash]
+	c.mutex.Unlock()
+	if !exists {
+		return nil, nil
+	}
+	receipt, err := c.backend.TransactionReceipt(ctx, mined)
+	if receipt != nil {
+		receipt.TxHash = hash
+	}
+	return receipt, err
+}
+
+// SimulatedEth serves the 'eth' RPC methods used by commands.  RPC services
+// must be exported
+type SimulatedEth struct {
+	chain *simulatedChain
+}
+
+// SimulatedCallArgs are the arguments of eth_call and eth_estimateGas
+type SimulatedCallArgs struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Big    `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     hexutil.Bytes   `json:"data"`
+}
+
+func (args *SimulatedCallArgs) msg() ethereum.CallMsg {
+	return ethereum.CallMsg{
+		From:     args.From,
+		To:       args.To,
+		Gas:      (*big.Int)(args.Gas),
+		GasPrice: (*big.Int)(args.GasPrice),
+		Value:    (*big.Int)(args.Value),
+		Data:     args.Data,
+	}
+}
+
+// simulatedBlock parses a block argument.  The simulated backend only has
+// state for the latest and pending blocks
+func simulatedBlock(block string) (pending bool, err error) {
+	switch block {
+	case "latest":
+		return false, nil
+	case "pending":
+		return true, nil
+	default:
+		return false, fmt.Errorf("block %s is not available", block)
+	}
+}
+
+func (s *SimulatedEth) Call(ctx context.Context, args SimulatedCallArgs, block string) (hexutil.Bytes, error) {
+	pending, err := simulatedBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return s.chain.backend.PendingCallContract(ctx, args.msg())
+	}
+	return s.chain.backend.CallContract(ctx, args.msg(), nil)
+}
+
+func (s *SimulatedEth) EstimateGas(ctx context.Context, args SimulatedCallArgs) (*hexutil.Big, error) {
+	gas, err := s.chain.backend.EstimateGas(ctx, args.msg())
+	return (*hexutil.Big)(gas), err
+}
+
+func (s *SimulatedEth) GetCode(ctx context.Context, address common.Address, block string) (hexutil.Bytes, error) {
+	pending, err := simulatedBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return s.chain.backend.PendingCodeAt(ctx, address)
+	}
+	return s.chain.backend.CodeAt(ctx, address, nil)
+}
+
+func (s *SimulatedEth) GetBalance(ctx context.Context, address common.Address, block string) (*hexutil.Big, error) {
+	if _, err := simulatedBlock(block); err != nil {
+		return nil, err
+	}
+	balance, err := s.chain.backend.BalanceAt(ctx, address, nil)
+	return (*hexutil.Big)(balance), err
+}
+
+func (s *SimulatedEth) GetTransactionCount(ctx context.Context, address common.Address, block string) (hexutil.Uint64, error) {
+	pending, err := simulatedBlock(block)
+	if err != nil {
+		return 0, err
+	}
+	var nonce uint64
+	if pending {
+		nonce, err = s.chain.backend.PendingNonceAt(ctx, address)
+	} else {
+		nonce, err = s.chain.backend.NonceAt(ctx, address, nil)
+	}
+	return hexutil.Uint64(nonce), err
+}
+
+func (s *SimulatedEth) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := s.chain.backend.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+func (s *SimulatedEth) SendRawTransaction(ctx context.Context, encoded hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(encoded, tx); err != nil {
+		return common.Hash{}, err
+	}
+	if err := s.chain.send(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+func (s *SimulatedEth) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	return s.chain.receipt(ctx, hash)
+}
+
+// SimulatedNet serves the 'net' RPC methods used by commands
+type SimulatedNet struct{}
+
+func (s *SimulatedNet) Version() string {
+	return params.AllEthashProtocolChanges.ChainId.String()
+}
+
+// simulatedConfig returns the path of the configuration file for the tests
+func simulatedConfig() string {
+	return filepath.Join(simulatedHome, "ens.yaml")
+}
+
+// run runs a command against the chain and returns its output, failing the
+// test if the command fails.  Once the contracts are deployed commands use
+// the 'simulated' network profile
+func (c *simulatedChain) run(t *testing.T, args ...string) string {
+	t.Helper()
+	output, err := c.runErr(t, args...)
+	if err != nil {
+		t.Fatalf("%s: %v", strings.Join(args, " "), err)
+	}
+	return output
+}
+
+// runErr runs a command against the chain and returns its output and the
+// error with which it failed, if any
+func (c *simulatedChain) runErr(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	args = append(args, "--config="+simulatedConfig(), "--connection=simulated", "--timeout=0")
+	if args[0] != "deploy" {
+		args = append(args, "--network=simulated")
+	}
+	resetFlags(RootCmd)
+	RootCmd.SetArgs(args)
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	output := make(chan string)
+	go func() {
+		data, _ := ioutil.ReadAll(reader)
+		output <- string(data)
+	}()
+	err = execute()
+	os.Stdout = stdout
+	writer.Close()
+	return <-output, err
+}
+
+// resetFlags returns the flags of a command and its children to their
+// defaults, as flag values otherwise carry over from one run to the next
+func resetFlags(cmd *cobra.Command) {
+	reset := func(flag *pflag.Flag) {
+		flag.Changed = false
+		if strings.HasSuffix(flag.Value.Type(), "Slice") {
+			// Slices cannot be set back to empty through their value
+			return
+		}
+		flag.Value.Set(flag.DefValue)
+	}
+	cmd.Flags().VisitAll(reset)
+	cmd.PersistentFlags().VisitAll(reset)
+	for _, child := range cmd.Commands() {
+		resetFlags(child)
+	}
+	fusesBurnFuses = nil
+}
+
+// transactWith runs a command that sends a transaction from the account
+func (c *simulatedChain) transactWith(t *testing.T, args ...string) string {
+	t.Helper()
+	return c.run(t, append(args, "--passphrase="+simulatedPassphrase)...)
+}
+
+// deploy deploys the ENS contracts with the given registrar, writing the
+// 'simulated' network profile.  It returns the output of the deployment
+func (c *simulatedChain) deploy(t *testing.T, registrar string, contracts string) string {
+	t.Helper()
+	return c.transactWith(t, "deploy", "--account="+c.account.Hex(), "--registrar="+registrar, "--contracts="+contracts, "--profile=simulated")
+}
+
+// simulatedContracts is the directory of the compiled HashRegistrar and
+// ReverseRegistrar, in the layout that 'ens deploy --contracts' reads
+var simulatedContracts = filepath.Join("testdata", "contracts")
+
+// contractsFor returns the directory of compiled contracts for the tests,
+// which is ENS_CONTRACTS if set, skipping the test if the contracts that it
+// needs are not there
+func contractsFor(t *testing.T, names ...string) string {
+	contracts := os.Getenv("ENS_CONTRACTS")
+	if contracts == "" {
+		contracts = simulatedContracts
+	}
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(contracts, name+".bin")); err != nil {
+			t.Skipf("no compiled %s in %s", name, contracts)
+		}
+	}
+	return contracts
+}
+
+// outputValue returns the value following the given prefix in the output of
+// a command
+func outputValue(t *testing.T, output string, prefix string) string {
+	t.Helper()
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), prefix) {
+			return strings.TrimSpace(strings.TrimPrefix(scanner.Text(), prefix))
+		}
+	}
+	t.Fatalf("no %q in output %q", prefix, output)
+	return ""
+}
+
+// lastLine returns the last line of the output of a command
+func lastLine(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
@@ -0,0 +1,51 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var textKey string
+
+// textCmd represents the text command
+var textCmd = &cobra.Command{
+	Use:   "text",
+	Short: "Obtain a text record of an ENS name",
+	Long: `Obtain a text record of a name registered with the Ethereum Name Service (ENS).  For example:
+
+    ens text --key=url enstest.eth
+
+In quiet mode this will return 0 if the name has the text record, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assert(textKey != "", "Key of the text record is required")
+		text, err := mgr.Text(runCtx, args[0], textKey)
+		errCheck(err, "Failed to obtain text record")
+		if quiet {
+			if text == "" {
+				exit(1)
+			}
+			exit(0)
+		}
+		fmt.Println(text)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(textCmd)
+
+	textCmd.Flags().StringVarP(&textKey, "key", "k", "", "Key of the text record, for example url or email")
+}
@@ -14,70 +14,61 @@
 package cmd
 
 import (
-	"bytes"
 	"fmt"
-	"math/big"
-	"strings"
 
-	etherutils "github.com/orinocopay/go-etherutils"
-	"github.com/orinocopay/go-etherutils/cli"
-	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/ens/manager"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 var transferAddressStr string
+var transferWithControl bool
 
 // transferCmd represents the transfer command
 var transferCmd = &cobra.Command{
 	Use:   "transfer",
 	Short: "Transfer an ENS name",
-	Long: `Transfer an Ethereum Name Service (ENS) name's ownership to another address.  For example:
+	Long: `Transfer the registration of an Ethereum Name Service (ENS) name to another address.  For example:
 
     ens transfer --address=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --passphrase="my secret passphrase" enstest.eth
 
+If the name is wrapped then its ownership in the name wrapper is transferred instead, which can be done for a name at any level.
+
+With the permanent registrar the transfer leaves the controller of the name, its owner in the registry, unchanged; the new registrant can take control with 'ens controller set', or --with-control gives control to the new address along with the registration.  With the auction registrar, and for wrapped names, control moves with the transfer.
+
 The keystore for the address must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
 
 In quiet mode this will return 0 if the transaction to transfer the name is sent successfully, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		cli.Assert(transferAddressStr != "", quiet, "Address to which to transfer ownership of the name is required")
-		cli.Assert(len(args[0]) > 10, quiet, "Name must be at least 7 characters long")
-		cli.Assert(len(strings.Split(args[0], ".")) == 2, quiet, "Name must not contain . (except for ending in .eth)")
-
-		// Ensure that the name is in a suitable state
-		registrarContract, err := ens.RegistrarContract(client)
-		inState, err := ens.NameInState(registrarContract, client, args[0], "Owned")
-		cli.ErrAssert(inState, err, quiet, "Name not in a suitable state to transfer")
-
-		// Obtain the registry contract
-		registryContract, err := ens.RegistryContract(client)
-
-		// Fetch the owner of the name
-		cli.ErrCheck(err, quiet, "Invalid name")
-		owner, err := registryContract.Owner(nil, ens.NameHash(args[0]))
-		cli.ErrCheck(err, quiet, "Cannot obtain owner")
-		cli.Assert(bytes.Compare(owner.Bytes(), ens.UnknownAddress.Bytes()) != 0, quiet, "Owner is not set")
-
-		// Fetch the wallet and account for the owner
-		wallet, err := cli.ObtainWallet(chainID, owner)
-		cli.ErrCheck(err, quiet, "Failed to obtain a wallet for the owner")
-		account, err := cli.ObtainAccount(&wallet, &owner, passphrase)
-		cli.ErrCheck(err, quiet, "Failed to obtain an account for the owner")
-
-		gasPrice, err := etherutils.StringToWei(gasPriceStr)
-		cli.ErrCheck(err, quiet, "Invalid gas price")
-
-		// Set up our session
-		session := ens.CreateRegistrarSession(chainID, &wallet, account, passphrase, registrarContract, gasPrice)
-		if nonce != -1 {
-			session.TransactOpts.Nonce = big.NewInt(nonce)
-		}
+		assert(transferAddressStr != "", "Address to which to transfer ownership of the name is required")
 
 		// Transfer the deed
-		transferAddress, err := ens.Resolve(client, transferAddressStr)
-		cli.ErrCheck(err, quiet, "Failed to obtain transfer address")
-		tx, err := ens.Transfer(session, args[0], transferAddress)
-		cli.ErrCheck(err, quiet, "Failed to send transaction")
+		transferAddress, err := resolveName(transferAddressStr)
+		errCheck(err, "Failed to obtain transfer address")
+
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+
+		if transferWithControl && manager.IsETH2LD(args[0]) && mgr.Permanent() {
+			wrapped, err := mgr.Wrapped(runCtx, args[0])
+			errCheck(err, "Cannot obtain name wrapper data")
+			if wrapped == nil {
+				// Control is reclaimed by the current registrant, so before
+				// the transfer
+				tx, err := mgr.SetOwner(runCtx, args[0], transferAddress, opts)
+				errCheck(err, "Failed to set controller")
+				if !quiet {
+					fmt.Println("Controller transaction ID is", tx.Hash().Hex())
+				}
+				log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+					"name":       args[0],
+					"networkid":  chainID,
+					"controller": transferAddress.Hex()}).Info("Set controller")
+			}
+		}
+
+		tx, err := mgr.Transfer(runCtx, args[0], transferAddress, opts)
+		errCheck(err, "Failed to transfer name")
 		if !quiet {
 			fmt.Println("Transaction ID is", tx.Hash().Hex())
 		}
@@ -92,5 +83,6 @@ func init() {
 	RootCmd.AddCommand(transferCmd)
 
 	transferCmd.Flags().StringVarP(&transferAddressStr, "address", "a", "", "Address to which to transfer the ownership of the name")
+	transferCmd.Flags().BoolVar(&transferWithControl, "with-control", false, "Also give control of the name in the registry to the address")
 	addTransactionFlags(transferCmd, "Passphrase for the account that owns the name")
 }
@@ -0,0 +1,66 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/orinocopay/go-etherutils/ens"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var unwrapOwnerStr string
+
+// unwrapCmd represents the unwrap command
+var unwrapCmd = &cobra.Command{
+	Use:   "unwrap",
+	Short: "Unwrap an ENS name from the name wrapper",
+	Long: `Unwrap a name registered with the Ethereum Name Service (ENS) from the name wrapper.  For example:
+
+    ens unwrap --passphrase="my secret passphrase" enstest.eth
+
+The name is unwrapped by its owner in the name wrapper, and is then owned in the registry, and for names directly under 'eth' also registered, to the address given with --owner or, failing that, to the owner in the wrapper.  Names with the CANNOT_UNWRAP fuse burned cannot be unwrapped.
+
+The keystore for the owner of the wrapped name must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
+
+In quiet mode this will return 0 if the transaction to unwrap the name is sent successfully, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		owner := ens.UnknownAddress
+		var err error
+		if unwrapOwnerStr != "" {
+			owner, err = resolveName(unwrapOwnerStr)
+			errCheck(err, "Invalid owner")
+		}
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+
+		tx, err := mgr.Unwrap(runCtx, args[0], owner, opts)
+		errCheck(err, "Failed to unwrap name")
+		if !quiet {
+			fmt.Println("Transaction ID is", tx.Hash().Hex())
+		}
+		log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+			"name":      args[0],
+			"networkid": chainID,
+			"owner":     owner.Hex()}).Info("Unwrap")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(unwrapCmd)
+
+	unwrapCmd.Flags().StringVarP(&unwrapOwnerStr, "owner", "o", "", "Owner of the unwrapped name (default is the owner of the wrapped name)")
+	addTransactionFlags(unwrapCmd, "Passphrase for the account that owns the wrapped name")
+}
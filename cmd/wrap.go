@@ -0,0 +1,93 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/orinocopay/ens/manager"
+	"github.com/orinocopay/go-etherutils/ens"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var wrapOwnerStr string
+var wrapResolverStr string
+
+// wrapCmd represents the wrap command
+var wrapCmd = &cobra.Command{
+	Use:   "wrap",
+	Short: "Wrap an ENS name in the name wrapper",
+	Long: `Wrap a name registered with the Ethereum Name Service (ENS) in the name wrapper.  For example:
+
+    ens wrap --passphrase="my secret passphrase" enstest.eth
+
+A wrapped name is held by the name wrapper as an ERC-1155 token, and is managed by its owner in the wrapper.  Names directly under 'eth' are wrapped by their registrant, other names by their owner.  The name wrapper must be approved to take names from the registrar or registry before it can wrap them; if it is not then an approval transaction is sent and mined first, so any --timeout given must be long enough to wait for it.
+
+The owner of the wrapped name defaults to the address that wraps it, and the resolver to the name's current resolver.
+
+The keystore for the address that wraps the name must be local (i.e. listed with 'get accounts list') and unlockable with the supplied passphrase.
+
+In quiet mode this will return 0 if the transaction to wrap the name is sent successfully, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if manager.IsETH2LD(args[0]) {
+			assert(inState(args[0], "Owned"), "Name not in a suitable state to wrap")
+		}
+		owner := ens.UnknownAddress
+		var err error
+		if wrapOwnerStr != "" {
+			owner, err = resolveName(wrapOwnerStr)
+			errCheck(err, "Invalid owner")
+		}
+		var resolverAddress = ens.UnknownAddress
+		if wrapResolverStr != "" {
+			resolverAddress, err = resolveName(wrapResolverStr)
+			errCheck(err, "Invalid resolver")
+		} else {
+			resolverAddress, err = mgr.Resolver(runCtx, args[0])
+			errCheck(err, "Cannot obtain resolver")
+		}
+		opts, err := transactionOptions()
+		errCheck(err, "Invalid gas price")
+
+		tx, err := mgr.ApproveNameWrapper(runCtx, args[0], opts)
+		errCheck(err, "Failed to approve name wrapper")
+		if tx != nil {
+			if !quiet {
+				fmt.Println("Approval transaction ID is", tx.Hash().Hex())
+			}
+			_, err = mgr.WaitMined(runCtx, tx)
+			checkWait(err, "Approval failed")
+		}
+
+		tx, err = mgr.Wrap(runCtx, args[0], owner, resolverAddress, opts)
+		errCheck(err, "Failed to wrap name")
+		if !quiet {
+			fmt.Println("Transaction ID is", tx.Hash().Hex())
+		}
+		log.WithFields(log.Fields{"transactionid": tx.Hash().Hex(),
+			"name":      args[0],
+			"networkid": chainID,
+			"owner":     owner.Hex(),
+			"resolver":  resolverAddress.Hex()}).Info("Wrap")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(wrapCmd)
+
+	wrapCmd.Flags().StringVarP(&wrapOwnerStr, "owner", "o", "", "Owner of the wrapped name (default is the address that wraps it)")
+	wrapCmd.Flags().StringVarP(&wrapResolverStr, "resolver", "r", "", "Resolver of the wrapped name (default is its current resolver)")
+	addTransactionFlags(wrapCmd, "Passphrase for the account that wraps the name")
+}
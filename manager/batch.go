@@ -0,0 +1,174 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// batchTimeout is the longest that a batch of calls can take
+var batchTimeout = time.Minute
+
+// BatchBackend is a contract backend that gathers contract calls made at
+// around the same time, for example from separate goroutines, and sends them
+// to the node as a single JSON-RPC batch request.  If the node refuses batch
+// requests then the calls are sent individually
+type BatchBackend struct {
+	*ethclient.Client
+	rpcClient *rpc.Client
+	// Window is the time for which calls are gathered before they are sent
+	Window time.Duration
+	// MaxSize is the largest number of calls sent in a single batch
+	MaxSize int
+
+	mutex   sync.Mutex
+	pending []*batchCall
+}
+
+// batchCall is a single contract call awaiting its batch
+type batchCall struct {
+	elem   rpc.BatchElem
+	result hexutil.Bytes
+	done   chan struct{}
+}
+
+// NewBatchBackend creates a batch backend over the given RPC client
+func NewBatchBackend(client *rpc.Client) *BatchBackend {
+	return &BatchBackend{
+		Client:    ethclient.NewClient(client),
+		rpcClient: client,
+		Window:    2 * time.Millisecond,
+		MaxSize:   100,
+	}
+}
+
+// CallContract calls a contract as part of a batch
+func (b *BatchBackend) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	block := "latest"
+	if blockNumber != nil {
+		block = hexutil.EncodeBig(blockNumber)
+	}
+	return b.call(ctx, msg, block)
+}
+
+// PendingCallContract calls a contract in the pending state as part of a
+// batch
+func (b *BatchBackend) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	return b.call(ctx, msg, "pending")
+}
+
+// call queues a call for the next batch and waits for its result
+func (b *BatchBackend) call(ctx context.Context, msg ethereum.CallMsg, block string) ([]byte, error) {
+	call := &batchCall{done: make(chan struct{})}
+	call.elem = rpc.BatchElem{
+		Method: "eth_call",
+		Args:   []interface{}{callArg(msg), block},
+		Result: &call.result,
+	}
+
+	b.mutex.Lock()
+	b.pending = append(b.pending, call)
+	switch len(b.pending) {
+	case 1:
+		time.AfterFunc(b.Window, b.flush)
+	case b.MaxSize:
+		go b.flush()
+	}
+	b.mutex.Unlock()
+
+	select {
+	case <-call.done:
+		return call.result, call.elem.Error
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// flush sends the pending calls
+func (b *BatchBackend) flush() {
+	b.mutex.Lock()
+	calls := b.pending
+	b.pending = nil
+	b.mutex.Unlock()
+	if len(calls) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), batchTimeout)
+	defer cancel()
+	elems := make([]rpc.BatchElem, len(calls))
+	for i, call := range calls {
+		elems[i] = call.elem
+	}
+	if err := b.rpcClient.BatchCallContext(ctx, elems); err != nil {
+		// The node might not accept batches, so send the calls individually
+		for i, call := range calls {
+			elems[i].Error = b.rpcClient.CallContext(ctx, &call.result, "eth_call", call.elem.Args...)
+		}
+	}
+	for i, call := range calls {
+		call.elem.Error = elems[i].Error
+		close(call.done)
+	}
+}
+
+// callArg converts a call message to the arguments for eth_call
+func callArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != nil {
+		arg["gas"] = (*hexutil.Big)(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	return arg
+}
+
+// parallel runs functions concurrently, so that their calls can be batched,
+// and returns the first error encountered
+func parallel(funcs ...func() error) error {
+	errs := make([]error, len(funcs))
+	var wg sync.WaitGroup
+	for i := range funcs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = funcs[i]()
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
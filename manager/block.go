@@ -0,0 +1,70 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// blockBackend is a contract backend whose calls are made against a fixed
+// block rather than the latest block.  If the block is nil then calls are
+// made against the pending block
+type blockBackend struct {
+	Backend
+	block *big.Int
+}
+
+// CallContract calls a contract at the backend's block
+func (b *blockBackend) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if b.block == nil {
+		return b.Backend.PendingCallContract(ctx, msg)
+	}
+	return b.Backend.CallContract(ctx, msg, b.block)
+}
+
+// CodeAt obtains the code of a contract at the backend's block
+func (b *blockBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	if b.block == nil {
+		return b.Backend.PendingCodeAt(ctx, contract)
+	}
+	return b.Backend.CodeAt(ctx, contract, b.block)
+}
+
+// AtBlock returns a manager whose lookups are made against the given block,
+// with the registrar that owned 'eth' at the block and expiries checked
+// against the time of the block.  If the block is nil then lookups are made
+// against the pending block.  The returned manager should only be used for
+// lookups
+func (m *Manager) AtBlock(ctx context.Context, block *big.Int) (*Manager, error) {
+	atBlock, err := m.WithBackend(&blockBackend{Backend: m.backend, block: block})
+	if err != nil {
+		return nil, err
+	}
+	if err = atBlock.setRegistrar(ctx); err != nil {
+		return nil, err
+	}
+	if block != nil {
+		header, err := m.chain().HeaderByNumber(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+		atBlock.blockTime = time.Unix(header.Time.Int64(), 0)
+	}
+	return atBlock, nil
+}
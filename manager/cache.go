@@ -0,0 +1,343 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// cacheFinality is the number of blocks after which a block is taken to be
+// final.  Results at later blocks could be reorganised away
+var cacheFinality uint64 = 12
+
+// cacheMaxScan is the largest number of blocks whose logs are scanned to
+// check cached results; entries older than this are discarded instead
+var cacheMaxScan uint64 = 100000
+
+// uncacheableCalls are the selectors of calls whose results depend on the
+// time as well as the state, such as the registrar's state of a name, the
+// permanent registrar's holder and expiry of a name and the controller's
+// availability, prices and commitments, or whose logs do not identify the
+// name, such as wildcard resolution and the name wrapper's transfers, which
+// carry the token ID in their data
+var uncacheableCalls = [][]byte{
+	crypto.Keccak256([]byte("state(bytes32)"))[:4],
+	crypto.Keccak256([]byte("entries(bytes32)"))[:4],
+	crypto.Keccak256([]byte("ownerOf(uint256)"))[:4],
+	crypto.Keccak256([]byte("nameExpires(uint256)"))[:4],
+	crypto.Keccak256([]byte("available(string)"))[:4],
+	crypto.Keccak256([]byte("rentPrice(string,uint256)"))[:4],
+	crypto.Keccak256([]byte("rentPrice(string[],uint256)"))[:4],
+	crypto.Keccak256([]byte("commitments(bytes32)"))[:4],
+	crypto.Keccak256([]byte("resolve(bytes,bytes)"))[:4],
+	crypto.Keccak256([]byte("getData(uint256)"))[:4],
+}
+
+// nodeCalls are the selectors of registry and resolver calls whose first
+// argument is the node of a name, which the logs that change their results
+// carry as a topic.  Any log from the contract makes other calls stale
+var nodeCalls = [][]byte{
+	crypto.Keccak256([]byte("owner(bytes32)"))[:4],
+	crypto.Keccak256([]byte("resolver(bytes32)"))[:4],
+	crypto.Keccak256([]byte("ttl(bytes32)"))[:4],
+	crypto.Keccak256([]byte("recordExists(bytes32)"))[:4],
+	crypto.Keccak256([]byte("addr(bytes32)"))[:4],
+	crypto.Keccak256([]byte("addr(bytes32,uint256)"))[:4],
+	crypto.Keccak256([]byte("name(bytes32)"))[:4],
+	crypto.Keccak256([]byte("text(bytes32,string)"))[:4],
+	crypto.Keccak256([]byte("ABI(bytes32,uint256)"))[:4],
+	crypto.Keccak256([]byte("content(bytes32)"))[:4],
+	crypto.Keccak256([]byte("contenthash(bytes32)"))[:4],
+	crypto.Keccak256([]byte("pubkey(bytes32)"))[:4],
+	crypto.Keccak256([]byte("interfaceImplementer(bytes32,bytes4)"))[:4],
+}
+
+// CacheBackend is a contract backend that keeps the results of contract
+// calls on disk.  Results at a fixed, final block never change so are always
+// served from the cache.  Results at the latest block are served from the
+// cache unless the contract has emitted a log for the call's node since the
+// result was obtained, found with a single log query per run
+type CacheBackend struct {
+	Backend
+	chain   ChainBackend
+	chainID string
+	path    string
+
+	mutex   sync.Mutex
+	file    map[string]map[string]*cacheEntry
+	entries map[string]*cacheEntry
+	checked bool
+	head    *big.Int
+	changed bool
+}
+
+// cacheEntry is a cached result
+type cacheEntry struct {
+	Contract common.Address `json:"contract"`
+	Data     hexutil.Bytes  `json:"data"`
+	Block    uint64         `json:"block"`
+	// Latest is true if the result was for the latest block, so can become
+	// stale
+	Latest bool          `json:"latest"`
+	Result hexutil.Bytes `json:"result"`
+}
+
+// NewCacheBackend creates a cache backend over the given backend, with the
+// cache held in the file at path.  The chain backend is used to obtain the
+// head block and logs for the chain with the given ID
+func NewCacheBackend(backend Backend, chain ChainBackend, chainID *big.Int, path string) (*CacheBackend, error) {
+	c := &CacheBackend{
+		Backend: backend,
+		chain:   chain,
+		chainID: chainID.String(),
+		path:    path,
+		file:    make(map[string]map[string]*cacheEntry),
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err = json.Unmarshal(data, &c.file); err != nil {
+			return nil, fmt.Errorf("invalid cache %s: %v", path, err)
+		}
+	}
+	c.entries = c.file[c.chainID]
+	if c.entries == nil {
+		c.entries = make(map[string]*cacheEntry)
+		c.file[c.chainID] = c.entries
+	}
+	return c, nil
+}
+
+// Save writes the cache to disk if it has changed.  New results are only
+// held in memory until then, so Save should be called once lookups are done
+func (c *CacheBackend) Save() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.save()
+}
+
+// save writes the cache to disk if it has changed, replacing the file in a
+// single step so that an exit part way through leaves the previous cache.
+// The mutex must be held
+func (c *CacheBackend) save() error {
+	if !c.changed {
+		return nil
+	}
+	data, err := json.Marshal(c.file)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return err
+	}
+	tmpPath := c.path + ".tmp"
+	if err = ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	if err = os.Rename(tmpPath, c.path); err != nil {
+		return err
+	}
+	c.changed = false
+	return nil
+}
+
+// CallContract calls a contract, serving the result from the cache where
+// possible
+func (c *CacheBackend) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if msg.To == nil || !cacheable(msg.Data) {
+		return c.Backend.CallContract(ctx, msg, blockNumber)
+	}
+
+	c.mutex.Lock()
+	refreshed := c.refresh(ctx)
+	if !refreshed {
+		// Without the head block nothing can be checked
+		c.mutex.Unlock()
+		return c.Backend.CallContract(ctx, msg, blockNumber)
+	}
+	head := c.head
+	c.mutex.Unlock()
+
+	latest := blockNumber == nil
+	block := blockNumber
+	if latest {
+		// Pin the call to the head so that the block of the result is known
+		block = head
+	} else if block.Uint64()+cacheFinality > head.Uint64() {
+		// Not yet final
+		return c.Backend.CallContract(ctx, msg, blockNumber)
+	}
+	key := cacheKey(*msg.To, msg.Data, block, latest)
+
+	c.mutex.Lock()
+	entry, exists := c.entries[key]
+	c.mutex.Unlock()
+	if exists {
+		return entry.Result, nil
+	}
+
+	result, err := c.Backend.CallContract(ctx, msg, block)
+	if err != nil || len(result) == 0 {
+		// Empty results are checked by the caller, so are not cached
+		return result, err
+	}
+	c.mutex.Lock()
+	c.entries[key] = &cacheEntry{
+		Contract: *msg.To,
+		Data:     msg.Data,
+		Block:    block.Uint64(),
+		Latest:   latest,
+		Result:   result,
+	}
+	c.changed = true
+	c.mutex.Unlock()
+	return result, nil
+}
+
+// refresh obtains the head block and discards stale entries, once per run.
+// It returns false if the head block could not be obtained.  The mutex must
+// be held
+func (c *CacheBackend) refresh(ctx context.Context) bool {
+	if c.checked {
+		return c.head != nil
+	}
+	c.checked = true
+	header, err := c.chain.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false
+	}
+	c.head = header.Number
+	head := c.head.Uint64()
+
+	// Find the contracts and blocks that need to be scanned
+	contracts := make(map[common.Address]bool)
+	from := head + 1
+	for key, entry := range c.entries {
+		if !entry.Latest {
+			continue
+		}
+		if entry.Block > head {
+			// From a different fork of the chain
+			c.discard(key)
+			continue
+		}
+		contracts[entry.Contract] = true
+		start := uint64(0)
+		if entry.Block+1 > cacheFinality {
+			start = entry.Block + 1 - cacheFinality
+		}
+		if start < from {
+			from = start
+		}
+	}
+	if len(contracts) == 0 || from > head {
+		return true
+	}
+
+	var logs []types.Log
+	if head-from <= cacheMaxScan {
+		addresses := make([]common.Address, 0, len(contracts))
+		for contract := range contracts {
+			addresses = append(addresses, contract)
+		}
+		logs, err = c.chain.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   c.head,
+			Addresses: addresses,
+		})
+	}
+	for key, entry := range c.entries {
+		if !entry.Latest {
+			continue
+		}
+		if head-from > cacheMaxScan || err != nil || stale(entry, logs) {
+			c.discard(key)
+		}
+	}
+	return true
+}
+
+// discard removes an entry from the cache.  The mutex must be held
+func (c *CacheBackend) discard(key string) {
+	delete(c.entries, key)
+	c.changed = true
+}
+
+// stale returns true if any of the logs could have changed the result of an
+// entry.  Logs in the last few blocks before the result was obtained are
+// included, in case those blocks have since been reorganised
+func stale(entry *cacheEntry, logs []types.Log) bool {
+	var node []byte
+	if len(entry.Data) >= 36 && hasSelector(nodeCalls, entry.Data) {
+		node = entry.Data[4:36]
+	}
+	for _, log := range logs {
+		if log.Address != entry.Contract || log.BlockNumber+cacheFinality <= entry.Block {
+			continue
+		}
+		if node == nil || len(log.Topics) < 2 {
+			return true
+		}
+		if bytes.Equal(log.Topics[1].Bytes(), node) {
+			return true
+		}
+		if len(log.Topics) > 2 && bytes.Equal(crypto.Keccak256(log.Topics[1].Bytes(), log.Topics[2].Bytes()), node) {
+			// A change to a subnode, such as the registry's NewOwner
+			return true
+		}
+	}
+	return false
+}
+
+// cacheable returns true if the result of a call can be cached
+func cacheable(data []byte) bool {
+	return len(data) >= 4 && !hasSelector(uncacheableCalls, data)
+}
+
+// hasSelector returns true if the selector of a call is one of those given
+func hasSelector(selectors [][]byte, data []byte) bool {
+	if len(data) < 4 {
+		return false
+	}
+	for _, selector := range selectors {
+		if bytes.Equal(data[:4], selector) {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheKey returns the key for a call
+func cacheKey(contract common.Address, data []byte, block *big.Int, latest bool) string {
+	if latest {
+		return fmt.Sprintf("latest:%s:%x", contract.Hex(), data)
+	}
+	return fmt.Sprintf("%v:%s:%x", block, contract.Hex(), data)
+}
@@ -0,0 +1,360 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrTooManyOffchainLookups is returned when a call needs more off-chain
+// lookups than allowed
+var ErrTooManyOffchainLookups = errors.New("too many off-chain lookups")
+
+// offchainLookupSelector is the selector of the OffchainLookup error with
+// which contracts ask for an answer to be fetched from a gateway (EIP-3668)
+var offchainLookupSelector = crypto.Keccak256([]byte("OffchainLookup(address,string[],bytes,bytes4,bytes)"))[:4]
+
+// defaultMaxOffchainLookups is the number of off-chain lookups allowed for a
+// single call unless set with WithOffchainLookups
+var defaultMaxOffchainLookups = 4
+
+// maxGatewayResponse is the largest gateway response that is read
+var maxGatewayResponse int64 = 1024 * 1024
+
+// gatewayTimeout is the time allowed for each gateway request
+var gatewayTimeout = 30 * time.Second
+
+// maxGatewayRedirects is the number of redirects followed for each gateway
+// request
+var maxGatewayRedirects = 5
+
+// offchainLookup is a decoded OffchainLookup error
+type offchainLookup struct {
+	sender           common.Address
+	urls             []string
+	callData         []byte
+	callbackFunction []byte
+	extraData        []byte
+}
+
+// WithOffchainLookups returns a manager that fetches answers for off-chain
+// lookups only from gateways on the given hosts, and makes at most the given
+// number of lookups for a single call.  Hosts can be given with a port, or
+// as '*.domain' to allow all subdomains of a domain; no hosts allows any
+// gateway.  A maximum of 0 refuses off-chain lookups
+func (m *Manager) WithOffchainLookups(gateways []string, maxLookups int) *Manager {
+	withLookups := *m
+	withLookups.gateways = gateways
+	withLookups.maxOffchainLookups = maxLookups
+	return &withLookups
+}
+
+// dataError is an RPC error that carries data, as with later versions of the
+// RPC client
+type dataError interface {
+	ErrorData() interface{}
+}
+
+// revertData returns the data with which a call reverted, if the node
+// supplied it with the error
+func revertData(err error) ([]byte, bool) {
+	if err == nil {
+		return nil, false
+	}
+	var data interface{}
+	if withData, isDataError := err.(dataError); isDataError {
+		data = withData.ErrorData()
+	} else {
+		// The RPC client's error type is unexported, but its data is not
+		value := reflect.ValueOf(err)
+		if value.Kind() == reflect.Ptr {
+			value = value.Elem()
+		}
+		if value.Kind() != reflect.Struct {
+			return nil, false
+		}
+		field := value.FieldByName("Data")
+		if !field.IsValid() || !field.CanInterface() {
+			return nil, false
+		}
+		data = field.Interface()
+	}
+	hex, isString := data.(string)
+	if !isString {
+		return nil, false
+	}
+	// Some nodes prefix the data
+	hex = strings.TrimPrefix(hex, "Reverted ")
+	revert, err := hexutil.Decode(hex)
+	if err != nil {
+		return nil, false
+	}
+	return revert, true
+}
+
+// callReverted returns true if a call failed because the contract reverted
+// or otherwise failed, rather than because the node could not be reached
+func callReverted(err error) bool {
+	if _, reverted := revertData(err); reverted {
+		return true
+	}
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "revert") || strings.Contains(message, "invalid opcode") || strings.Contains(message, "invalid jump")
+}
+
+// call calls a contract, following off-chain lookups (EIP-3668): if the
+// contract reverts with OffchainLookup then the answer is fetched from one
+// of its gateways and passed to its callback function, whose result is
+// returned
+func (m *Manager) call(ctx context.Context, to common.Address, data []byte) ([]byte, error) {
+	for lookups := 0; ; lookups++ {
+		result, err := m.backend.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+		revert, reverted := revertData(err)
+		if !reverted || len(revert) < 4 || !bytes.Equal(revert[:4], offchainLookupSelector) {
+			return result, err
+		}
+		if lookups >= m.maxOffchainLookups {
+			return nil, ErrTooManyOffchainLookups
+		}
+		lookup, err := decodeOffchainLookup(revert[4:])
+		if err != nil {
+			return nil, err
+		}
+		if lookup.sender != to {
+			return nil, fmt.Errorf("off-chain lookup from %s is for %s", to.Hex(), lookup.sender.Hex())
+		}
+		response, err := m.fetchOffchain(ctx, lookup)
+		if err != nil {
+			return nil, err
+		}
+		data = packBytesCall(lookup.callbackFunction, response, lookup.extraData)
+	}
+}
+
+// gatewayAllowed returns true if answers can be fetched from a gateway URL
+func (m *Manager) gatewayAllowed(gateway *url.URL) bool {
+	if gateway.Scheme != "https" && gateway.Scheme != "http" {
+		return false
+	}
+	if len(m.gateways) == 0 {
+		return true
+	}
+	host := strings.ToLower(gateway.Hostname())
+	for _, allowed := range m.gateways {
+		allowed = strings.ToLower(allowed)
+		switch {
+		case allowed == host || allowed == strings.ToLower(gateway.Host):
+			return true
+		case strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:]):
+			return true
+		}
+	}
+	return false
+}
+
+// fetchOffchain fetches the answer to an off-chain lookup, trying each of
+// its gateways in turn.  Gateways are queried with GET if their URL contains
+// the data, otherwise with POST
+func (m *Manager) fetchOffchain(ctx context.Context, lookup *offchainLookup) ([]byte, error) {
+	sender := strings.ToLower(lookup.sender.Hex())
+	data := hexutil.Encode(lookup.callData)
+	err := errors.New("no gateways for off-chain lookup")
+	for _, template := range lookup.urls {
+		gateway, parseErr := url.Parse(template)
+		if parseErr != nil || !m.gatewayAllowed(gateway) {
+			err = fmt.Errorf("gateway %s is not allowed", template)
+			continue
+		}
+
+		var request *http.Request
+		requestURL := strings.Replace(template, "{sender}", sender, -1)
+		if strings.Contains(template, "{data}") {
+			request, err = http.NewRequest(http.MethodGet, strings.Replace(requestURL, "{data}", data, -1), nil)
+		} else {
+			body, _ := json.Marshal(map[string]string{"data": data, "sender": sender})
+			request, err = http.NewRequest(http.MethodPost, requestURL, bytes.NewReader(body))
+			if err == nil {
+				request.Header.Set("Content-Type", "application/json")
+			}
+		}
+		if err != nil {
+			continue
+		}
+
+		var response []byte
+		var status int
+		response, status, err = m.gatewayRequest(ctx, request)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			if status >= 400 && status < 500 {
+				// The gateway has rejected the request, so others would too
+				return nil, err
+			}
+			continue
+		}
+		return response, nil
+	}
+	return nil, err
+}
+
+// gatewayClient returns the client for gateway requests.  Redirects are only
+// followed to gateways that are allowed, so that a gateway cannot send the
+// request to a host outside the allowed hosts
+func (m *Manager) gatewayClient() *http.Client {
+	return &http.Client{
+		CheckRedirect: func(request *http.Request, via []*http.Request) error {
+			if len(via) >= maxGatewayRedirects {
+				return fmt.Errorf("gateway %s: too many redirects", via[0].URL.Host)
+			}
+			if !m.gatewayAllowed(request.URL) {
+				return fmt.Errorf("gateway %s: redirect to %s is not allowed", via[0].URL.Host, request.URL.Host)
+			}
+			return nil
+		},
+	}
+}
+
+// gatewayRequest makes a request of a gateway, returning the data of its
+// answer and the status of the response
+func (m *Manager) gatewayRequest(ctx context.Context, request *http.Request) ([]byte, int, error) {
+	ctx, cancel := context.WithTimeout(ctx, gatewayTimeout)
+	defer cancel()
+	response, err := m.gatewayClient().Do(request.WithContext(ctx))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(response.Body, maxGatewayResponse))
+	if err != nil {
+		return nil, response.StatusCode, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, response.StatusCode, fmt.Errorf("gateway %s: %s", request.URL.Host, response.Status)
+	}
+	var answer struct {
+		Data string `json:"data"`
+	}
+	if err = json.Unmarshal(body, &answer); err != nil {
+		return nil, response.StatusCode, fmt.Errorf("gateway %s: invalid response: %v", request.URL.Host, err)
+	}
+	data, err := hexutil.Decode(answer.Data)
+	if err != nil {
+		return nil, response.StatusCode, fmt.Errorf("gateway %s: invalid data: %v", request.URL.Host, err)
+	}
+	return data, response.StatusCode, nil
+}
+
+// decodeOffchainLookup decodes the arguments of an OffchainLookup error.
+// This is decoded by hand as the ABI package cannot decode string arrays
+func decodeOffchainLookup(data []byte) (*offchainLookup, error) {
+	invalid := errors.New("invalid off-chain lookup")
+	word := func(offset int) (int, bool) {
+		if offset < 0 || offset+32 > len(data) {
+			return 0, false
+		}
+		value := new(big.Int).SetBytes(data[offset : offset+32])
+		if !value.IsInt64() || value.Int64() > int64(len(data)) {
+			return 0, false
+		}
+		return int(value.Int64()), true
+	}
+	bytesAt := func(offset int) ([]byte, bool) {
+		length, ok := word(offset)
+		if !ok || offset+32+length > len(data) {
+			return nil, false
+		}
+		return data[offset+32 : offset+32+length], true
+	}
+	if len(data) < 5*32 {
+		return nil, invalid
+	}
+
+	lookup := &offchainLookup{
+		sender:           common.BytesToAddress(data[12:32]),
+		callbackFunction: data[3*32 : 3*32+4],
+	}
+	urlsOffset, ok := word(32)
+	if !ok {
+		return nil, invalid
+	}
+	count, ok := word(urlsOffset)
+	if !ok {
+		return nil, invalid
+	}
+	for i := 0; i < count; i++ {
+		// String offsets are relative to the start of the array's contents
+		offset, ok := word(urlsOffset + 32 + i*32)
+		if !ok {
+			return nil, invalid
+		}
+		value, ok := bytesAt(urlsOffset + 32 + offset)
+		if !ok {
+			return nil, invalid
+		}
+		lookup.urls = append(lookup.urls, string(value))
+	}
+	callDataOffset, ok := word(2 * 32)
+	if !ok {
+		return nil, invalid
+	}
+	if lookup.callData, ok = bytesAt(callDataOffset); !ok {
+		return nil, invalid
+	}
+	extraDataOffset, ok := word(4 * 32)
+	if !ok {
+		return nil, invalid
+	}
+	if lookup.extraData, ok = bytesAt(extraDataOffset); !ok {
+		return nil, invalid
+	}
+	return lookup, nil
+}
+
+// packBytesCall encodes a call of a function that takes only dynamic bytes
+// arguments, such as the callback of an off-chain lookup
+func packBytesCall(selector []byte, values ...[]byte) []byte {
+	word := func(n int) []byte {
+		return common.LeftPadBytes(big.NewInt(int64(n)).Bytes(), 32)
+	}
+	padded := func(value []byte) []byte {
+		return common.RightPadBytes(value, (len(value)+31)/32*32)
+	}
+	data := append([]byte{}, selector...)
+	tail := make([]byte, 0)
+	for _, value := range values {
+		data = append(data, word(len(values)*32+len(tail))...)
+		tail = append(tail, word(len(value))...)
+		tail = append(tail, padded(value)...)
+	}
+	return append(data, tail...)
+}
@@ -0,0 +1,294 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var lookupSender = common.HexToAddress("0x00000000000000000000000000000000000000e1")
+var lookupCallData = []byte{0x01, 0x02, 0x03}
+var lookupCallback = []byte{0xca, 0x11, 0xba, 0xc4}
+var lookupExtraData = []byte{0xe0}
+
+// revertError is a call error carrying revert data, as returned by nodes
+type revertError struct {
+	data []byte
+}
+
+func (e *revertError) Error() string { return "execution reverted" }
+
+func (e *revertError) ErrorData() interface{} { return hexutil.Encode(e.data) }
+
+// lookupBackend is a backend whose contract answers every call with an
+// off-chain lookup at the given URLs, unless it is a call of the callback
+// and answer is set, in which case the call data is returned
+type lookupBackend struct {
+	urls   []string
+	answer bool
+}
+
+func (b *lookupBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if b.answer && bytes.HasPrefix(call.Data, lookupCallback) {
+		return call.Data, nil
+	}
+	return nil, &revertError{data: encodeOffchainLookup(lookupSender, b.urls, lookupCallData, lookupCallback, lookupExtraData)}
+}
+
+func (b *lookupBackend) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	return b.CallContract(ctx, call, nil)
+}
+
+func (b *lookupBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+func (b *lookupBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return nil, nil
+}
+
+func (b *lookupBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return 0, nil
+}
+
+func (b *lookupBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+func (b *lookupBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+func (b *lookupBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return errors.New("not supported")
+}
+
+// encodeOffchainLookup encodes an OffchainLookup error
+func encodeOffchainLookup(sender common.Address, urls []string, callData []byte, callback []byte, extraData []byte) []byte {
+	word := func(n int) []byte {
+		return common.LeftPadBytes(big.NewInt(int64(n)).Bytes(), 32)
+	}
+	encodeBytes := func(value []byte) []byte {
+		return append(word(len(value)), common.RightPadBytes(value, (len(value)+31)/32*32)...)
+	}
+	var strs []byte
+	offsets := make([]byte, 0)
+	for _, u := range urls {
+		offsets = append(offsets, word(len(urls)*32+len(strs))...)
+		strs = append(strs, encodeBytes([]byte(u))...)
+	}
+	urlsData := append(append(word(len(urls)), offsets...), strs...)
+	callDataData := encodeBytes(callData)
+
+	data := append([]byte{}, offchainLookupSelector...)
+	data = append(data, common.LeftPadBytes(sender.Bytes(), 32)...)
+	data = append(data, word(5*32)...)
+	data = append(data, word(5*32+len(urlsData))...)
+	data = append(data, common.RightPadBytes(callback, 32)...)
+	data = append(data, word(5*32+len(urlsData)+len(callDataData))...)
+	data = append(data, urlsData...)
+	data = append(data, callDataData...)
+	return append(data, encodeBytes(extraData)...)
+}
+
+// lookupManager returns a manager that calls the lookup backend
+func lookupManager(backend Backend, gateways []string) *Manager {
+	m := &Manager{backend: backend}
+	return m.WithOffchainLookups(gateways, defaultMaxOffchainLookups)
+}
+
+// gateway returns a gateway server that answers with the given data,
+// counting its requests
+func gateway(t *testing.T, answer []byte, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		sender := strings.ToLower(lookupSender.Hex())
+		data := hexutil.Encode(lookupCallData)
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Path != fmt.Sprintf("/%s/%s.json", sender, data) {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+		case http.MethodPost:
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("invalid body: %v", err)
+			}
+			if body["sender"] != sender || body["data"] != data {
+				t.Errorf("unexpected body %v", body)
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]string{"data": hexutil.Encode(answer)})
+	}))
+}
+
+// failingGateway returns a gateway server that fails with the given status,
+// counting its requests
+func failingGateway(status int, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		http.Error(w, http.StatusText(status), status)
+	}))
+}
+
+func TestGatewayAllowed(t *testing.T) {
+	tests := []struct {
+		gateways []string
+		url      string
+		allowed  bool
+	}{
+		{nil, "https://gateway.example.com/{data}", true},
+		{nil, "ftp://gateway.example.com/{data}", false},
+		{[]string{"gateway.example.com"}, "https://gateway.example.com/{data}", true},
+		{[]string{"gateway.example.com"}, "https://gateway.example.com:8443/{data}", true},
+		{[]string{"gateway.example.com"}, "https://other.example.com/{data}", false},
+		{[]string{"gateway.example.com:8443"}, "https://gateway.example.com:8443/{data}", true},
+		{[]string{"gateway.example.com:8443"}, "https://gateway.example.com/{data}", false},
+		{[]string{"*.example.com"}, "https://a.b.example.com/{data}", true},
+		{[]string{"*.example.com"}, "https://badexample.com/{data}", false},
+	}
+	for _, test := range tests {
+		gateway, err := url.Parse(test.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := lookupManager(nil, test.gateways)
+		if allowed := m.gatewayAllowed(gateway); allowed != test.allowed {
+			t.Errorf("%v %s: allowed %v, expected %v", test.gateways, test.url, allowed, test.allowed)
+		}
+	}
+}
+
+func TestOffchainLookup(t *testing.T) {
+	answer := []byte("answer")
+	var requests int32
+	server := gateway(t, answer, &requests)
+	defer server.Close()
+	expected := packBytesCall(lookupCallback, answer, lookupExtraData)
+
+	for _, template := range []string{server.URL + "/{sender}/{data}.json", server.URL + "/lookup/{sender}"} {
+		m := lookupManager(&lookupBackend{urls: []string{template}, answer: true}, nil)
+		result, err := m.call(context.Background(), lookupSender, []byte{0x12, 0x34, 0x56, 0x78})
+		if err != nil {
+			t.Fatalf("%s: %v", template, err)
+		}
+		if !bytes.Equal(result, expected) {
+			t.Errorf("%s: unexpected result %x", template, result)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("%d requests, expected 2", requests)
+	}
+}
+
+func TestOffchainLookupLimit(t *testing.T) {
+	var requests int32
+	server := gateway(t, []byte("answer"), &requests)
+	defer server.Close()
+	backend := &lookupBackend{urls: []string{server.URL + "/{sender}/{data}.json"}}
+
+	m := lookupManager(backend, nil)
+	if _, err := m.call(context.Background(), lookupSender, nil); err != ErrTooManyOffchainLookups {
+		t.Errorf("unexpected error %v", err)
+	}
+	if requests != int32(defaultMaxOffchainLookups) {
+		t.Errorf("%d requests, expected %d", requests, defaultMaxOffchainLookups)
+	}
+
+	requests = 0
+	m = m.WithOffchainLookups(nil, 0)
+	if _, err := m.call(context.Background(), lookupSender, nil); err != ErrTooManyOffchainLookups {
+		t.Errorf("unexpected error %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("%d requests, expected none", requests)
+	}
+}
+
+func TestOffchainLookupAllowlist(t *testing.T) {
+	var requests int32
+	server := gateway(t, []byte("answer"), &requests)
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+	backend := &lookupBackend{urls: []string{server.URL + "/{sender}/{data}.json"}, answer: true}
+
+	if _, err := lookupManager(backend, []string{"gateway.example.com"}).call(context.Background(), lookupSender, nil); err == nil {
+		t.Error("lookup from a gateway that is not allowed succeeded")
+	}
+	if requests != 0 {
+		t.Errorf("%d requests, expected none", requests)
+	}
+	if _, err := lookupManager(backend, []string{serverURL.Host}).call(context.Background(), lookupSender, nil); err != nil {
+		t.Errorf("lookup from an allowed gateway failed: %v", err)
+	}
+
+	// Redirects to hosts that are not allowed are refused
+	redirect := httptest.NewServer(http.RedirectHandler(server.URL+"/redirected", http.StatusFound))
+	defer redirect.Close()
+	redirectURL, _ := url.Parse(redirect.URL)
+	requests = 0
+	backend = &lookupBackend{urls: []string{redirect.URL + "/{sender}/{data}.json"}, answer: true}
+	if _, err := lookupManager(backend, []string{redirectURL.Host}).call(context.Background(), lookupSender, nil); err == nil {
+		t.Error("lookup redirected to a gateway that is not allowed succeeded")
+	}
+	if requests != 0 {
+		t.Errorf("%d requests, expected none", requests)
+	}
+}
+
+func TestOffchainLookupFailures(t *testing.T) {
+	var requests, failedRequests int32
+	server := gateway(t, []byte("answer"), &requests)
+	defer server.Close()
+	template := "/{sender}/{data}.json"
+
+	// A server error moves on to the next gateway
+	unavailable := failingGateway(http.StatusServiceUnavailable, &failedRequests)
+	defer unavailable.Close()
+	backend := &lookupBackend{urls: []string{unavailable.URL + template, server.URL + template}, answer: true}
+	if _, err := lookupManager(backend, nil).call(context.Background(), lookupSender, nil); err != nil {
+		t.Errorf("lookup failed: %v", err)
+	}
+	if failedRequests != 1 || requests != 1 {
+		t.Errorf("%d failed and %d successful requests, expected 1 of each", failedRequests, requests)
+	}
+
+	// A client error is returned without trying other gateways
+	requests, failedRequests = 0, 0
+	notFound := failingGateway(http.StatusNotFound, &failedRequests)
+	defer notFound.Close()
+	backend = &lookupBackend{urls: []string{notFound.URL + template, server.URL + template}, answer: true}
+	if _, err := lookupManager(backend, nil).call(context.Background(), lookupSender, nil); err == nil {
+		t.Error("lookup rejected by a gateway succeeded")
+	}
+	if failedRequests != 1 || requests != 0 {
+		t.Errorf("%d failed and %d successful requests, expected 1 failed", failedRequests, requests)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrTransactionFailed is returned when a mined transaction failed
+var ErrTransactionFailed = errors.New("transaction failed")
+
+// mustParseABI parses a contract ABI held in the package
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// bound returns a contract bound to the manager's backend.  This is used for
+// contracts that are not covered by go-etherutils
+func (m *Manager) bound(address common.Address, contractABI abi.ABI) *bind.BoundContract {
+	return bind.NewBoundContract(address, contractABI, m.backend, m.backend)
+}
+
+// transactOpts returns the options for a transaction from the given address
+func (m *Manager) transactOpts(ctx context.Context, from common.Address, opts *TxOpts) (*bind.TransactOpts, error) {
+	wallet, account, err := m.account(from, opts)
+	if err != nil {
+		return nil, err
+	}
+	transactOpts := &bind.TransactOpts{
+		From: account.Address,
+		Signer: func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return wallet.SignTxWithPassphrase(*account, opts.Passphrase, tx, m.chainID)
+		},
+		GasPrice: opts.GasPrice,
+	}
+	if err = opts.prepare(ctx, transactOpts); err != nil {
+		return nil, err
+	}
+	return transactOpts, nil
+}
+
+// WaitMined waits for a transaction to be mined, returning
+// ErrTransactionFailed if it failed
+func (m *Manager) WaitMined(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, m.chain(), tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		return receipt, ErrTransactionFailed
+	}
+	return receipt, nil
+}
@@ -0,0 +1,295 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+)
+
+// DNS record types and class used in proofs
+const (
+	dnsTypeTXT    = uint16(16)
+	dnsTypeOPT    = uint16(41)
+	dnsTypeDS     = uint16(43)
+	dnsTypeRRSIG  = uint16(46)
+	dnsTypeDNSKEY = uint16(48)
+	dnsClassIN    = uint16(1)
+)
+
+// dnsTypeNames are the names of the DNS record types used in proofs
+var dnsTypeNames = map[uint16]string{
+	dnsTypeTXT:    "TXT",
+	dnsTypeDS:     "DS",
+	dnsTypeDNSKEY: "DNSKEY",
+}
+
+// dnsUDPSize is the size of UDP response advertised in queries
+var dnsUDPSize = uint16(4096)
+
+// dnsRR is a resource record from a DNS response
+type dnsRR struct {
+	name   string
+	rrtype uint16
+	class  uint16
+	ttl    uint32
+	rdata  []byte
+	// signer and signature are those of an RRSIG record
+	signer    string
+	signature []byte
+}
+
+// DefaultDNSServer returns the first name server listed in /etc/resolv.conf
+func DefaultDNSServer() (string, error) {
+	f, err := os.Open("/etc/resolv.conf")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			return fields[1], nil
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", errors.New("no name server in /etc/resolv.conf")
+}
+
+// dnsServerAddress adds the DNS port to a server if it has none
+func dnsServerAddress(server string) string {
+	if _, _, err := net.SplitHostPort(server); err == nil {
+		return server
+	}
+	return net.JoinHostPort(strings.Trim(server, "[]"), "53")
+}
+
+// dnsQuery asks a DNS server for the records of a name with the given type,
+// along with their signatures.  The query is sent over UDP, and repeated
+// over TCP if the answer is truncated
+func dnsQuery(ctx context.Context, server string, name string, rrtype uint16) ([]*dnsRR, error) {
+	query, id, err := dnsQueryMessage(name, rrtype)
+	if err != nil {
+		return nil, err
+	}
+	address := dnsServerAddress(server)
+	response, err := dnsExchange(ctx, "udp", address, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) >= 4 && response[2]&0x02 != 0 {
+		// Truncated
+		if response, err = dnsExchange(ctx, "tcp", address, query); err != nil {
+			return nil, err
+		}
+	}
+	return parseDNSResponse(response, id)
+}
+
+// dnsQueryMessage creates a recursive query for a name and type that asks
+// for DNSSEC records, returning the message and its ID
+func dnsQueryMessage(name string, rrtype uint16) ([]byte, uint16, error) {
+	encoded, err := dnsWireName(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	idBytes := make([]byte, 2)
+	if _, err = rand.Read(idBytes); err != nil {
+		return nil, 0, err
+	}
+	id := binary.BigEndian.Uint16(idBytes)
+
+	// Header: recursion desired, one question, one additional record
+	message := make([]byte, 12)
+	binary.BigEndian.PutUint16(message[0:], id)
+	binary.BigEndian.PutUint16(message[2:], 0x0100)
+	binary.BigEndian.PutUint16(message[4:], 1)
+	binary.BigEndian.PutUint16(message[10:], 1)
+	message = append(message, encoded...)
+	message = appendUint16(message, rrtype)
+	message = appendUint16(message, dnsClassIN)
+	// EDNS with the DNSSEC OK bit set
+	message = append(message, 0)
+	message = appendUint16(message, dnsTypeOPT)
+	message = appendUint16(message, dnsUDPSize)
+	message = append(message, 0, 0, 0x80, 0, 0, 0)
+	return message, id, nil
+}
+
+// dnsExchange sends a DNS message to a server and returns its response
+func dnsExchange(ctx context.Context, network string, address string, message []byte) ([]byte, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if network == "tcp" {
+		// Messages over TCP are prefixed with their length
+		if _, err = conn.Write(append(appendUint16(nil, uint16(len(message))), message...)); err != nil {
+			return nil, err
+		}
+		length := make([]byte, 2)
+		if _, err = io.ReadFull(conn, length); err != nil {
+			return nil, err
+		}
+		response := make([]byte, binary.BigEndian.Uint16(length))
+		_, err = io.ReadFull(conn, response)
+		return response, err
+	}
+	if _, err = conn.Write(message); err != nil {
+		return nil, err
+	}
+	response := make([]byte, 65535)
+	n, err := conn.Read(response)
+	if err != nil {
+		return nil, err
+	}
+	return response[:n], nil
+}
+
+// parseDNSResponse returns the answer records of a DNS response
+func parseDNSResponse(message []byte, id uint16) ([]*dnsRR, error) {
+	invalid := errors.New("invalid DNS response")
+	if len(message) < 12 {
+		return nil, invalid
+	}
+	if binary.BigEndian.Uint16(message) != id {
+		return nil, errors.New("DNS response does not match query")
+	}
+	if rcode := message[3] & 0x0f; rcode != 0 {
+		return nil, fmt.Errorf("DNS query failed with response code %d", rcode)
+	}
+	questions := int(binary.BigEndian.Uint16(message[4:]))
+	answers := int(binary.BigEndian.Uint16(message[6:]))
+
+	offset := 12
+	for i := 0; i < questions; i++ {
+		_, next, err := readDNSName(message, offset)
+		if err != nil {
+			return nil, err
+		}
+		offset = next + 4
+	}
+	rrs := make([]*dnsRR, 0, answers)
+	for i := 0; i < answers; i++ {
+		name, next, err := readDNSName(message, offset)
+		if err != nil {
+			return nil, err
+		}
+		if next+10 > len(message) {
+			return nil, invalid
+		}
+		rr := &dnsRR{
+			name:   name,
+			rrtype: binary.BigEndian.Uint16(message[next:]),
+			class:  binary.BigEndian.Uint16(message[next+2:]),
+			ttl:    binary.BigEndian.Uint32(message[next+4:]),
+		}
+		length := int(binary.BigEndian.Uint16(message[next+8:]))
+		start := next + 10
+		if start+length > len(message) {
+			return nil, invalid
+		}
+		rr.rdata = message[start : start+length]
+		if rr.rrtype == dnsTypeRRSIG {
+			if length < rrsigHeaderLength {
+				return nil, invalid
+			}
+			// The signer name is not compressed, but is lower-cased in the
+			// canonical form
+			signer, end, err := readDNSName(message, start+rrsigHeaderLength)
+			if err != nil || end > start+length {
+				return nil, invalid
+			}
+			rr.signer = signer
+			rr.signature = message[end : start+length]
+		}
+		rrs = append(rrs, rr)
+		offset = start + length
+	}
+	return rrs, nil
+}
+
+// readDNSName reads a possibly compressed name from a DNS message, returning
+// the name in lower case and the offset following it
+func readDNSName(message []byte, offset int) (string, int, error) {
+	invalid := errors.New("invalid name in DNS message")
+	labels := make([]string, 0)
+	end := -1
+	for jumps := 0; ; {
+		if offset >= len(message) {
+			return "", 0, invalid
+		}
+		length := int(message[offset])
+		switch {
+		case length == 0:
+			if end == -1 {
+				end = offset + 1
+			}
+			if len(labels) == 0 {
+				return ".", end, nil
+			}
+			return strings.ToLower(strings.Join(labels, ".")), end, nil
+		case length&0xc0 == 0xc0:
+			// Compression pointer
+			if offset+2 > len(message) || jumps > 64 {
+				return "", 0, invalid
+			}
+			if end == -1 {
+				end = offset + 2
+			}
+			offset = int(binary.BigEndian.Uint16(message[offset:]) & 0x3fff)
+			jumps++
+		case length&0xc0 != 0:
+			return "", 0, invalid
+		default:
+			if offset+1+length > len(message) {
+				return "", 0, invalid
+			}
+			labels = append(labels, string(message[offset+1:offset+1+length]))
+			offset += 1 + length
+		}
+	}
+}
+
+// dnsWireName encodes a name in canonical DNS wire format.  The root is
+// given as '.'
+func dnsWireName(name string) ([]byte, error) {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == "" {
+		return []byte{0}, nil
+	}
+	return dnsEncode(name)
+}
+
+// appendUint16 appends a big-endian 16-bit value
+func appendUint16(data []byte, value uint16) []byte {
+	return append(data, byte(value>>8), byte(value))
+}
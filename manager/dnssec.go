@@ -0,0 +1,645 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/orinocopay/go-etherutils/ens"
+)
+
+// ErrNoDNSRegistrar is returned when the top-level domain of a DNS name is
+// not managed by a DNS registrar
+var ErrNoDNSRegistrar = errors.New("no DNS registrar")
+
+// rrsigHeaderLength is the length of the fixed fields of an RRSIG record,
+// which precede its signer name
+const rrsigHeaderLength = 18
+
+const dnsRegistrarABIJSON = `[
+{"constant":true,"inputs":[],"name":"oracle","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":false,"inputs":[{"name":"name","type":"bytes"},{"name":"proof","type":"bytes"}],"name":"claim","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"name","type":"bytes"},{"name":"input","type":"bytes"},{"name":"proof","type":"bytes"}],"name":"proveAndClaim","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}
+]`
+
+const dnssecOracleABIJSON = `[
+{"constant":true,"inputs":[],"name":"anchors","outputs":[{"name":"","type":"bytes"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[{"name":"dnstype","type":"uint16"},{"name":"name","type":"bytes"}],"name":"rrdata","outputs":[{"name":"inception","type":"uint32"},{"name":"expiration","type":"uint64"},{"name":"hash","type":"bytes20"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":false,"inputs":[{"name":"data","type":"bytes"},{"name":"proof","type":"bytes"}],"name":"submitRRSets","outputs":[{"name":"","type":"bytes"}],"payable":false,"stateMutability":"nonpayable","type":"function"}
+]`
+
+var dnsRegistrarABI = mustParseABI(dnsRegistrarABIJSON)
+var dnssecOracleABI = mustParseABI(dnssecOracleABIJSON)
+
+// DNSProof is the chain of signed RRsets that proves the _ens TXT record of
+// a DNS name, from the DNSKEY records of the root zone down.  Each RRset is
+// signed by a key in the RRset before it
+type DNSProof struct {
+	Name   string         `json:"name"`
+	RRSets []*SignedRRSet `json:"rrsets"`
+}
+
+// SignedRRSet is an RRset in the form submitted to the DNSSEC oracle: the
+// RRSIG record without its signature followed by the records in canonical
+// form, and the signature.  The name and type are for reference
+type SignedRRSet struct {
+	Name      string        `json:"name"`
+	Type      string        `json:"type"`
+	Input     hexutil.Bytes `json:"input"`
+	Signature hexutil.Bytes `json:"sig"`
+}
+
+// rrsig is the RRSIG record of a signed RRset
+type rrsig struct {
+	typeCovered uint16
+	labels      uint8
+	originalTTL uint32
+	expiration  uint32
+	inception   uint32
+	keyTag      uint16
+	signer      string
+	// length is the length of the record without its signature
+	length int
+}
+
+// dnsRRSet is an RRset fetched from DNS, with all of its signatures
+type dnsRRSet struct {
+	name   string
+	rrtype uint16
+	rrs    []*dnsRR
+	sigs   []*dnsRR
+}
+
+// ParseDNSProof parses and checks a proof saved as JSON
+func ParseDNSProof(data []byte) (*DNSProof, error) {
+	proof := new(DNSProof)
+	if err := json.Unmarshal(data, proof); err != nil {
+		return nil, err
+	}
+	if err := proof.checkChain(); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// canonicalDNSName returns a name in lower case without a trailing dot,
+// with the root as '.'
+func canonicalDNSName(name string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == "" {
+		return "."
+	}
+	return name
+}
+
+// isDNSSubdomain returns true if a name is the same as or under a zone
+func isDNSSubdomain(name string, zone string) bool {
+	return zone == "." || name == zone || strings.HasSuffix(name, "."+zone)
+}
+
+// rrsig parses the RRSIG record of a signed RRset
+func (s *SignedRRSet) rrsig() (*rrsig, error) {
+	if len(s.Input) < rrsigHeaderLength {
+		return nil, errors.New("invalid RRSIG record")
+	}
+	signer, end, err := readDNSName(s.Input, rrsigHeaderLength)
+	if err != nil {
+		return nil, err
+	}
+	return &rrsig{
+		typeCovered: binary.BigEndian.Uint16(s.Input[0:]),
+		labels:      s.Input[3],
+		originalTTL: binary.BigEndian.Uint32(s.Input[4:]),
+		expiration:  binary.BigEndian.Uint32(s.Input[8:]),
+		inception:   binary.BigEndian.Uint32(s.Input[12:]),
+		keyTag:      binary.BigEndian.Uint16(s.Input[16:]),
+		signer:      signer,
+		length:      end,
+	}, nil
+}
+
+// rrs returns the records of a signed RRset in canonical form.  This is the
+// data that the oracle holds once the RRset is proved
+func (s *SignedRRSet) rrs() []byte {
+	sig, err := s.rrsig()
+	if err != nil {
+		return nil
+	}
+	return s.Input[sig.length:]
+}
+
+// Signer returns the zone that signed an RRset
+func (s *SignedRRSet) Signer() string {
+	sig, err := s.rrsig()
+	if err != nil {
+		return ""
+	}
+	return sig.signer
+}
+
+// Validity returns the period for which the signature of an RRset is valid
+func (s *SignedRRSet) Validity() (time.Time, time.Time) {
+	sig, err := s.rrsig()
+	if err != nil {
+		return time.Time{}, time.Time{}
+	}
+	return time.Unix(int64(sig.inception), 0), time.Unix(int64(sig.expiration), 0)
+}
+
+// check checks that a signed RRset is well-formed and matches its stated
+// name and type, returning its RRSIG record
+func (s *SignedRRSet) check() (*rrsig, error) {
+	sig, err := s.rrsig()
+	if err != nil {
+		return nil, err
+	}
+	rrs, err := parseDNSRRs(s.rrs())
+	if err != nil {
+		return nil, err
+	}
+	if len(rrs) == 0 {
+		return nil, fmt.Errorf("no records in %s %s", s.Type, s.Name)
+	}
+	if dnsTypeNames[sig.typeCovered] != s.Type {
+		return nil, fmt.Errorf("RRset for %s is %d not %s", s.Name, sig.typeCovered, s.Type)
+	}
+	for _, rr := range rrs {
+		if rr.rrtype != sig.typeCovered || rr.class != dnsClassIN {
+			return nil, fmt.Errorf("unexpected record in %s %s", s.Type, s.Name)
+		}
+		if rr.name != canonicalDNSName(s.Name) && !strings.HasPrefix(rr.name, "*.") {
+			return nil, fmt.Errorf("record for %s in %s %s", rr.name, s.Type, s.Name)
+		}
+	}
+	return sig, nil
+}
+
+// checkChain checks that each RRset of a proof is signed by the RRset
+// before it, ending with the _ens TXT record of the name
+func (p *DNSProof) checkChain() error {
+	if len(p.RRSets) == 0 {
+		return errors.New("proof is empty")
+	}
+	p.Name = canonicalDNSName(p.Name)
+	var previous *SignedRRSet
+	for _, set := range p.RRSets {
+		sig, err := set.check()
+		if err != nil {
+			return err
+		}
+		name := canonicalDNSName(set.Name)
+		switch {
+		case previous == nil:
+			if set.Type != "DNSKEY" || name != "." {
+				return errors.New("proof does not start with the root DNSKEY records")
+			}
+		case set.Type == "DNSKEY":
+			// Keys are proved by the DS records for their zone
+			if previous.Type != "DS" || canonicalDNSName(previous.Name) != name {
+				return fmt.Errorf("DNSKEY %s does not follow DS %s", name, name)
+			}
+		default:
+			// Other records are proved by the keys of their zone
+			if previous.Type != "DNSKEY" || canonicalDNSName(previous.Name) != sig.signer {
+				return fmt.Errorf("%s %s does not follow DNSKEY %s", set.Type, name, sig.signer)
+			}
+		}
+		if !isDNSSubdomain(name, sig.signer) || (set.Type == "DNSKEY" && sig.signer != name) || (set.Type == "DS" && sig.signer == name) {
+			return fmt.Errorf("%s %s cannot be signed by %s", set.Type, name, sig.signer)
+		}
+		previous = set
+	}
+	if previous.Type != "TXT" || canonicalDNSName(previous.Name) != "_ens."+p.Name {
+		return fmt.Errorf("proof does not end with the TXT records of _ens.%s", p.Name)
+	}
+	return nil
+}
+
+// Check checks that a proof is well-formed and that the given time is within
+// the validity period of each of its signatures.  The signatures themselves
+// are not verified here; the oracle verifies them when the proof is
+// submitted
+func (p *DNSProof) Check(at time.Time) error {
+	if err := p.checkChain(); err != nil {
+		return err
+	}
+	for _, set := range p.RRSets {
+		inception, expiration := set.Validity()
+		if at.Before(inception) || at.After(expiration) {
+			return fmt.Errorf("signature of %s %s is valid only from %v to %v", set.Type, set.Name, inception, expiration)
+		}
+	}
+	return nil
+}
+
+// Owner returns the address given by the 'a=' entry of the _ens TXT record
+// proved, which is the address for which the name is claimed
+func (p *DNSProof) Owner() (common.Address, error) {
+	if len(p.RRSets) == 0 {
+		return ens.UnknownAddress, errors.New("proof is empty")
+	}
+	rrs, err := parseDNSRRs(p.RRSets[len(p.RRSets)-1].rrs())
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	for _, rr := range rrs {
+		// TXT data is a series of length-prefixed strings
+		for data := rr.rdata; len(data) > 0 && len(data) > int(data[0]); data = data[1+int(data[0]):] {
+			text := string(data[1 : 1+int(data[0])])
+			if strings.HasPrefix(text, "a=") && common.IsHexAddress(text[2:]) {
+				return common.HexToAddress(text[2:]), nil
+			}
+		}
+	}
+	return ens.UnknownAddress, fmt.Errorf("no address in the TXT records of _ens.%s", p.Name)
+}
+
+// parseDNSRRs parses records in canonical form
+func parseDNSRRs(data []byte) ([]*dnsRR, error) {
+	rrs := make([]*dnsRR, 0)
+	for offset := 0; offset < len(data); {
+		name, next, err := readDNSName(data, offset)
+		if err != nil {
+			return nil, err
+		}
+		if next+10 > len(data) {
+			return nil, errors.New("invalid DNS record")
+		}
+		length := int(binary.BigEndian.Uint16(data[next+8:]))
+		if next+10+length > len(data) {
+			return nil, errors.New("invalid DNS record")
+		}
+		rrs = append(rrs, &dnsRR{
+			name:   name,
+			rrtype: binary.BigEndian.Uint16(data[next:]),
+			class:  binary.BigEndian.Uint16(data[next+2:]),
+			ttl:    binary.BigEndian.Uint32(data[next+4:]),
+			rdata:  data[next+10 : next+10+length],
+		})
+		offset = next + 10 + length
+	}
+	return rrs, nil
+}
+
+// keyTag returns the key tag of a DNSKEY record
+func keyTag(rdata []byte) uint16 {
+	var tag uint32
+	for i, b := range rdata {
+		if i&1 == 0 {
+			tag += uint32(b) << 8
+		} else {
+			tag += uint32(b)
+		}
+	}
+	tag += tag >> 16
+	return uint16(tag)
+}
+
+// keyTags returns the key tags of DNSKEY records, or those referred to by DS
+// records
+func keyTags(rrs []*dnsRR) map[uint16]bool {
+	tags := make(map[uint16]bool)
+	for _, rr := range rrs {
+		switch {
+		case rr.rrtype == dnsTypeDNSKEY:
+			tags[keyTag(rr.rdata)] = true
+		case rr.rrtype == dnsTypeDS && len(rr.rdata) >= 2:
+			tags[binary.BigEndian.Uint16(rr.rdata)] = true
+		}
+	}
+	return tags
+}
+
+// fetchRRSet fetches the records of a name with the given type, and their
+// signatures
+func fetchRRSet(ctx context.Context, server string, name string, rrtype uint16) (*dnsRRSet, error) {
+	name = canonicalDNSName(name)
+	answers, err := dnsQuery(ctx, server, name, rrtype)
+	if err != nil {
+		return nil, err
+	}
+	set := &dnsRRSet{name: name, rrtype: rrtype}
+	for _, rr := range answers {
+		switch {
+		case rr.name != name || rr.class != dnsClassIN:
+		case rr.rrtype == rrtype:
+			set.rrs = append(set.rrs, rr)
+		case rr.rrtype == dnsTypeRRSIG && binary.BigEndian.Uint16(rr.rdata) == rrtype:
+			set.sigs = append(set.sigs, rr)
+		}
+	}
+	if len(set.rrs) == 0 {
+		return nil, fmt.Errorf("no %s records for %s", dnsTypeNames[rrtype], name)
+	}
+	if len(set.sigs) == 0 {
+		return nil, fmt.Errorf("%s records for %s are not signed", dnsTypeNames[rrtype], name)
+	}
+	return set, nil
+}
+
+// zone returns the zone that signed an RRset
+func (s *dnsRRSet) zone() (string, error) {
+	zone := s.sigs[0].signer
+	if !isDNSSubdomain(s.name, zone) || (s.rrtype == dnsTypeDS && zone == s.name) {
+		return "", fmt.Errorf("%s %s cannot be signed by %s", dnsTypeNames[s.rrtype], s.name, zone)
+	}
+	return zone, nil
+}
+
+// signed returns an RRset signed by one of the keys with the given tags in
+// the zone, in the form submitted to the oracle
+func (s *dnsRRSet) signed(zone string, tags map[uint16]bool, at time.Time) (*SignedRRSet, error) {
+	var sig *dnsRR
+	for _, candidate := range s.sigs {
+		header := candidate.rdata[:rrsigHeaderLength]
+		inception := time.Unix(int64(binary.BigEndian.Uint32(header[12:])), 0)
+		expiration := time.Unix(int64(binary.BigEndian.Uint32(header[8:])), 0)
+		if candidate.signer == zone && tags[binary.BigEndian.Uint16(header[16:])] && !at.Before(inception) && !at.After(expiration) {
+			sig = candidate
+			break
+		}
+	}
+	if sig == nil {
+		return nil, fmt.Errorf("no valid signature for %s %s", dnsTypeNames[s.rrtype], s.name)
+	}
+
+	signer, err := dnsWireName(sig.signer)
+	if err != nil {
+		return nil, err
+	}
+	input := append(append([]byte{}, sig.rdata[:rrsigHeaderLength]...), signer...)
+
+	// Records of wildcards are signed with the wildcard name
+	owner := s.name
+	labels := strings.Split(s.name, ".")
+	if s.name != "." && int(sig.rdata[3]) < len(labels) {
+		owner = "*." + strings.Join(labels[len(labels)-int(sig.rdata[3]):], ".")
+	}
+	ownerWire, err := dnsWireName(owner)
+	if err != nil {
+		return nil, err
+	}
+	rdatas := make([][]byte, 0, len(s.rrs))
+	for _, rr := range s.rrs {
+		rdatas = append(rdatas, rr.rdata)
+	}
+	sort.Slice(rdatas, func(i, j int) bool { return bytes.Compare(rdatas[i], rdatas[j]) < 0 })
+	for i, rdata := range rdatas {
+		if i > 0 && bytes.Equal(rdata, rdatas[i-1]) {
+			continue
+		}
+		input = append(input, ownerWire...)
+		input = appendUint16(input, s.rrtype)
+		input = appendUint16(input, dnsClassIN)
+		// Records are signed with their original TTL
+		input = append(input, sig.rdata[4:8]...)
+		input = appendUint16(input, uint16(len(rdata)))
+		input = append(input, rdata...)
+	}
+	return &SignedRRSet{
+		Name:      s.name,
+		Type:      dnsTypeNames[s.rrtype],
+		Input:     input,
+		Signature: sig.signature,
+	}, nil
+}
+
+// FetchDNSProof fetches the proof of the _ens TXT record of a DNS name from
+// a DNS server.  The chain of keys is followed from the zone that signs the
+// record up to the root, whose keys must be signed by one of the oracle's
+// trust anchors
+func (m *Manager) FetchDNSProof(ctx context.Context, name string, server string) (*DNSProof, error) {
+	name = canonicalDNSName(name)
+	_, oracle, err := m.DNSRegistrar(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	var anchors []byte
+	if err = m.bound(oracle, dnssecOracleABI).Call(&bind.CallOpts{Context: ctx}, &anchors, "anchors"); err != nil {
+		return nil, err
+	}
+	anchorRRs, err := parseDNSRRs(anchors)
+	if err != nil {
+		return nil, err
+	}
+	return fetchDNSProof(ctx, name, server, anchorRRs, time.Now())
+}
+
+// fetchDNSProof fetches the proof of the _ens TXT record of a DNS name, with
+// signatures valid at the given time, whose root keys are signed by one of
+// the given DS records
+func fetchDNSProof(ctx context.Context, name string, server string, anchorRRs []*dnsRR, now time.Time) (*DNSProof, error) {
+	pending, err := fetchRRSet(ctx, server, "_ens."+name, dnsTypeTXT)
+	if err != nil {
+		return nil, err
+	}
+	// Sets are gathered from the TXT records up, and reversed at the end
+	sets := make([]*SignedRRSet, 0)
+	for {
+		zone, err := pending.zone()
+		if err != nil {
+			return nil, err
+		}
+		keys, err := fetchRRSet(ctx, server, zone, dnsTypeDNSKEY)
+		if err != nil {
+			return nil, err
+		}
+		signed, err := pending.signed(zone, keyTags(keys.rrs), now)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, signed)
+
+		var ds *dnsRRSet
+		dsRRs := anchorRRs
+		if zone != "." {
+			if ds, err = fetchRRSet(ctx, server, zone, dnsTypeDS); err != nil {
+				return nil, err
+			}
+			dsRRs = ds.rrs
+		}
+		signedKeys, err := keys.signed(zone, keyTags(dsRRs), now)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, signedKeys)
+		if ds == nil {
+			break
+		}
+		pending = ds
+	}
+	for i, j := 0, len(sets)-1; i < j; i, j = i+1, j-1 {
+		sets[i], sets[j] = sets[j], sets[i]
+	}
+
+	proof := &DNSProof{Name: name, RRSets: sets}
+	if err := proof.checkChain(); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// DNSRegistrar returns the DNS registrar that manages the top-level domain
+// of a DNS name, and the DNSSEC oracle that it uses
+func (m *Manager) DNSRegistrar(ctx context.Context, name string) (common.Address, common.Address, error) {
+	name = canonicalDNSName(name)
+	tld := name[strings.LastIndex(name, ".")+1:]
+	registrar, err := m.Owner(ctx, tld)
+	if err != nil {
+		return ens.UnknownAddress, ens.UnknownAddress, err
+	}
+	if registrar == ens.UnknownAddress {
+		return ens.UnknownAddress, ens.UnknownAddress, ErrNoDNSRegistrar
+	}
+	var oracle common.Address
+	err = m.bound(registrar, dnsRegistrarABI).Call(&bind.CallOpts{Context: ctx}, &oracle, "oracle")
+	if err != nil || oracle == ens.UnknownAddress {
+		// The owner of the domain is not a DNS registrar
+		return ens.UnknownAddress, ens.UnknownAddress, ErrNoDNSRegistrar
+	}
+	return registrar, oracle, nil
+}
+
+// HeldRRSets returns, for each RRset of a proof, true if the oracle already
+// holds it
+func (m *Manager) HeldRRSets(ctx context.Context, proof *DNSProof) ([]bool, error) {
+	_, oracle, err := m.DNSRegistrar(ctx, proof.Name)
+	if err != nil {
+		return nil, err
+	}
+	held := make([]bool, len(proof.RRSets))
+	for i, set := range proof.RRSets {
+		sig, err := set.rrsig()
+		if err != nil {
+			return nil, err
+		}
+		name, err := dnsWireName(set.Name)
+		if err != nil {
+			return nil, err
+		}
+		data := new(struct {
+			Inception  uint32
+			Expiration uint64
+			Hash       [20]byte
+		})
+		err = m.bound(oracle, dnssecOracleABI).Call(&bind.CallOpts{Context: ctx}, data, "rrdata", sig.typeCovered, name)
+		if err != nil {
+			return nil, err
+		}
+		held[i] = bytes.Equal(data.Hash[:], crypto.Keccak256(set.rrs())[:20])
+	}
+	return held, nil
+}
+
+// dnsSubmission returns the arguments with which the RRsets of a proof that
+// the oracle does not already hold are submitted: the RRsets and their
+// signatures, each prefixed with its length, and the RRset that proves the
+// first of them.  The data is empty if the oracle holds all of the RRsets
+func (m *Manager) dnsSubmission(ctx context.Context, oracle common.Address, proof *DNSProof) ([]byte, []byte, error) {
+	held, err := m.HeldRRSets(ctx, proof)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Only RRsets after the last held are needed
+	start := 0
+	for i := range held {
+		if held[i] {
+			start = i + 1
+		}
+	}
+	var provedBy []byte
+	if start == 0 {
+		if err = m.bound(oracle, dnssecOracleABI).Call(&bind.CallOpts{Context: ctx}, &provedBy, "anchors"); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		provedBy = proof.RRSets[start-1].rrs()
+	}
+	data := make([]byte, 0)
+	for _, set := range proof.RRSets[start:] {
+		data = appendUint16(data, uint16(len(set.Input)))
+		data = append(data, set.Input...)
+		data = appendUint16(data, uint16(len(set.Signature)))
+		data = append(data, set.Signature...)
+	}
+	return data, provedBy, nil
+}
+
+// VerifyDNSProof asks the oracle to verify the RRsets of a proof that it
+// does not already hold, without submitting them
+func (m *Manager) VerifyDNSProof(ctx context.Context, proof *DNSProof) error {
+	_, oracle, err := m.DNSRegistrar(ctx, proof.Name)
+	if err != nil {
+		return err
+	}
+	data, provedBy, err := m.dnsSubmission(ctx, oracle, proof)
+	if err != nil || len(data) == 0 {
+		return err
+	}
+	var proved []byte
+	if err = m.bound(oracle, dnssecOracleABI).Call(&bind.CallOpts{Context: ctx}, &proved, "submitRRSets", data, provedBy); err != nil {
+		return fmt.Errorf("oracle rejected proof: %v", err)
+	}
+	if !bytes.Equal(proved, proof.RRSets[len(proof.RRSets)-1].rrs()) {
+		return errors.New("oracle did not prove the TXT records")
+	}
+	return nil
+}
+
+// ClaimDNSName claims a DNS name through the DNS registrar for its top-level
+// domain, submitting the RRsets of the proof that the oracle does not
+// already hold.  The name is claimed for the address in its _ens TXT record,
+// whichever address sends the transaction
+func (m *Manager) ClaimDNSName(ctx context.Context, from common.Address, proof *DNSProof, opts *TxOpts) (*types.Transaction, error) {
+	registrar, oracle, err := m.DNSRegistrar(ctx, proof.Name)
+	if err != nil {
+		return nil, err
+	}
+	name, err := dnsWireName(proof.Name)
+	if err != nil {
+		return nil, err
+	}
+	data, provedBy, err := m.dnsSubmission(ctx, oracle, proof)
+	if err != nil {
+		return nil, err
+	}
+	transactOpts, err := m.transactOpts(ctx, from, opts)
+	if err != nil {
+		return nil, err
+	}
+	var tx *types.Transaction
+	if len(data) == 0 {
+		tx, err = m.bound(registrar, dnsRegistrarABI).Transact(transactOpts, "claim", name, proof.RRSets[len(proof.RRSets)-1].rrs())
+	} else {
+		tx, err = m.bound(registrar, dnsRegistrarABI).Transact(transactOpts, "proveAndClaim", name, data, provedBy)
+	}
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
@@ -0,0 +1,426 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/orinocopay/go-etherutils/ens/registrycontract"
+)
+
+// dnsProofFile is a proof of the _ens TXT records of example.xyz, signed by
+// test keys for the root, xyz and example.xyz zones rather than the real
+// ones.  Its signatures are valid from 2020 to 2030
+var dnsProofFile = filepath.Join("testdata", "dnsproof.json")
+
+// dnsProofTime is a time at which the signatures of the proof are valid
+var dnsProofTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// dnsProofOwner is the address in the TXT records of the proof
+var dnsProofOwner = common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4")
+
+// loadDNSProof loads the test proof
+func loadDNSProof(t *testing.T) *DNSProof {
+	data, err := ioutil.ReadFile(dnsProofFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := ParseDNSProof(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proof
+}
+
+// dnsRecord returns a record in canonical form
+func dnsRecord(t *testing.T, name string, rrtype uint16, ttl uint32, rdata []byte) []byte {
+	record, err := dnsWireName(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record = appendUint16(record, rrtype)
+	record = appendUint16(record, dnsClassIN)
+	record = append(record, byte(ttl>>24), byte(ttl>>16), byte(ttl>>8), byte(ttl))
+	record = appendUint16(record, uint16(len(rdata)))
+	return append(record, rdata...)
+}
+
+// rrsigRecord returns the RRSIG record for an RRset
+func rrsigRecord(t *testing.T, rrtype uint16, labels uint8, ttl uint32, inception time.Time, expiration time.Time, tag uint16, signer string, signature []byte) *dnsRR {
+	rdata := appendUint16(nil, rrtype)
+	rdata = append(rdata, 13, labels)
+	for _, value := range []uint32{ttl, uint32(expiration.Unix()), uint32(inception.Unix())} {
+		rdata = append(rdata, byte(value>>24), byte(value>>16), byte(value>>8), byte(value))
+	}
+	rdata = appendUint16(rdata, tag)
+	signerWire, err := dnsWireName(signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rdata = append(append(rdata, signerWire...), signature...)
+	return &dnsRR{name: signer, rrtype: dnsTypeRRSIG, class: dnsClassIN, rdata: rdata, signer: signer, signature: signature}
+}
+
+func TestParseDNSRRs(t *testing.T) {
+	first := dnsRecord(t, "_ens.example.xyz", dnsTypeTXT, 3600, []byte("\x05v=ens"))
+	second := dnsRecord(t, "example.xyz", dnsTypeDS, 60, []byte{0x01, 0x02})
+	tests := []struct {
+		data  []byte
+		rrs   []*dnsRR
+		valid bool
+	}{
+		{data: nil, valid: true},
+		{
+			data:  append(append([]byte{}, first...), second...),
+			valid: true,
+			rrs: []*dnsRR{
+				{name: "_ens.example.xyz", rrtype: dnsTypeTXT, class: dnsClassIN, ttl: 3600, rdata: []byte("\x05v=ens")},
+				{name: "example.xyz", rrtype: dnsTypeDS, class: dnsClassIN, ttl: 60, rdata: []byte{0x01, 0x02}},
+			},
+		},
+		// Truncated fixed fields
+		{data: first[:len(first)-len("\x05v=ens")-3]},
+		// Data shorter than its length
+		{data: first[:len(first)-1]},
+		// Name that runs off the end
+		{data: []byte{0x04, 'a', 'b'}},
+	}
+	for i, test := range tests {
+		rrs, err := parseDNSRRs(test.data)
+		if !test.valid {
+			if err == nil {
+				t.Errorf("%d: parsed invalid records", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		if len(rrs) != len(test.rrs) {
+			t.Errorf("%d: %d records, expected %d", i, len(rrs), len(test.rrs))
+			continue
+		}
+		for j, rr := range rrs {
+			expected := test.rrs[j]
+			if rr.name != expected.name || rr.rrtype != expected.rrtype || rr.class != expected.class || rr.ttl != expected.ttl || !bytes.Equal(rr.rdata, expected.rdata) {
+				t.Errorf("%d: record %d is %+v, expected %+v", i, j, rr, expected)
+			}
+		}
+	}
+}
+
+func TestParseDNSResponse(t *testing.T) {
+	// A response to a TXT query for _ens.example.xyz, whose answer refers
+	// to the name in the question
+	message := []byte{0x12, 0x34, 0x81, 0x80, 0x00, 0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00}
+	question, err := dnsWireName("_ENS.Example.xyz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	message = append(message, question...)
+	message = appendUint16(message, dnsTypeTXT)
+	message = appendUint16(message, dnsClassIN)
+	message = append(message, 0xc0, 12)
+	message = appendUint16(message, dnsTypeTXT)
+	message = appendUint16(message, dnsClassIN)
+	message = append(message, 0, 0, 0x0e, 0x10)
+	message = appendUint16(message, 6)
+	message = append(message, "\x05v=ens"...)
+
+	rrs, err := parseDNSResponse(message, 0x1234)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rrs) != 1 || rrs[0].name != "_ens.example.xyz" || rrs[0].rrtype != dnsTypeTXT || rrs[0].ttl != 3600 || string(rrs[0].rdata) != "\x05v=ens" {
+		t.Errorf("parsed %+v", rrs)
+	}
+
+	if _, err = parseDNSResponse(message, 0x4321); err == nil {
+		t.Error("parsed a response to another query")
+	}
+	if _, err = parseDNSResponse(message[:len(message)-1], 0x1234); err == nil {
+		t.Error("parsed a truncated response")
+	}
+	looped := append([]byte{}, message...)
+	looped[len(message)-17] = 12 + byte(len(question)) + 4
+	if _, err = parseDNSResponse(looped, 0x1234); err == nil {
+		t.Error("parsed a response whose name points at itself")
+	}
+}
+
+func TestSigned(t *testing.T) {
+	inception := dnsProofTime.Add(-24 * time.Hour)
+	expiration := dnsProofTime.Add(24 * time.Hour)
+	signature := []byte{0x5e, 0x5e}
+	valid := rrsigRecord(t, dnsTypeTXT, 3, 300, inception, expiration, 1234, "example.xyz", signature)
+	wildcard := rrsigRecord(t, dnsTypeTXT, 2, 300, inception, expiration, 1234, "example.xyz", signature)
+	expired := rrsigRecord(t, dnsTypeTXT, 3, 300, inception, dnsProofTime.Add(-time.Hour), 1234, "example.xyz", signature)
+	otherKey := rrsigRecord(t, dnsTypeTXT, 3, 300, inception, expiration, 4321, "example.xyz", signature)
+	otherZone := rrsigRecord(t, dnsTypeTXT, 3, 300, inception, expiration, 1234, "xyz", signature)
+
+	// Records are unordered, with a duplicate, and have a TTL that has
+	// counted down from the original
+	rrs := []*dnsRR{
+		{name: "_ens.example.xyz", rrtype: dnsTypeTXT, class: dnsClassIN, ttl: 10, rdata: []byte("\x02bb")},
+		{name: "_ens.example.xyz", rrtype: dnsTypeTXT, class: dnsClassIN, ttl: 10, rdata: []byte("\x02ab")},
+		{name: "_ens.example.xyz", rrtype: dnsTypeTXT, class: dnsClassIN, ttl: 10, rdata: []byte("\x02bb")},
+	}
+	records := func(owner string) []byte {
+		return append(dnsRecord(t, owner, dnsTypeTXT, 300, []byte("\x02ab")), dnsRecord(t, owner, dnsTypeTXT, 300, []byte("\x02bb"))...)
+	}
+	signerWire, err := dnsWireName("example.xyz")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		sigs  []*dnsRR
+		owner string
+		valid bool
+	}{
+		{sigs: []*dnsRR{valid}, owner: "_ens.example.xyz", valid: true},
+		{sigs: []*dnsRR{expired, otherKey, otherZone, valid}, owner: "_ens.example.xyz", valid: true},
+		// Signed with fewer labels than the name, so by a wildcard
+		{sigs: []*dnsRR{wildcard}, owner: "*.example.xyz", valid: true},
+		{sigs: []*dnsRR{expired, otherKey, otherZone}},
+	}
+	for i, test := range tests {
+		set := &dnsRRSet{name: "_ens.example.xyz", rrtype: dnsTypeTXT, rrs: rrs, sigs: test.sigs}
+		signed, err := set.signed("example.xyz", map[uint16]bool{1234: true}, dnsProofTime)
+		if !test.valid {
+			if err == nil {
+				t.Errorf("%d: signed without a valid signature", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		sig := test.sigs[len(test.sigs)-1]
+		expected := append(append(append([]byte{}, sig.rdata[:rrsigHeaderLength]...), signerWire...), records(test.owner)...)
+		if !bytes.Equal(signed.Input, expected) {
+			t.Errorf("%d: input is %x, expected %x", i, signed.Input, expected)
+		}
+		if !bytes.Equal(signed.Signature, signature) || signed.Name != "_ens.example.xyz" || signed.Type != "TXT" {
+			t.Errorf("%d: signed %+v", i, signed)
+		}
+		if _, err = signed.check(); err != nil {
+			t.Errorf("%d: signed RRset fails its check: %v", i, err)
+		}
+	}
+}
+
+func TestCheckChain(t *testing.T) {
+	proof := loadDNSProof(t)
+	if proof.Name != "example.xyz" || len(proof.RRSets) != 6 {
+		t.Fatalf("loaded %s with %d RRsets", proof.Name, len(proof.RRSets))
+	}
+
+	tests := []struct {
+		description string
+		change      func(proof *DNSProof)
+	}{
+		{"empty", func(proof *DNSProof) { proof.RRSets = nil }},
+		{"no root keys", func(proof *DNSProof) { proof.RRSets = proof.RRSets[1:] }},
+		{"no TXT records", func(proof *DNSProof) { proof.RRSets = proof.RRSets[:5] }},
+		{"keys without DS records", func(proof *DNSProof) {
+			proof.RRSets = append(proof.RRSets[:1], proof.RRSets[2:]...)
+		}},
+		{"out of order", func(proof *DNSProof) {
+			proof.RRSets[1], proof.RRSets[2] = proof.RRSets[2], proof.RRSets[1]
+		}},
+		{"other name", func(proof *DNSProof) { proof.Name = "other.xyz" }},
+		{"wrong type", func(proof *DNSProof) { proof.RRSets[5].Type = "DS" }},
+		{"wrong name", func(proof *DNSProof) { proof.RRSets[5].Name = "_ens.other.xyz" }},
+		{"truncated", func(proof *DNSProof) {
+			proof.RRSets[3].Input = proof.RRSets[3].Input[:len(proof.RRSets[3].Input)-1]
+		}},
+	}
+	for _, test := range tests {
+		changed := loadDNSProof(t)
+		test.change(changed)
+		if err := changed.checkChain(); err == nil {
+			t.Errorf("%s: chain passed its check", test.description)
+		}
+	}
+}
+
+func TestDNSProofCheck(t *testing.T) {
+	proof := loadDNSProof(t)
+	if err := proof.Check(dnsProofTime); err != nil {
+		t.Errorf("proof failed its check: %v", err)
+	}
+	if err := proof.Check(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("proof passed its check before its signatures were valid")
+	}
+	if err := proof.Check(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("proof passed its check after its signatures expired")
+	}
+	owner, err := proof.Owner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner != dnsProofOwner {
+		t.Errorf("owner is %s, expected %s", owner.Hex(), dnsProofOwner.Hex())
+	}
+	if signer := proof.RRSets[5].Signer(); signer != "example.xyz" {
+		t.Errorf("TXT records are signed by %s", signer)
+	}
+}
+
+var ownerSelector = crypto.Keccak256([]byte("owner(bytes32)"))[:4]
+var oracleSelector = crypto.Keccak256([]byte("oracle()"))[:4]
+var anchorsSelector = crypto.Keccak256([]byte("anchors()"))[:4]
+
+// oracleBackend is a backend with a registry whose every name is owned by a
+// DNS registrar, and a DNSSEC oracle that holds the given RRsets
+type oracleBackend struct {
+	registrar common.Address
+	oracle    common.Address
+	anchors   []byte
+	// held maps the data of rrdata calls to the RRsets that they return
+	held map[string]*SignedRRSet
+}
+
+// abiWord returns data padded to a whole number of words
+func abiWord(data []byte) []byte {
+	return common.RightPadBytes(data, (len(data)+31)/32*32)
+}
+
+func (b *oracleBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(call.Data, ownerSelector):
+		return common.LeftPadBytes(b.registrar.Bytes(), 32), nil
+	case bytes.HasPrefix(call.Data, oracleSelector):
+		return common.LeftPadBytes(b.oracle.Bytes(), 32), nil
+	case bytes.HasPrefix(call.Data, anchorsSelector):
+		result := common.LeftPadBytes([]byte{32}, 32)
+		result = append(result, common.LeftPadBytes(big.NewInt(int64(len(b.anchors))).Bytes(), 32)...)
+		return append(result, abiWord(b.anchors)...), nil
+	}
+	result := make([]byte, 96)
+	if set, exists := b.held[string(call.Data)]; exists {
+		sig, err := set.rrsig()
+		if err != nil {
+			return nil, err
+		}
+		binary.BigEndian.PutUint32(result[28:], sig.inception)
+		binary.BigEndian.PutUint32(result[60:], sig.expiration)
+		copy(result[64:], crypto.Keccak256(set.rrs())[:20])
+	}
+	return result, nil
+}
+
+func (b *oracleBackend) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	return b.CallContract(ctx, call, nil)
+}
+
+func (b *oracleBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (b *oracleBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (b *oracleBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return 0, nil
+}
+
+func (b *oracleBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+func (b *oracleBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+func (b *oracleBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return errors.New("not supported")
+}
+
+// oracleManager returns a manager whose oracle holds the first RRsets of a
+// proof
+func oracleManager(t *testing.T, proof *DNSProof, held int) (*Manager, *oracleBackend) {
+	backend := &oracleBackend{
+		registrar: common.HexToAddress("0x00000000000000000000000000000000000000d1"),
+		oracle:    common.HexToAddress("0x00000000000000000000000000000000000000d2"),
+		anchors:   []byte("anchors"),
+		held:      make(map[string]*SignedRRSet),
+	}
+	for _, set := range proof.RRSets[:held] {
+		sig, err := set.rrsig()
+		if err != nil {
+			t.Fatal(err)
+		}
+		name, err := dnsWireName(set.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := dnssecOracleABI.Pack("rrdata", sig.typeCovered, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		backend.held[string(data)] = set
+	}
+	registry, err := registrycontract.NewRegistryContract(common.HexToAddress("0x00000000000000000000000000000000000000d0"), backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Manager{backend: backend, registry: registry}, backend
+}
+
+func TestDNSSubmission(t *testing.T) {
+	proof := loadDNSProof(t)
+	for held := 0; held <= len(proof.RRSets); held++ {
+		m, backend := oracleManager(t, proof, held)
+		data, provedBy, err := m.dnsSubmission(context.Background(), backend.oracle, proof)
+		if err != nil {
+			t.Fatalf("%d held: %v", held, err)
+		}
+
+		// The RRsets not held are submitted, proved by the last held
+		expected := make([]byte, 0)
+		for _, set := range proof.RRSets[held:] {
+			expected = appendUint16(expected, uint16(len(set.Input)))
+			expected = append(expected, set.Input...)
+			expected = appendUint16(expected, uint16(len(set.Signature)))
+			expected = append(expected, set.Signature...)
+		}
+		if !bytes.Equal(data, expected) {
+			t.Errorf("%d held: submitted %x, expected %x", held, data, expected)
+		}
+		expectedProvedBy := backend.anchors
+		if held > 0 {
+			expectedProvedBy = proof.RRSets[held-1].rrs()
+		}
+		if !bytes.Equal(provedBy, expectedProvedBy) {
+			t.Errorf("%d held: proved by %x, expected %x", held, provedBy, expectedProvedBy)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+// +build ignore
+
+// This program generates emoji.go from the Unicode emoji test data, which
+// lists every recommended emoji sequence.  Run it with the path to
+// emoji-test.txt:
+//
+//	go run emoji_gen.go emoji-test.txt
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: go run emoji_gen.go emoji-test.txt")
+	}
+	f, err := os.Open(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	version := ""
+	seen := make(map[string]bool)
+	sequences := make([]string, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "# Version:") {
+			version = strings.TrimSpace(strings.TrimPrefix(line, "# Version:"))
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 || !strings.HasPrefix(strings.TrimSpace(fields[1]), "fully-qualified") {
+			continue
+		}
+		// Normalized emoji do not contain emoji presentation selectors
+		codePoints := make([]string, 0)
+		for _, codePoint := range strings.Fields(fields[0]) {
+			if codePoint != "FE0F" {
+				codePoints = append(codePoints, codePoint)
+			}
+		}
+		sequence := strings.Join(codePoints, " ")
+		if !seen[sequence] {
+			seen[sequence] = true
+			sequences = append(sequences, sequence)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by emoji_gen.go. DO NOT EDIT.\n\npackage manager\n\n")
+	fmt.Fprintf(&source, "// emojiSequences are the recommended emoji sequences of Unicode emoji %s,\n", version)
+	source.WriteString("// without emoji presentation selectors, one per line in hex\n")
+	source.WriteString("const emojiSequences = `")
+	source.WriteString(strings.Join(sequences, "\n"))
+	source.WriteString("`\n")
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile("emoji.go", formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
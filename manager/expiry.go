@@ -0,0 +1,197 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/go-etherutils/ens/deedcontract"
+)
+
+const baseRegistrarABIJSON = `[
+{"constant":true,"inputs":[],"name":"GRACE_PERIOD","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[{"name":"id","type":"uint256"}],"name":"nameExpires","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[{"name":"tokenId","type":"uint256"}],"name":"ownerOf","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[],"name":"previousRegistrar","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":false,"inputs":[{"name":"id","type":"uint256"},{"name":"owner","type":"address"}],"name":"reclaim","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"name":"transferFrom","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}
+]`
+
+var baseRegistrarABI = mustParseABI(baseRegistrarABIJSON)
+
+// gracePeriod returns the grace period of the registrar at the given
+// address, or 0 if the registrar is not a permanent registrar, which is the
+// case if it has no code or the call fails in the contract.  Other errors,
+// such as a node that cannot be reached, are returned
+func gracePeriod(ctx context.Context, backend bind.ContractBackend, registrarAddress common.Address) (time.Duration, error) {
+	data, err := baseRegistrarABI.Pack("GRACE_PERIOD")
+	if err != nil {
+		return 0, err
+	}
+	result, err := backend.CallContract(ctx, ethereum.CallMsg{To: &registrarAddress, Data: data}, nil)
+	if err != nil {
+		if ctx.Err() == nil && callReverted(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	period := new(*big.Int)
+	if len(result) == 0 || baseRegistrarABI.Unpack(period, "GRACE_PERIOD", result) != nil {
+		// Old contracts return nothing rather than reverting
+		return 0, nil
+	}
+	return time.Duration((*period).Int64()) * time.Second, nil
+}
+
+// Permanent returns true if the registrar for 'eth' is the permanent
+// registrar, in which case names are registered with the controller for a
+// period rather than won at auction
+func (m *Manager) Permanent() bool {
+	return m.gracePeriod != 0
+}
+
+// GracePeriod returns the period after a name expires during which only its
+// registrant can renew it.  It is zero for the auction registrar
+func (m *Manager) GracePeriod() time.Duration {
+	return m.gracePeriod
+}
+
+// labelID returns the token ID in the permanent registrar of a name directly
+// under 'eth'
+func labelID(name string) (*big.Int, error) {
+	nameLabel, err := label(name)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(crypto.Keccak256([]byte(nameLabel))), nil
+}
+
+// Expiry returns the time at which the registration of a name directly under
+// 'eth' with the permanent registrar expires.  It returns the zero time if
+// the name has never been registered
+func (m *Manager) Expiry(ctx context.Context, name string) (time.Time, error) {
+	id, err := labelID(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	expires := new(*big.Int)
+	err = m.bound(m.registrarAddress, baseRegistrarABI).Call(&bind.CallOpts{Context: ctx}, expires, "nameExpires", id)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if (*expires).Sign() == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix((*expires).Int64(), 0), nil
+}
+
+// Registrant returns the registrant of a name directly under 'eth': the
+// holder of the name in the permanent registrar, or the owner of its deed in
+// the auction registrar.  The registrant can transfer the name and reclaim
+// its ownership in the registry, which is its controller.  It returns the
+// zero address if the name is not owned, including during the grace period
+func (m *Manager) Registrant(ctx context.Context, name string) (common.Address, error) {
+	if !m.Permanent() {
+		return m.deedOwner(ctx, name)
+	}
+	expiry, err := m.Expiry(ctx, name)
+	if err != nil || m.expiryState(expiry) != "Owned" {
+		// The registrar does not report the holder of an expired name
+		return ens.UnknownAddress, err
+	}
+	id, err := labelID(name)
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	var registrant common.Address
+	err = m.bound(m.registrarAddress, baseRegistrarABI).Call(&bind.CallOpts{Context: ctx}, &registrant, "ownerOf", id)
+	return registrant, err
+}
+
+// deedOwner returns the owner of the deed for a name in the auction
+// registrar, or the zero address if the name is not owned
+func (m *Manager) deedOwner(ctx context.Context, name string) (common.Address, error) {
+	if _, err := label(name); err != nil {
+		return ens.UnknownAddress, err
+	}
+	state, deedAddress, _, _, _, err := m.Entry(ctx, name)
+	if err != nil || state != "Owned" {
+		return ens.UnknownAddress, err
+	}
+	deed, err := deedcontract.NewDeedContract(deedAddress, m.backend)
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	return deed.Owner(&bind.CallOpts{Context: ctx})
+}
+
+// Now returns the time against which expiries are checked: the time of the
+// block against which lookups are made, or the current time
+func (m *Manager) Now() time.Time {
+	if m.blockTime.IsZero() {
+		return time.Now()
+	}
+	return m.blockTime
+}
+
+// expiryState returns the state of a name in the permanent registrar with the
+// given expiry: Available, Owned, or Expired if in the grace period
+func (m *Manager) expiryState(expiry time.Time) string {
+	now := m.Now()
+	switch {
+	case expiry.IsZero() || now.After(expiry.Add(m.gracePeriod)):
+		return "Available"
+	case now.After(expiry):
+		return "Expired"
+	default:
+		return "Owned"
+	}
+}
+
+// UnmigratedDeed returns the deed for a name directly under 'eth' that is
+// owned in the auction registrar that preceded the permanent registrar but
+// has not been migrated to the permanent registrar.  It returns the zero
+// address if there is no such deed, or if the permanent registrar does not
+// name its predecessor
+func (m *Manager) UnmigratedDeed(ctx context.Context, name string) (common.Address, error) {
+	if !m.Permanent() {
+		return ens.UnknownAddress, nil
+	}
+	expiry, err := m.Expiry(ctx, name)
+	if err != nil || !expiry.IsZero() {
+		// Migrated names have an expiry
+		return ens.UnknownAddress, err
+	}
+	var previousAddress common.Address
+	err = m.bound(m.registrarAddress, baseRegistrarABI).Call(&bind.CallOpts{Context: ctx}, &previousAddress, "previousRegistrar")
+	if err != nil || previousAddress == ens.UnknownAddress {
+		// Not all permanent registrars have a predecessor
+		return ens.UnknownAddress, nil
+	}
+	state, deedAddress, _, _, _, err := m.entry(ctx, previousAddress, name)
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	if state != "Owned" {
+		return ens.UnknownAddress, nil
+	}
+	return deedAddress, nil
+}
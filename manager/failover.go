@@ -0,0 +1,204 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FailoverBackend is a chain backend over several backends for the same
+// chain.  Each request is sent to the backend that last succeeded; if it
+// fails then the request is retried with the remaining backends in turn,
+// unless the node reports that the call reverted or that what was asked for
+// was not found
+type FailoverBackend struct {
+	backends []ChainBackend
+	mutex    sync.Mutex
+	current  int
+	// Failed, if supplied, is called when a backend fails a request
+	Failed func(index int, err error)
+}
+
+// NewFailoverBackend creates a failover backend over the given backends,
+// which are tried in order
+func NewFailoverBackend(backends []ChainBackend) *FailoverBackend {
+	return &FailoverBackend{backends: backends}
+}
+
+// try runs a request against each backend in turn until one succeeds
+func (b *FailoverBackend) try(ctx context.Context, request func(backend ChainBackend) error) error {
+	b.mutex.Lock()
+	start := b.current
+	b.mutex.Unlock()
+
+	var err error
+	for i := range b.backends {
+		index := (start + i) % len(b.backends)
+		if err = request(b.backends[index]); err == nil {
+			b.mutex.Lock()
+			b.current = index
+			b.mutex.Unlock()
+			return nil
+		}
+		if ctx.Err() != nil {
+			// Cancelled, so no point trying elsewhere
+			return err
+		}
+		if _, reverted := revertData(err); reverted {
+			// The call reverted, which it would do elsewhere too
+			return err
+		}
+		if err == ethereum.NotFound {
+			// An answer rather than a failure, for example a transaction
+			// that has yet to be mined
+			return err
+		}
+		if b.Failed != nil {
+			b.Failed(index, err)
+		}
+	}
+	return err
+}
+
+// CodeAt obtains the code of a contract
+func (b *FailoverBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (code []byte, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		code, err = backend.CodeAt(ctx, contract, blockNumber)
+		return
+	})
+	return
+}
+
+// CallContract calls a contract
+func (b *FailoverBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		result, err = backend.CallContract(ctx, call, blockNumber)
+		return
+	})
+	return
+}
+
+// PendingCodeAt obtains the code of a contract in the pending state
+func (b *FailoverBackend) PendingCodeAt(ctx context.Context, contract common.Address) (code []byte, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		code, err = backend.PendingCodeAt(ctx, contract)
+		return
+	})
+	return
+}
+
+// PendingCallContract calls a contract in the pending state
+func (b *FailoverBackend) PendingCallContract(ctx context.Context, call ethereum.CallMsg) (result []byte, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		result, err = backend.PendingCallContract(ctx, call)
+		return
+	})
+	return
+}
+
+// PendingNonceAt obtains the next nonce for an account
+func (b *FailoverBackend) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		nonce, err = backend.PendingNonceAt(ctx, account)
+		return
+	})
+	return
+}
+
+// SuggestGasPrice obtains a suggested gas price
+func (b *FailoverBackend) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		price, err = backend.SuggestGasPrice(ctx)
+		return
+	})
+	return
+}
+
+// EstimateGas estimates the gas required for a call
+func (b *FailoverBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas *big.Int, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		gas, err = backend.EstimateGas(ctx, call)
+		return
+	})
+	return
+}
+
+// SendTransaction sends a signed transaction.  Sending the same transaction
+// to another node is harmless, so this fails over as for any other request
+func (b *FailoverBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return b.try(ctx, func(backend ChainBackend) error {
+		return backend.SendTransaction(ctx, tx)
+	})
+}
+
+// NonceAt obtains the nonce of an account at the given block
+func (b *FailoverBackend) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		nonce, err = backend.NonceAt(ctx, account, blockNumber)
+		return
+	})
+	return
+}
+
+// HeaderByNumber obtains the header of a block by its number, or the latest
+// block if the number is nil
+func (b *FailoverBackend) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		header, err = backend.HeaderByNumber(ctx, number)
+		return
+	})
+	return
+}
+
+// HeaderByHash obtains the header of a block by its hash
+func (b *FailoverBackend) HeaderByHash(ctx context.Context, hash common.Hash) (header *types.Header, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		header, err = backend.HeaderByHash(ctx, hash)
+		return
+	})
+	return
+}
+
+// TransactionByHash obtains a transaction and whether it is pending
+func (b *FailoverBackend) TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		tx, isPending, err = backend.TransactionByHash(ctx, hash)
+		return
+	})
+	return
+}
+
+// TransactionReceipt obtains the receipt of a mined transaction
+func (b *FailoverBackend) TransactionReceipt(ctx context.Context, hash common.Hash) (receipt *types.Receipt, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		receipt, err = backend.TransactionReceipt(ctx, hash)
+		return
+	})
+	return
+}
+
+// FilterLogs obtains the logs that match a query
+func (b *FailoverBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	err = b.try(ctx, func(backend ChainBackend) (err error) {
+		logs, err = backend.FilterLogs(ctx, query)
+		return
+	})
+	return
+}
@@ -0,0 +1,286 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/go-etherutils/ens/deedcontract"
+)
+
+// minimumValue is the value locked for a bid that does not state a value
+var minimumValue = big.NewInt(10000000000000000)
+
+// NameInfo is information about a name.  Registrar fields are only present
+// for names directly under 'eth', and deed fields only once an auction has
+// been won
+type NameInfo struct {
+	Name string
+	// Level is the number of labels below the top-level domain
+	Level int
+
+	// Registrar.  Expiry, GracePeriodEnd and Registrant are only present
+	// with the permanent registrar, and the other fields only with the
+	// auction registrar, where the deed owner is the registrant
+	State            string
+	Registrant       common.Address
+	Expiry           time.Time
+	GracePeriodEnd   time.Time
+	RegistrationDate time.Time
+	Value            *big.Int
+	HighestBid       *big.Int
+
+	// Deed
+	Deed              common.Address
+	DeedOwner         common.Address
+	PreviousDeedOwner common.Address
+
+	// Registry and resolver.  If Owner is the name wrapper then Wrapped is
+	// set, and WrappedOwner, Fuses and WrapperExpiry are from the wrapper
+	Owner         common.Address
+	Wrapped       bool
+	WrappedOwner  common.Address
+	Fuses         uint32
+	WrapperExpiry time.Time
+	Resolver      common.Address
+	// ResolverName is the name for which Resolver is set, which is a parent
+	// of Name if the resolver is a wildcard resolver
+	ResolverName string
+	Address      common.Address
+	// ReverseName is the name to which Address reverse resolves
+	ReverseName string
+
+	// Names are the names to which the addresses above reverse resolve, where
+	// they do so
+	Names map[common.Address]string
+}
+
+// Info obtains information about a name.  Items that are not set are left as
+// their zero values.  Independent lookups are made concurrently, so with a
+// batch backend this takes a few round trips to the node
+func (m *Manager) Info(ctx context.Context, name string) (*NameInfo, error) {
+	info := &NameInfo{
+		Name:  name,
+		Level: ens.DomainLevel(name),
+		Names: make(map[common.Address]string),
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	// Registrar entry and registry
+	err := parallel(
+		func() error {
+			if !IsETH2LD(name) {
+				return nil
+			}
+			if m.Permanent() {
+				expiry, err := m.Expiry(ctx, name)
+				if err != nil {
+					return err
+				}
+				info.State = m.expiryState(expiry)
+				if !expiry.IsZero() {
+					info.Expiry = expiry
+					info.GracePeriodEnd = expiry.Add(m.gracePeriod)
+				}
+				return nil
+			}
+			state, deedAddress, registrationDate, value, highestBid, err := m.Entry(ctx, name)
+			if err != nil {
+				return err
+			}
+			info.State = state
+			if state != "Available" {
+				info.RegistrationDate = registrationDate
+				info.HighestBid = highestBid
+				info.Value = value
+				if value != nil && value.Sign() == 0 && state != "Owned" {
+					// A value of 0 means the minimum value
+					info.Value = minimumValue
+				}
+			}
+			if state == "Won" || state == "Owned" {
+				info.Deed = deedAddress
+			}
+			return nil
+		},
+		func() (err error) {
+			if IsETH2LD(name) && m.Permanent() {
+				info.Registrant, err = m.Registrant(ctx, name)
+			}
+			return
+		},
+		func() (err error) {
+			info.Owner, err = m.Owner(ctx, name)
+			return
+		},
+		func() (err error) {
+			info.Resolver, info.ResolverName, err = m.FindResolver(ctx, name)
+			return
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deed, name wrapper and resolver
+	err = parallel(
+		func() error {
+			if info.Deed == ens.UnknownAddress {
+				return nil
+			}
+			deedContract, err := deedcontract.NewDeedContract(info.Deed, m.backend)
+			if err != nil {
+				return err
+			}
+			return parallel(
+				func() (err error) {
+					info.DeedOwner, err = deedContract.Owner(opts)
+					return
+				},
+				func() (err error) {
+					info.PreviousDeedOwner, err = deedContract.PreviousOwner(opts)
+					return
+				},
+			)
+		},
+		func() error {
+			wrapped, err := m.wrapped(ctx, name, info.Owner)
+			if err != nil || wrapped == nil {
+				return err
+			}
+			info.Wrapped = true
+			info.WrappedOwner = wrapped.Owner
+			info.Fuses = wrapped.Fuses
+			info.WrapperExpiry = wrapped.Expiry
+			return nil
+		},
+		func() error {
+			if info.Resolver != ens.UnknownAddress {
+				// Failure to resolve is not an error here
+				info.Address, _ = m.Resolve(ctx, name)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reverse resolution of the addresses
+	addresses := make([]common.Address, 0)
+	for _, address := range []common.Address{info.Registrant, info.DeedOwner, info.PreviousDeedOwner, info.Owner, info.WrappedOwner, info.Resolver, info.Address} {
+		if _, exists := info.Names[address]; address != ens.UnknownAddress && !exists {
+			info.Names[address] = ""
+			addresses = append(addresses, address)
+		}
+	}
+	names := make([]string, len(addresses))
+	funcs := make([]func() error, len(addresses))
+	for i := range addresses {
+		i := i
+		funcs[i] = func() error {
+			// Failure to reverse resolve is not an error here
+			names[i], _ = m.ReverseResolve(ctx, addresses[i])
+			return nil
+		}
+	}
+	parallel(funcs...)
+	for i, address := range addresses {
+		if names[i] == "" {
+			delete(info.Names, address)
+		} else {
+			info.Names[address] = names[i]
+		}
+	}
+	info.ReverseName = info.Names[info.Address]
+	return info, nil
+}
+
+// State returns the registrar state of a name directly under 'eth'.  With
+// the permanent registrar this is Available, Owned, or Expired if the name is
+// in its grace period
+func (m *Manager) State(ctx context.Context, name string) (string, error) {
+	if !IsETH2LD(name) {
+		return "", fmt.Errorf("%s is not directly under eth", name)
+	}
+	if m.Permanent() {
+		expiry, err := m.Expiry(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		return m.expiryState(expiry), nil
+	}
+	state, _, _, _, _, err := m.Entry(ctx, name)
+	return state, err
+}
+
+const auctionRegistrarABIJSON = `[
+{"constant":true,"inputs":[{"name":"_hash","type":"bytes32"}],"name":"entries","outputs":[{"name":"","type":"uint8"},{"name":"","type":"address"},{"name":"","type":"uint256"},{"name":"","type":"uint256"},{"name":"","type":"uint256"}],"payable":false,"type":"function"}
+]`
+
+var auctionRegistrarABI = mustParseABI(auctionRegistrarABIJSON)
+
+// auctionStates are the states of a name in the auction registrar, by the
+// mode that the registrar gives.  An owned name is won until the auction is
+// finished and the name has an owner in the registry
+var auctionStates = []string{"Available", "Bidding", "Owned", "Forbidden", "Revealing", "Unavailable"}
+
+// Entry returns the entry for a name directly under 'eth' in the auction
+// registrar: its state, the deed holding the value locked for it, the date
+// on which it was or will be registered, the value locked and the highest
+// bid
+func (m *Manager) Entry(ctx context.Context, name string) (state string, deedAddress common.Address, registrationDate time.Time, value *big.Int, highestBid *big.Int, err error) {
+	return m.entry(ctx, m.registrarAddress, name)
+}
+
+// entry returns the entry for a name in the auction registrar at the given
+// address
+func (m *Manager) entry(ctx context.Context, registrarAddress common.Address, name string) (string, common.Address, time.Time, *big.Int, *big.Int, error) {
+	nameLabel, err := label(name)
+	if err != nil {
+		return "", ens.UnknownAddress, time.Time{}, nil, nil, err
+	}
+	var (
+		mode             = new(uint8)
+		deedAddress      = new(common.Address)
+		registrationDate = new(*big.Int)
+		value            = new(*big.Int)
+		highestBid       = new(*big.Int)
+	)
+	out := &[]interface{}{mode, deedAddress, registrationDate, value, highestBid}
+	err = m.bound(registrarAddress, auctionRegistrarABI).Call(&bind.CallOpts{Context: ctx}, out, "entries", ens.LabelHash(nameLabel))
+	if err != nil {
+		return "", ens.UnknownAddress, time.Time{}, nil, nil, err
+	}
+	if int(*mode) >= len(auctionStates) {
+		return "", ens.UnknownAddress, time.Time{}, nil, nil, fmt.Errorf("unknown auction state %d", *mode)
+	}
+	state := auctionStates[*mode]
+	if state == "Owned" {
+		owner, err := m.registry.Owner(&bind.CallOpts{Context: ctx}, ens.NameHash(name))
+		if err != nil {
+			return "", ens.UnknownAddress, time.Time{}, nil, nil, err
+		}
+		if owner == ens.UnknownAddress {
+			state = "Won"
+		}
+	}
+	return state, *deedAddress, time.Unix((*registrationDate).Int64(), 0), *value, *highestBid, nil
+}
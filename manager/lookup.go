@@ -0,0 +1,89 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/go-etherutils/ens/reverseregistrarcontract"
+	"github.com/orinocopay/go-etherutils/ens/reverseresolvercontract"
+)
+
+// Owner returns the owner of a name in the registry
+func (m *Manager) Owner(ctx context.Context, name string) (common.Address, error) {
+	return m.registry.Owner(&bind.CallOpts{Context: ctx}, ens.NameHash(name))
+}
+
+// Resolver returns the resolver of a name in the registry
+func (m *Manager) Resolver(ctx context.Context, name string) (common.Address, error) {
+	return m.registry.Resolver(&bind.CallOpts{Context: ctx}, ens.NameHash(name))
+}
+
+// Resolve resolves a name to an address.  If the input is already a hex
+// address then it is parsed strictly with ParseAddress.  Names without a
+// resolver of their own are resolved with a wildcard resolver of a parent,
+// if there is one
+func (m *Manager) Resolve(ctx context.Context, input string) (common.Address, error) {
+	if IsAddress(input) {
+		return ParseAddress(input)
+	}
+	var address common.Address
+	if err := m.resolverQuery(ctx, input, &address, "addr", ens.NameHash(input)); err != nil {
+		return ens.UnknownAddress, err
+	}
+	if address == ens.UnknownAddress {
+		return ens.UnknownAddress, fmt.Errorf("no address for %s", input)
+	}
+	return address, nil
+}
+
+// ReverseResolve resolves an address to a name
+func (m *Manager) ReverseResolve(ctx context.Context, address common.Address) (string, error) {
+	nameHash := ens.NameHash(fmt.Sprintf("%x.addr.reverse", address.Bytes()))
+	resolverAddress, err := m.registry.Resolver(&bind.CallOpts{Context: ctx}, nameHash)
+	if err != nil {
+		return "", err
+	}
+	if resolverAddress == ens.UnknownAddress {
+		return "", fmt.Errorf("no resolver for %s", address.Hex())
+	}
+	resolverContract, err := reverseresolvercontract.NewReverseResolverContract(resolverAddress, m.backend)
+	if err != nil {
+		return "", err
+	}
+	return resolverContract.Name(&bind.CallOpts{Context: ctx}, nameHash)
+}
+
+// PublicResolver returns the address of the public resolver, which is the
+// address of 'resolver.eth'
+func (m *Manager) PublicResolver(ctx context.Context) (common.Address, error) {
+	return m.Resolve(ctx, "resolver.eth")
+}
+
+// ReverseRegistrar returns the reverse registrar, which is the owner of the
+// 'addr.reverse' node
+func (m *Manager) ReverseRegistrar(ctx context.Context) (*reverseregistrarcontract.ReverseRegistrarContract, error) {
+	address, err := m.Owner(ctx, "addr.reverse")
+	if err != nil {
+		return nil, err
+	}
+	if address == ens.UnknownAddress {
+		return nil, fmt.Errorf("no reverse registrar")
+	}
+	return reverseregistrarcontract.NewReverseRegistrarContract(address, m.backend)
+}
@@ -0,0 +1,208 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package manager manages names in the Ethereum Name Service (ENS).  It
+// provides the operations behind the ens command as functions that return
+// structured results and errors, for use by other Go programs.
+package manager
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/go-etherutils/ens/registrarcontract"
+	"github.com/orinocopay/go-etherutils/ens/registrycontract"
+)
+
+// Backend is a contract backend that can also call contracts in the pending
+// state.  *ethclient.Client, *BatchBackend and *FailoverBackend are all
+// backends
+type Backend interface {
+	bind.ContractBackend
+	PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error)
+}
+
+// ChainBackend is a backend that can also obtain blocks, transactions and
+// logs.  *ethclient.Client, *BatchBackend and *FailoverBackend are all chain
+// backends
+type ChainBackend interface {
+	Backend
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// Manager manages ENS names with a single registry
+type Manager struct {
+	client           *ethclient.Client
+	backend          Backend
+	chainID          *big.Int
+	registryAddress  common.Address
+	registry         *registrycontract.RegistryContract
+	registrarAddress common.Address
+	registrar        *registrarcontract.RegistrarContract
+	// gracePeriod is the grace period of the permanent registrar, or 0 for
+	// the auction registrar
+	gracePeriod time.Duration
+	// blockTime is the time of the block against which lookups are made, or
+	// zero for the latest block
+	blockTime time.Time
+	// controllerAddress is the registrar controller, if not the one
+	// published for 'eth'
+	controllerAddress common.Address
+	// nameWrapperAddress is the name wrapper, if given rather than found
+	nameWrapperAddress common.Address
+	// nameWrappers notes which owners of names are name wrappers
+	nameWrappers *sync.Map
+	// gateways are the hosts from which off-chain lookups can be answered,
+	// or any host if empty
+	gateways           []string
+	maxOffchainLookups int
+	readOnly           bool
+}
+
+// New creates a manager for the registry at the given address.  If the
+// address is zero then the registry for the client's network is used.  The
+// registrar is the owner of the 'eth' node in the registry
+func New(ctx context.Context, client *ethclient.Client, registryAddress common.Address) (*Manager, error) {
+	chainID, err := client.NetworkID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if registryAddress == ens.UnknownAddress {
+		registryAddress, err = ens.RegistryContractAddress(client)
+		if err != nil {
+			return nil, err
+		}
+	}
+	registry, err := registrycontract.NewRegistryContract(registryAddress, client)
+	if err != nil {
+		return nil, err
+	}
+	m := &Manager{
+		client:             client,
+		backend:            client,
+		chainID:            chainID,
+		registryAddress:    registryAddress,
+		registry:           registry,
+		nameWrappers:       new(sync.Map),
+		maxOffchainLookups: defaultMaxOffchainLookups,
+	}
+	if err = m.setRegistrar(ctx); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// setRegistrar sets the registrar for 'eth', which is the owner of its node
+// in the registry, and its grace period if it is the permanent registrar
+func (m *Manager) setRegistrar(ctx context.Context) error {
+	registrarAddress, err := m.registry.Owner(&bind.CallOpts{Context: ctx}, ens.NameHash("eth"))
+	if err != nil {
+		return err
+	}
+	registrar, err := registrarcontract.NewRegistrarContract(registrarAddress, m.backend)
+	if err != nil {
+		return err
+	}
+	// Only the permanent registrar has a grace period
+	period, err := gracePeriod(ctx, m.backend, registrarAddress)
+	if err != nil {
+		return err
+	}
+	m.registrarAddress = registrarAddress
+	m.registrar = registrar
+	m.gracePeriod = period
+	return nil
+}
+
+// WithBackend returns a manager whose contracts are bound to the given
+// backend, for example a failover backend over several clients
+func (m *Manager) WithBackend(backend Backend) (*Manager, error) {
+	registry, err := registrycontract.NewRegistryContract(m.registryAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+	registrar, err := registrarcontract.NewRegistrarContract(m.registrarAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+	withBackend := *m
+	withBackend.backend = backend
+	withBackend.registry = registry
+	withBackend.registrar = registrar
+	return &withBackend, nil
+}
+
+// ReadOnly returns a manager that refuses to load keystores or send
+// transactions
+func (m *Manager) ReadOnly() *Manager {
+	readOnly := *m
+	readOnly.readOnly = true
+	return &readOnly
+}
+
+// Client returns the client used by the manager
+func (m *Manager) Client() *ethclient.Client {
+	return m.client
+}
+
+// chain returns the backend with which to obtain blocks and transactions:
+// the manager's backend if it can, otherwise its client
+func (m *Manager) chain() ChainBackend {
+	if chain, isChain := m.backend.(ChainBackend); isChain {
+		return chain
+	}
+	return m.client
+}
+
+// Backend returns the backend to which contracts are bound
+func (m *Manager) Backend() bind.ContractBackend {
+	return m.backend
+}
+
+// ChainID returns the ID of the chain on which the manager operates
+func (m *Manager) ChainID() *big.Int {
+	return m.chainID
+}
+
+// RegistryAddress returns the address of the registry
+func (m *Manager) RegistryAddress() common.Address {
+	return m.registryAddress
+}
+
+// Registry returns the registry contract
+func (m *Manager) Registry() *registrycontract.RegistryContract {
+	return m.registry
+}
+
+// RegistrarAddress returns the address of the registrar for 'eth'
+func (m *Manager) RegistrarAddress() common.Address {
+	return m.registrarAddress
+}
+
+// Registrar returns the registrar contract for 'eth'
+func (m *Manager) Registrar() *registrarcontract.RegistrarContract {
+	return m.registrar
+}
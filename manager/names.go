@@ -0,0 +1,113 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/orinocopay/go-etherutils/ens"
+)
+
+// ErrAmbiguous is returned when input could mean more than one name, or
+// could be either a name or an address
+var ErrAmbiguous = errors.New("ambiguous input")
+
+// IsAddress returns true if input has the form of a hex address, with or
+// without its 0x prefix.  Such input is not necessarily a valid address; see
+// ParseAddress
+func IsAddress(input string) bool {
+	hexAddress := strings.TrimPrefix(input, "0x")
+	if len(hexAddress) != 2*common.AddressLength {
+		return false
+	}
+	_, err := hex.DecodeString(hexAddress)
+	return err == nil
+}
+
+// ParseAddress parses a hex address.  The address must have its 0x prefix,
+// as without it the input could also be a name, and if it has mixed case
+// then it must have a valid EIP-55 checksum
+func ParseAddress(input string) (common.Address, error) {
+	if !IsAddress(input) {
+		return ens.UnknownAddress, fmt.Errorf("%s is not an address", input)
+	}
+	if !strings.HasPrefix(input, "0x") {
+		return ens.UnknownAddress, fmt.Errorf("%s could be a name or an address without its 0x prefix: %v", input, ErrAmbiguous)
+	}
+	address := common.HexToAddress(input)
+	hexAddress := input[2:]
+	if hexAddress != strings.ToLower(hexAddress) && hexAddress != strings.ToUpper(hexAddress) && address.Hex() != input {
+		return ens.UnknownAddress, fmt.Errorf("%s has an invalid checksum", input)
+	}
+	return address, nil
+}
+
+// FullName returns the name meant by a name given without its top-level
+// domain, which is taken to be 'eth'.  A top-level domain is recognised by
+// having an owner in the registry, so:
+//
+//   - a name of several labels is complete if its last label is a top-level
+//     domain, otherwise 'eth' is added if that makes a name whose parent
+//     under 'eth' is registered
+//   - a single label is a top-level domain if it has an owner, otherwise 'eth'
+//     is added; it is ambiguous if it is a top-level domain and the name with
+//     'eth' added also has an owner
+//
+// Names that cannot be read either way are errors
+func (m *Manager) FullName(ctx context.Context, input string) (string, error) {
+	name := strings.TrimSuffix(input, ".")
+	if name == "" {
+		return "", errors.New("empty name")
+	}
+	labels := strings.Split(name, ".")
+	tld := labels[len(labels)-1]
+	if tld == "eth" {
+		return name, nil
+	}
+	tldOwner, err := m.Owner(ctx, tld)
+	if err != nil {
+		return "", err
+	}
+
+	if len(labels) > 1 {
+		if tldOwner != ens.UnknownAddress {
+			return name, nil
+		}
+		parentOwner, err := m.Owner(ctx, tld+".eth")
+		if err != nil {
+			return "", err
+		}
+		if parentOwner == ens.UnknownAddress {
+			return "", fmt.Errorf("%s is not a top-level domain and %s.eth is not registered", tld, tld)
+		}
+		return name + ".eth", nil
+	}
+
+	if tldOwner == ens.UnknownAddress {
+		return name + ".eth", nil
+	}
+	ethOwner, err := m.Owner(ctx, name+".eth")
+	if err != nil {
+		return "", err
+	}
+	if ethOwner != ens.UnknownAddress {
+		return "", fmt.Errorf("%s could be the top-level domain or %s.eth: %v", name, name, ErrAmbiguous)
+	}
+	return name, nil
+}
@@ -0,0 +1,553 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+//go:generate go run emoji_gen.go emoji-test.txt
+//go:generate go run normalize_gen.go spec.json testdata/ensip15-tests.json
+
+// scriptGroup is a group of characters that can be used together in a
+// label, such as a script along with the characters common to all scripts
+type scriptGroup struct {
+	name      string
+	primary   *unicode.RangeTable
+	secondary *unicode.RangeTable
+	// combiningMarks is true if the group allows any number of non-spacing
+	// marks
+	combiningMarks bool
+}
+
+// contains returns true if a character belongs to the group
+func (g *scriptGroup) contains(r rune) bool {
+	return unicode.Is(g.primary, r) || unicode.Is(g.secondary, r)
+}
+
+var wholesOnce sync.Once
+
+// confusedWith maps a character that looks like characters of other groups
+// to those groups
+var confusedWith map[rune]map[*scriptGroup]bool
+
+// distinctive are characters that belong to a single group and look like no
+// other character, so a label containing one cannot be mistaken for a
+// label in another group
+var distinctive map[rune]bool
+
+// lookalikes map a character to the ASCII character that it looks like
+var lookalikes map[rune]rune
+
+// emojiNode is a node in the trie of emoji sequences
+type emojiNode struct {
+	children map[rune]*emojiNode
+	// complete is true if the path to the node is an emoji sequence
+	complete bool
+}
+
+var emojiTrie *emojiNode
+var emojiOnce sync.Once
+
+// labelToken is part of a label: either an emoji sequence or text
+type labelToken struct {
+	emoji bool
+	runes []rune
+}
+
+// Normalize normalizes a name following ENSIP-15, returning an error that
+// gives the reason if the name is not valid.  Each label is split in to
+// emoji sequences, which are kept without their presentation selectors, and
+// text, which is mapped and must then consist of valid characters.  The text
+// of a label must belong to a single group of characters, and must not be
+// wholly made of characters that look like those of another group.  Names
+// that can be mistaken for others although valid are reported by Confusable
+func Normalize(name string) (string, error) {
+	if name == "" {
+		return "", errors.New("empty name")
+	}
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		normalized, err := normalizeLabel(label)
+		if err != nil {
+			if label == "" {
+				return "", err
+			}
+			return "", fmt.Errorf("invalid label %q: %v", label, err)
+		}
+		labels[i] = normalized
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// normalizeLabel normalizes a single label
+func normalizeLabel(label string) (string, error) {
+	if label == "" {
+		return "", errors.New("empty label")
+	}
+	tokens, err := tokenizeLabel([]rune(label))
+	if err != nil {
+		return "", err
+	}
+	if err = checkLabel(tokens); err != nil {
+		return "", err
+	}
+	var normalized strings.Builder
+	for _, token := range tokens {
+		normalized.WriteString(string(token.runes))
+	}
+	return normalized.String(), nil
+}
+
+// tokenizeLabel splits a label in to emoji sequences and mapped text
+func tokenizeLabel(runes []rune) ([]*labelToken, error) {
+	tokens := make([]*labelToken, 0)
+	text := make([]rune, 0)
+	// flush ends the text so far, in its composed form
+	flush := func() {
+		if len(text) > 0 {
+			tokens = append(tokens, &labelToken{runes: []rune(norm.NFC.String(string(text)))})
+			text = make([]rune, 0)
+		}
+	}
+	for i := 0; i < len(runes); {
+		if length, emoji := matchEmoji(runes[i:]); length > 0 {
+			flush()
+			tokens = append(tokens, &labelToken{emoji: true, runes: emoji})
+			i += length
+			continue
+		}
+		mapped, err := mapRune(runes[i])
+		if err != nil {
+			return nil, err
+		}
+		text = append(text, mapped...)
+		i++
+	}
+	flush()
+	if len(tokens) == 0 {
+		// Everything was ignored
+		return nil, errors.New("empty label")
+	}
+	return tokens, nil
+}
+
+// describeRune describes a character for an error
+func describeRune(r rune) string {
+	return fmt.Sprintf("%s (U+%04X)", strconv.QuoteRune(r), r)
+}
+
+// mapRune maps a text character, returning an error if it is not allowed
+func mapRune(r rune) ([]rune, error) {
+	switch {
+	case unicode.Is(validCharacters, r):
+		return []rune{r}, nil
+	case unicode.Is(ignoredCharacters, r):
+		return nil, nil
+	}
+	if mapped, exists := mappedCharacters[r]; exists {
+		return []rune(mapped), nil
+	}
+	return nil, fmt.Errorf("disallowed character %s", describeRune(r))
+}
+
+// loadEmoji builds the trie of emoji sequences
+func loadEmoji() {
+	emojiTrie = &emojiNode{children: make(map[rune]*emojiNode)}
+	for _, line := range strings.Split(emojiSequences, "\n") {
+		node := emojiTrie
+		for _, codePoint := range strings.Fields(line) {
+			value, err := strconv.ParseUint(codePoint, 16, 32)
+			if err != nil {
+				panic(err)
+			}
+			child, exists := node.children[rune(value)]
+			if !exists {
+				child = &emojiNode{children: make(map[rune]*emojiNode)}
+				node.children[rune(value)] = child
+			}
+			node = child
+		}
+		node.complete = true
+	}
+}
+
+// matchEmoji returns the number of characters in the longest emoji sequence
+// at the start of runes, and the sequence without presentation selectors.
+// Presentation selectors are optional in the input.  It returns 0 if there
+// is no emoji sequence
+func matchEmoji(runes []rune) (int, []rune) {
+	emojiOnce.Do(loadEmoji)
+	node := emojiTrie
+	sequence := make([]rune, 0)
+	length := 0
+	var match []rune
+	for i, r := range runes {
+		if r == '\ufe0f' {
+			if i == 0 {
+				break
+			}
+			if node.complete && length == i {
+				length = i + 1
+			}
+			continue
+		}
+		child, exists := node.children[r]
+		if !exists {
+			break
+		}
+		node = child
+		sequence = append(sequence, r)
+		if node.complete {
+			length = i + 1
+			match = append([]rune{}, sequence...)
+		}
+	}
+	return length, match
+}
+
+// checkLabel checks the rules for the characters of a label
+func checkLabel(tokens []*labelToken) error {
+	runes := make([]rune, 0)
+	text := make([]rune, 0)
+	ascii := true
+	for _, token := range tokens {
+		for _, r := range token.runes {
+			ascii = ascii && r < utf8.RuneSelf
+		}
+		runes = append(runes, token.runes...)
+		if !token.emoji {
+			text = append(text, token.runes...)
+		}
+	}
+
+	leading := 0
+	for leading < len(runes) && runes[leading] == '_' {
+		leading++
+	}
+	for _, r := range runes[leading:] {
+		if r == '_' {
+			return errors.New("underscore allowed only at start")
+		}
+	}
+	if ascii && !tokens[0].emoji && len(tokens) == 1 {
+		if len(runes) >= 4 && runes[2] == '-' && runes[3] == '-' {
+			return errors.New("invalid label extension")
+		}
+		return nil
+	}
+	if len(text) == 0 {
+		// Wholly emoji
+		return nil
+	}
+
+	if unicode.Is(combiningMarks, runes[0]) {
+		return errors.New("leading combining mark")
+	}
+	for i, token := range tokens {
+		if i > 0 && !token.emoji && unicode.Is(combiningMarks, token.runes[0]) {
+			return errors.New("combining mark after emoji")
+		}
+	}
+	if err := checkFenced(runes); err != nil {
+		return err
+	}
+
+	unique := make([]rune, 0)
+	seen := make(map[rune]bool)
+	for _, r := range text {
+		if !seen[r] {
+			seen[r] = true
+			unique = append(unique, r)
+		}
+	}
+	group, err := labelGroup(unique)
+	if err != nil {
+		return err
+	}
+	if !group.combiningMarks {
+		if err := checkNonSpacingMarks(text); err != nil {
+			return err
+		}
+	}
+	return checkWhole(group, unique)
+}
+
+// checkFenced checks that fenced characters are not at the start or end of
+// a label, or next to each other
+func checkFenced(runes []rune) error {
+	if name, exists := fencedCharacters[runes[0]]; exists {
+		return fmt.Errorf("leading %s", name)
+	}
+	for i := 1; i < len(runes); i++ {
+		name, exists := fencedCharacters[runes[i]]
+		if !exists {
+			continue
+		}
+		if previous, exists := fencedCharacters[runes[i-1]]; exists {
+			return fmt.Errorf("adjacent %s and %s", previous, name)
+		}
+		if i == len(runes)-1 {
+			return fmt.Errorf("trailing %s", name)
+		}
+	}
+	return nil
+}
+
+// labelGroup returns the first group that contains all of the characters of
+// the text of a label
+func labelGroup(unique []rune) (*scriptGroup, error) {
+	groups := scriptGroups
+	for _, r := range unique {
+		remaining := make([]*scriptGroup, 0, len(groups))
+		for _, group := range groups {
+			if group.contains(r) {
+				remaining = append(remaining, group)
+			}
+		}
+		if len(remaining) == 0 {
+			return nil, mixtureError(groups[0], r)
+		}
+		groups = remaining
+		if len(groups) == 1 {
+			break
+		}
+	}
+	for _, r := range unique {
+		if !groups[0].contains(r) {
+			return nil, mixtureError(groups[0], r)
+		}
+	}
+	return groups[0], nil
+}
+
+// mixtureError describes a character that does not belong to the group of
+// the rest of a label
+func mixtureError(group *scriptGroup, r rune) error {
+	for _, other := range scriptGroups {
+		if unicode.Is(other.primary, r) {
+			return fmt.Errorf("illegal mixture: %s + %s %s", group.name, other.name, describeRune(r))
+		}
+	}
+	return fmt.Errorf("illegal mixture: %s + %s", group.name, describeRune(r))
+}
+
+// checkNonSpacingMarks checks that no character of text is followed by more
+// than the maximum number of non-spacing marks, or by the same mark twice
+func checkNonSpacingMarks(text []rune) error {
+	decomposed := []rune(norm.NFD.String(string(text)))
+	for i := 1; i < len(decomposed); i++ {
+		if !unicode.Is(nonSpacingMarks, decomposed[i]) {
+			continue
+		}
+		j := i + 1
+		for ; j < len(decomposed) && unicode.Is(nonSpacingMarks, decomposed[j]); j++ {
+			for _, previous := range decomposed[i:j] {
+				if previous == decomposed[j] {
+					return fmt.Errorf("duplicate non-spacing mark %s", describeRune(previous))
+				}
+			}
+		}
+		if j-i > maxNonSpacingMarks {
+			return errors.New("too many non-spacing marks")
+		}
+		i = j
+	}
+	return nil
+}
+
+// loadWholes finds the groups that each confusable character can be
+// mistaken for, and the characters that cannot be mistaken at all
+func loadWholes() {
+	confusedWith = make(map[rune]map[*scriptGroup]bool)
+	lookalikes = make(map[rune]rune)
+	for _, whole := range wholeConfusables {
+		// Characters of the whole are split in to sets that share groups,
+		// each of which can be mistaken for the groups of the others
+		type confusableSet struct {
+			groups map[*scriptGroup]bool
+			runes  []rune
+		}
+		sets := make([]*confusableSet, 0)
+		ascii := rune(-1)
+		for _, r := range whole {
+			if r < utf8.RuneSelf && ascii == -1 {
+				ascii = r
+			}
+			groups := make([]*scriptGroup, 0)
+			for _, group := range scriptGroups {
+				if group.contains(r) {
+					groups = append(groups, group)
+				}
+			}
+			var set *confusableSet
+			for _, existing := range sets {
+				for _, group := range groups {
+					if set == nil && existing.groups[group] {
+						set = existing
+					}
+				}
+			}
+			if set == nil {
+				set = &confusableSet{groups: make(map[*scriptGroup]bool)}
+				sets = append(sets, set)
+			}
+			set.runes = append(set.runes, r)
+			for _, group := range groups {
+				set.groups[group] = true
+			}
+		}
+		for _, set := range sets {
+			others := make(map[*scriptGroup]bool)
+			for _, other := range sets {
+				for group := range other.groups {
+					if !set.groups[group] {
+						others[group] = true
+					}
+				}
+			}
+			for _, r := range set.runes {
+				confusedWith[r] = others
+				if ascii != -1 && r != ascii {
+					lookalikes[r] = ascii
+				}
+			}
+		}
+	}
+
+	members := make(map[rune]int)
+	for _, group := range scriptGroups {
+		for _, table := range []*unicode.RangeTable{group.primary, group.secondary} {
+			for _, r16 := range table.R16 {
+				for r := rune(r16.Lo); r <= rune(r16.Hi); r += rune(r16.Stride) {
+					members[r]++
+				}
+			}
+			for _, r32 := range table.R32 {
+				for r := rune(r32.Lo); r <= rune(r32.Hi); r += rune(r32.Stride) {
+					members[r]++
+				}
+			}
+		}
+	}
+	distinctive = make(map[rune]bool)
+	for r, count := range members {
+		if _, exists := confusedWith[r]; !exists && count == 1 {
+			distinctive[r] = true
+		}
+	}
+}
+
+// checkWhole checks that the text of a label in a group is not made wholly
+// of characters that look like those of another group
+func checkWhole(group *scriptGroup, unique []rune) error {
+	wholesOnce.Do(loadWholes)
+	var candidates []*scriptGroup
+	shared := make([]rune, 0)
+	for _, r := range unique {
+		if distinctive[r] {
+			return nil
+		}
+		others, exists := confusedWith[r]
+		if !exists {
+			shared = append(shared, r)
+			continue
+		}
+		if candidates == nil {
+			candidates = make([]*scriptGroup, 0)
+			for _, other := range scriptGroups {
+				if others[other] {
+					candidates = append(candidates, other)
+				}
+			}
+		} else {
+			remaining := make([]*scriptGroup, 0, len(candidates))
+			for _, candidate := range candidates {
+				if others[candidate] {
+					remaining = append(remaining, candidate)
+				}
+			}
+			candidates = remaining
+		}
+		if len(candidates) == 0 {
+			return nil
+		}
+	}
+	for _, candidate := range candidates {
+		contained := true
+		for _, r := range shared {
+			contained = contained && candidate.contains(r)
+		}
+		if contained {
+			return fmt.Errorf("whole-script confusable: %s/%s", group.name, candidate.name)
+		}
+	}
+	return nil
+}
+
+// lookalikeText returns text with each character that is confusable with
+// an ASCII character replaced by that character, and true if any were
+// replaced
+func lookalikeText(text []rune) (string, bool) {
+	wholesOnce.Do(loadWholes)
+	replaced := false
+	lookalike := make([]rune, len(text))
+	for i, r := range text {
+		if ascii, exists := lookalikes[r]; exists {
+			r = ascii
+			replaced = true
+		}
+		lookalike[i] = r
+	}
+	return string(lookalike), replaced
+}
+
+// Confusable returns the name that a normalized name could be mistaken
+// for, if it uses characters that the ENSIP-15 confusable data lists as
+// looking like ASCII characters, for example a Latin alpha in place of an
+// 'a'.  Accented letters are distinct and are not reported.  Emoji are left
+// as they are
+func Confusable(name string) (string, bool) {
+	labels := strings.Split(name, ".")
+	confusable := false
+	for i, label := range labels {
+		tokens, err := tokenizeLabel([]rune(label))
+		if err != nil {
+			return "", false
+		}
+		var lookalike strings.Builder
+		for _, token := range tokens {
+			if token.emoji {
+				lookalike.WriteString(string(token.runes))
+				continue
+			}
+			text, replaced := lookalikeText(token.runes)
+			confusable = confusable || replaced
+			lookalike.WriteString(text)
+		}
+		labels[i] = lookalike.String()
+	}
+	if !confusable {
+		return "", false
+	}
+	return strings.Join(labels, "."), true
+}
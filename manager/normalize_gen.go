@@ -0,0 +1,248 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+// +build ignore
+
+// This program generates normalize_tables.go from the ENSIP-15 specification
+// data, spec.json, published with the reference implementation
+// ens-normalize.js in derive/output.  Run it with the path to spec.json and
+// the path at which to keep the matching test vectors, tests.json:
+//
+//	go run normalize_gen.go spec.json testdata/ensip15-tests.json
+//
+// Either file that does not exist is first downloaded from ens-normalize.js,
+// so that the tables and the vectors that they are tested against come from
+// the same release.
+//
+// Emoji sequences are generated separately by emoji_gen.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// specification is the part of spec.json used for normalization
+type specification struct {
+	Created string              `json:"created"`
+	Unicode string              `json:"unicode"`
+	Ignored []rune              `json:"ignored"`
+	Mapped  [][]json.RawMessage `json:"mapped"`
+	Fenced  [][]json.RawMessage `json:"fenced"`
+	Wholes  []struct {
+		Valid    []rune `json:"valid"`
+		Confused []rune `json:"confused"`
+	} `json:"wholes"`
+	CM     []rune `json:"cm"`
+	NSM    []rune `json:"nsm"`
+	NSMMax int    `json:"nsm_max"`
+	Groups []struct {
+		Name      string `json:"name"`
+		Primary   []rune `json:"primary"`
+		Secondary []rune `json:"secondary"`
+		CM        bool   `json:"cm"`
+	} `json:"groups"`
+}
+
+// specificationURL is the location of ens-normalize.js, which publishes
+// spec.json in derive/output and tests.json in validate
+const specificationURL = "https://raw.githubusercontent.com/adraffy/ens-normalize.js/main/"
+
+// fetch downloads a file from ens-normalize.js to the given path, unless it
+// is already present
+func fetch(path string, file string) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	response, err := http.Get(specificationURL + file)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %s: %s", file, response.Status)
+	}
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatal("usage: go run normalize_gen.go spec.json testdata/ensip15-tests.json")
+	}
+	if err := fetch(os.Args[1], "derive/output/spec.json"); err != nil {
+		log.Fatal(err)
+	}
+	if err := fetch(os.Args[2], "validate/tests.json"); err != nil {
+		log.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	spec := &specification{}
+	if err = json.Unmarshal(data, spec); err != nil {
+		log.Fatal(err)
+	}
+
+	// Valid characters are those of any group, along with their
+	// decomposed forms
+	valid := make(map[rune]bool)
+	for _, group := range spec.Groups {
+		for _, r := range append(append([]rune{}, group.Primary...), group.Secondary...) {
+			valid[r] = true
+			for _, d := range norm.NFD.String(string(r)) {
+				valid[d] = true
+			}
+		}
+	}
+
+	tables := make(map[string]string)
+	var declarations bytes.Buffer
+	table := func(runes []rune) string {
+		literal := rangeTable(runes)
+		name, exists := tables[literal]
+		if !exists {
+			name = fmt.Sprintf("normalizationTable%d", len(tables))
+			tables[literal] = name
+			fmt.Fprintf(&declarations, "var %s = %s\n\n", name, literal)
+		}
+		return name
+	}
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by normalize_gen.go. DO NOT EDIT.\n\npackage manager\n\nimport \"unicode\"\n\n")
+	source.WriteString("// normalizationSource describes the ENSIP-15 data that the tables are\n// generated from\n")
+	fmt.Fprintf(&source, "const normalizationSource = %q\n\n", fmt.Sprintf("%s (Unicode %s)", spec.Created, spec.Unicode))
+	fmt.Fprintf(&source, "// maxNonSpacingMarks is the largest number of non-spacing marks allowed in\n// a row\nconst maxNonSpacingMarks = %d\n\n", spec.NSMMax)
+
+	fmt.Fprintf(&source, "// validCharacters are the characters allowed in the text of a label\nvar validCharacters = %s\n\n", table(keys(valid)))
+	fmt.Fprintf(&source, "// ignoredCharacters are removed from the text of a label\nvar ignoredCharacters = %s\n\n", table(spec.Ignored))
+	fmt.Fprintf(&source, "// combiningMarks are the characters that cannot start a label, or follow\n// an emoji sequence\nvar combiningMarks = %s\n\n", table(spec.CM))
+	fmt.Fprintf(&source, "// nonSpacingMarks are limited in number after each character\nvar nonSpacingMarks = %s\n\n", table(spec.NSM))
+
+	source.WriteString("// mappedCharacters are replaced in the text of a label\nvar mappedCharacters = map[rune]string{\n")
+	for _, mapping := range spec.Mapped {
+		var from rune
+		var to []rune
+		if len(mapping) != 2 || json.Unmarshal(mapping[0], &from) != nil || json.Unmarshal(mapping[1], &to) != nil {
+			log.Fatalf("invalid mapping %s", mapping)
+		}
+		fmt.Fprintf(&source, "0x%04X: %s,\n", from, strconv.QuoteToASCII(string(to)))
+	}
+	source.WriteString("}\n\n")
+
+	source.WriteString("// fencedCharacters are allowed within a label but not at its start or end,\n// or next to each other\nvar fencedCharacters = map[rune]string{\n")
+	for _, fenced := range spec.Fenced {
+		var r rune
+		var name string
+		if len(fenced) != 2 || json.Unmarshal(fenced[0], &r) != nil || json.Unmarshal(fenced[1], &name) != nil {
+			log.Fatalf("invalid fenced character %s", fenced)
+		}
+		fmt.Fprintf(&source, "0x%04X: %q,\n", r, name)
+	}
+	source.WriteString("}\n\n")
+
+	source.WriteString("// scriptGroups are the groups of characters that can be used together in\n// a label, in order of preference\nvar scriptGroups = []*scriptGroup{\n")
+	for _, group := range spec.Groups {
+		fmt.Fprintf(&source, "{name: %q, primary: %s, secondary: %s, combiningMarks: %v},\n", group.Name, table(group.Primary), table(group.Secondary), group.CM)
+	}
+	source.WriteString("}\n\n")
+
+	source.WriteString("// wholeConfusables are sets of characters that look alike, used to find\n// labels that can be mistaken for labels in another group\nvar wholeConfusables = [][]rune{\n")
+	for _, whole := range spec.Wholes {
+		runes := append(append([]rune{}, whole.Valid...), whole.Confused...)
+		sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+		hex := make([]string, len(runes))
+		for i, r := range runes {
+			hex[i] = fmt.Sprintf("0x%04X", r)
+		}
+		fmt.Fprintf(&source, "{%s},\n", strings.Join(hex, ", "))
+	}
+	source.WriteString("}\n\n")
+	source.Write(declarations.Bytes())
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile("normalize_tables.go", formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// keys returns the characters of a set
+func keys(set map[rune]bool) []rune {
+	runes := make([]rune, 0, len(set))
+	for r := range set {
+		runes = append(runes, r)
+	}
+	return runes
+}
+
+// rangeTable returns the source of a range table holding the characters
+func rangeTable(runes []rune) string {
+	sorted := append([]rune{}, runes...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	r16 := make([]string, 0)
+	r32 := make([]string, 0)
+	latinOffset := 0
+	for i := 0; i < len(sorted); {
+		j := i
+		for j+1 < len(sorted) && sorted[j+1] <= sorted[j]+1 {
+			j++
+		}
+		lo, hi := sorted[i], sorted[j]
+		if hi <= 0xFFFF {
+			r16 = append(r16, fmt.Sprintf("{0x%04X, 0x%04X, 1}", lo, hi))
+			if hi <= unicode.MaxLatin1 {
+				latinOffset++
+			}
+		} else if lo <= 0xFFFF {
+			r16 = append(r16, fmt.Sprintf("{0x%04X, 0xFFFF, 1}", lo))
+			r32 = append(r32, fmt.Sprintf("{0x10000, 0x%X, 1}", hi))
+		} else {
+			r32 = append(r32, fmt.Sprintf("{0x%X, 0x%X, 1}", lo, hi))
+		}
+		i = j + 1
+	}
+	var table bytes.Buffer
+	table.WriteString("&unicode.RangeTable{\n")
+	if len(r16) > 0 {
+		fmt.Fprintf(&table, "R16: []unicode.Range16{\n%s,\n},\n", strings.Join(r16, ",\n"))
+	}
+	if len(r32) > 0 {
+		fmt.Fprintf(&table, "R32: []unicode.Range32{\n%s,\n},\n", strings.Join(r32, ",\n"))
+	}
+	if latinOffset > 0 {
+		fmt.Fprintf(&table, "LatinOffset: %d,\n", latinOffset)
+	}
+	table.WriteString("}")
+	return table.String()
+}
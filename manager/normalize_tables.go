@@ -0,0 +1,10649 @@
+// These tables are a bootstrap in the layout that normalize_gen.go writes,
+// taken from the UTS-46 mapping of golang.org/x/net/idna and the scripts of
+// package unicode rather than from the ENSIP-15 spec.json, so names that
+// differ between the two normalize differently from other ENS clients.
+// Replace them by running go generate, which downloads spec.json and the
+// ENSIP-15 test vectors from ens-normalize.js and writes this file with a
+// generated header.
+
+package manager
+
+import "unicode"
+
+// normalizationSource describes the ENSIP-15 data that the tables are
+// generated from
+const normalizationSource = "bootstrapped from the UTS-46 mapping of golang.org/x/net/idna and the scripts of package unicode, not the published ENSIP-15 spec.json (Unicode 17.0.0)"
+
+// maxNonSpacingMarks is the largest number of non-spacing marks allowed in
+// a row
+const maxNonSpacingMarks = 4
+
+// validCharacters are the characters allowed in the text of a label
+var validCharacters = normalizationTable0
+
+// ignoredCharacters are removed from the text of a label
+var ignoredCharacters = normalizationTable1
+
+// combiningMarks are the characters that cannot start a label, or follow
+// an emoji sequence
+var combiningMarks = normalizationTable2
+
+// nonSpacingMarks are limited in number after each character
+var nonSpacingMarks = normalizationTable3
+
+// mappedCharacters are replaced in the text of a label
+var mappedCharacters = map[rune]string{
+	0x0027:  "\u2019",
+	0x0041:  "a",
+	0x0042:  "b",
+	0x0043:  "c",
+	0x0044:  "d",
+	0x0045:  "e",
+	0x0046:  "f",
+	0x0047:  "g",
+	0x0048:  "h",
+	0x0049:  "i",
+	0x004A:  "j",
+	0x004B:  "k",
+	0x004C:  "l",
+	0x004D:  "m",
+	0x004E:  "n",
+	0x004F:  "o",
+	0x0050:  "p",
+	0x0051:  "q",
+	0x0052:  "r",
+	0x0053:  "s",
+	0x0054:  "t",
+	0x0055:  "u",
+	0x0056:  "v",
+	0x0057:  "w",
+	0x0058:  "x",
+	0x0059:  "y",
+	0x005A:  "z",
+	0x00AA:  "a",
+	0x00B2:  "2",
+	0x00B3:  "3",
+	0x00B5:  "\u03bc",
+	0x00B9:  "1",
+	0x00BA:  "o",
+	0x00BC:  "1\u20444",
+	0x00BD:  "1\u20442",
+	0x00BE:  "3\u20444",
+	0x00C0:  "\u00e0",
+	0x00C1:  "\u00e1",
+	0x00C2:  "\u00e2",
+	0x00C3:  "\u00e3",
+	0x00C4:  "\u00e4",
+	0x00C5:  "\u00e5",
+	0x00C6:  "\u00e6",
+	0x00C7:  "\u00e7",
+	0x00C8:  "\u00e8",
+	0x00C9:  "\u00e9",
+	0x00CA:  "\u00ea",
+	0x00CB:  "\u00eb",
+	0x00CC:  "\u00ec",
+	0x00CD:  "\u00ed",
+	0x00CE:  "\u00ee",
+	0x00CF:  "\u00ef",
+	0x00D0:  "\u00f0",
+	0x00D1:  "\u00f1",
+	0x00D2:  "\u00f2",
+	0x00D3:  "\u00f3",
+	0x00D4:  "\u00f4",
+	0x00D5:  "\u00f5",
+	0x00D6:  "\u00f6",
+	0x00D8:  "\u00f8",
+	0x00D9:  "\u00f9",
+	0x00DA:  "\u00fa",
+	0x00DB:  "\u00fb",
+	0x00DC:  "\u00fc",
+	0x00DD:  "\u00fd",
+	0x00DE:  "\u00fe",
+	0x0100:  "\u0101",
+	0x0102:  "\u0103",
+	0x0104:  "\u0105",
+	0x0106:  "\u0107",
+	0x0108:  "\u0109",
+	0x010A:  "\u010b",
+	0x010C:  "\u010d",
+	0x010E:  "\u010f",
+	0x0110:  "\u0111",
+	0x0112:  "\u0113",
+	0x0114:  "\u0115",
+	0x0116:  "\u0117",
+	0x0118:  "\u0119",
+	0x011A:  "\u011b",
+	0x011C:  "\u011d",
+	0x011E:  "\u011f",
+	0x0120:  "\u0121",
+	0x0122:  "\u0123",
+	0x0124:  "\u0125",
+	0x0126:  "\u0127",
+	0x0128:  "\u0129",
+	0x012A:  "\u012b",
+	0x012C:  "\u012d",
+	0x012E:  "\u012f",
+	0x0130:  "i\u0307",
+	0x0132:  "ij",
+	0x0133:  "ij",
+	0x0134:  "\u0135",
+	0x0136:  "\u0137",
+	0x0139:  "\u013a",
+	0x013B:  "\u013c",
+	0x013D:  "\u013e",
+	0x013F:  "l\u00b7",
+	0x0140:  "l\u00b7",
+	0x0141:  "\u0142",
+	0x0143:  "\u0144",
+	0x0145:  "\u0146",
+	0x0147:  "\u0148",
+	0x0149:  "\u02bcn",
+	0x014A:  "\u014b",
+	0x014C:  "\u014d",
+	0x014E:  "\u014f",
+	0x0150:  "\u0151",
+	0x0152:  "\u0153",
+	0x0154:  "\u0155",
+	0x0156:  "\u0157",
+	0x0158:  "\u0159",
+	0x015A:  "\u015b",
+	0x015C:  "\u015d",
+	0x015E:  "\u015f",
+	0x0160:  "\u0161",
+	0x0162:  "\u0163",
+	0x0164:  "\u0165",
+	0x0166:  "\u0167",
+	0x0168:  "\u0169",
+	0x016A:  "\u016b",
+	0x016C:  "\u016d",
+	0x016E:  "\u016f",
+	0x0170:  "\u0171",
+	0x0172:  "\u0173",
+	0x0174:  "\u0175",
+	0x0176:  "\u0177",
+	0x0178:  "\u00ff",
+	0x0179:  "\u017a",
+	0x017B:  "\u017c",
+	0x017D:  "\u017e",
+	0x017F:  "s",
+	0x0181:  "\u0253",
+	0x0182:  "\u0183",
+	0x0184:  "\u0185",
+	0x0186:  "\u0254",
+	0x0187:  "\u0188",
+	0x0189:  "\u0256",
+	0x018A:  "\u0257",
+	0x018B:  "\u018c",
+	0x018E:  "\u01dd",
+	0x018F:  "\u0259",
+	0x0190:  "\u025b",
+	0x0191:  "\u0192",
+	0x0193:  "\u0260",
+	0x0194:  "\u0263",
+	0x0196:  "\u0269",
+	0x0197:  "\u0268",
+	0x0198:  "\u0199",
+	0x019C:  "\u026f",
+	0x019D:  "\u0272",
+	0x019F:  "\u0275",
+	0x01A0:  "\u01a1",
+	0x01A2:  "\u01a3",
+	0x01A4:  "\u01a5",
+	0x01A6:  "\u0280",
+	0x01A7:  "\u01a8",
+	0x01A9:  "\u0283",
+	0x01AC:  "\u01ad",
+	0x01AE:  "\u0288",
+	0x01AF:  "\u01b0",
+	0x01B1:  "\u028a",
+	0x01B2:  "\u028b",
+	0x01B3:  "\u01b4",
+	0x01B5:  "\u01b6",
+	0x01B7:  "\u0292",
+	0x01B8:  "\u01b9",
+	0x01BC:  "\u01bd",
+	0x01C4:  "d\u017e",
+	0x01C5:  "d\u017e",
+	0x01C6:  "d\u017e",
+	0x01C7:  "lj",
+	0x01C8:  "lj",
+	0x01C9:  "lj",
+	0x01CA:  "nj",
+	0x01CB:  "nj",
+	0x01CC:  "nj",
+	0x01CD:  "\u01ce",
+	0x01CF:  "\u01d0",
+	0x01D1:  "\u01d2",
+	0x01D3:  "\u01d4",
+	0x01D5:  "\u01d6",
+	0x01D7:  "\u01d8",
+	0x01D9:  "\u01da",
+	0x01DB:  "\u01dc",
+	0x01DE:  "\u01df",
+	0x01E0:  "\u01e1",
+	0x01E2:  "\u01e3",
+	0x01E4:  "\u01e5",
+	0x01E6:  "\u01e7",
+	0x01E8:  "\u01e9",
+	0x01EA:  "\u01eb",
+	0x01EC:  "\u01ed",
+	0x01EE:  "\u01ef",
+	0x01F1:  "dz",
+	0x01F2:  "dz",
+	0x01F3:  "dz",
+	0x01F4:  "\u01f5",
+	0x01F6:  "\u0195",
+	0x01F7:  "\u01bf",
+	0x01F8:  "\u01f9",
+	0x01FA:  "\u01fb",
+	0x01FC:  "\u01fd",
+	0x01FE:  "\u01ff",
+	0x0200:  "\u0201",
+	0x0202:  "\u0203",
+	0x0204:  "\u0205",
+	0x0206:  "\u0207",
+	0x0208:  "\u0209",
+	0x020A:  "\u020b",
+	0x020C:  "\u020d",
+	0x020E:  "\u020f",
+	0x0210:  "\u0211",
+	0x0212:  "\u0213",
+	0x0214:  "\u0215",
+	0x0216:  "\u0217",
+	0x0218:  "\u0219",
+	0x021A:  "\u021b",
+	0x021C:  "\u021d",
+	0x021E:  "\u021f",
+	0x0220:  "\u019e",
+	0x0222:  "\u0223",
+	0x0224:  "\u0225",
+	0x0226:  "\u0227",
+	0x0228:  "\u0229",
+	0x022A:  "\u022b",
+	0x022C:  "\u022d",
+	0x022E:  "\u022f",
+	0x0230:  "\u0231",
+	0x0232:  "\u0233",
+	0x023A:  "\u2c65",
+	0x023B:  "\u023c",
+	0x023D:  "\u019a",
+	0x023E:  "\u2c66",
+	0x0241:  "\u0242",
+	0x0243:  "\u0180",
+	0x0244:  "\u0289",
+	0x0245:  "\u028c",
+	0x0246:  "\u0247",
+	0x0248:  "\u0249",
+	0x024A:  "\u024b",
+	0x024C:  "\u024d",
+	0x024E:  "\u024f",
+	0x02B0:  "h",
+	0x02B1:  "\u0266",
+	0x02B2:  "j",
+	0x02B3:  "r",
+	0x02B4:  "\u0279",
+	0x02B5:  "\u027b",
+	0x02B6:  "\u0281",
+	0x02B7:  "w",
+	0x02B8:  "y",
+	0x02E0:  "\u0263",
+	0x02E1:  "l",
+	0x02E2:  "s",
+	0x02E3:  "x",
+	0x02E4:  "\u0295",
+	0x0340:  "\u0300",
+	0x0341:  "\u0301",
+	0x0343:  "\u0313",
+	0x0344:  "\u0308\u0301",
+	0x0345:  "\u03b9",
+	0x0370:  "\u0371",
+	0x0372:  "\u0373",
+	0x0374:  "\u02b9",
+	0x0376:  "\u0377",
+	0x037F:  "\u03f3",
+	0x0386:  "\u03ac",
+	0x0387:  "\u00b7",
+	0x0388:  "\u03ad",
+	0x0389:  "\u03ae",
+	0x038A:  "\u03af",
+	0x038C:  "\u03cc",
+	0x038E:  "\u03cd",
+	0x038F:  "\u03ce",
+	0x0391:  "\u03b1",
+	0x0392:  "\u03b2",
+	0x0393:  "\u03b3",
+	0x0394:  "\u03b4",
+	0x0395:  "\u03b5",
+	0x0396:  "\u03b6",
+	0x0397:  "\u03b7",
+	0x0398:  "\u03b8",
+	0x0399:  "\u03b9",
+	0x039A:  "\u03ba",
+	0x039B:  "\u03bb",
+	0x039C:  "\u03bc",
+	0x039D:  "\u03bd",
+	0x039E:  "\u03be",
+	0x039F:  "\u03bf",
+	0x03A0:  "\u03c0",
+	0x03A1:  "\u03c1",
+	0x03A3:  "\u03c3",
+	0x03A4:  "\u03c4",
+	0x03A5:  "\u03c5",
+	0x03A6:  "\u03c6",
+	0x03A7:  "\u03c7",
+	0x03A8:  "\u03c8",
+	0x03A9:  "\u03c9",
+	0x03AA:  "\u03ca",
+	0x03AB:  "\u03cb",
+	0x03CF:  "\u03d7",
+	0x03D0:  "\u03b2",
+	0x03D1:  "\u03b8",
+	0x03D2:  "\u03c5",
+	0x03D3:  "\u03cd",
+	0x03D4:  "\u03cb",
+	0x03D5:  "\u03c6",
+	0x03D6:  "\u03c0",
+	0x03D8:  "\u03d9",
+	0x03DA:  "\u03db",
+	0x03DC:  "\u03dd",
+	0x03DE:  "\u03df",
+	0x03E0:  "\u03e1",
+	0x03E2:  "\u03e3",
+	0x03E4:  "\u03e5",
+	0x03E6:  "\u03e7",
+	0x03E8:  "\u03e9",
+	0x03EA:  "\u03eb",
+	0x03EC:  "\u03ed",
+	0x03EE:  "\u03ef",
+	0x03F0:  "\u03ba",
+	0x03F1:  "\u03c1",
+	0x03F2:  "\u03c3",
+	0x03F4:  "\u03b8",
+	0x03F5:  "\u03b5",
+	0x03F7:  "\u03f8",
+	0x03F9:  "\u03c3",
+	0x03FA:  "\u03fb",
+	0x03FD:  "\u037b",
+	0x03FE:  "\u037c",
+	0x03FF:  "\u037d",
+	0x0400:  "\u0450",
+	0x0401:  "\u0451",
+	0x0402:  "\u0452",
+	0x0403:  "\u0453",
+	0x0404:  "\u0454",
+	0x0405:  "\u0455",
+	0x0406:  "\u0456",
+	0x0407:  "\u0457",
+	0x0408:  "\u0458",
+	0x0409:  "\u0459",
+	0x040A:  "\u045a",
+	0x040B:  "\u045b",
+	0x040C:  "\u045c",
+	0x040D:  "\u045d",
+	0x040E:  "\u045e",
+	0x040F:  "\u045f",
+	0x0410:  "\u0430",
+	0x0411:  "\u0431",
+	0x0412:  "\u0432",
+	0x0413:  "\u0433",
+	0x0414:  "\u0434",
+	0x0415:  "\u0435",
+	0x0416:  "\u0436",
+	0x0417:  "\u0437",
+	0x0418:  "\u0438",
+	0x0419:  "\u0439",
+	0x041A:  "\u043a",
+	0x041B:  "\u043b",
+	0x041C:  "\u043c",
+	0x041D:  "\u043d",
+	0x041E:  "\u043e",
+	0x041F:  "\u043f",
+	0x0420:  "\u0440",
+	0x0421:  "\u0441",
+	0x0422:  "\u0442",
+	0x0423:  "\u0443",
+	0x0424:  "\u0444",
+	0x0425:  "\u0445",
+	0x0426:  "\u0446",
+	0x0427:  "\u0447",
+	0x0428:  "\u0448",
+	0x0429:  "\u0449",
+	0x042A:  "\u044a",
+	0x042B:  "\u044b",
+	0x042C:  "\u044c",
+	0x042D:  "\u044d",
+	0x042E:  "\u044e",
+	0x042F:  "\u044f",
+	0x0460:  "\u0461",
+	0x0462:  "\u0463",
+	0x0464:  "\u0465",
+	0x0466:  "\u0467",
+	0x0468:  "\u0469",
+	0x046A:  "\u046b",
+	0x046C:  "\u046d",
+	0x046E:  "\u046f",
+	0x0470:  "\u0471",
+	0x0472:  "\u0473",
+	0x0474:  "\u0475",
+	0x0476:  "\u0477",
+	0x0478:  "\u0479",
+	0x047A:  "\u047b",
+	0x047C:  "\u047d",
+	0x047E:  "\u047f",
+	0x0480:  "\u0481",
+	0x048A:  "\u048b",
+	0x048C:  "\u048d",
+	0x048E:  "\u048f",
+	0x0490:  "\u0491",
+	0x0492:  "\u0493",
+	0x0494:  "\u0495",
+	0x0496:  "\u0497",
+	0x0498:  "\u0499",
+	0x049A:  "\u049b",
+	0x049C:  "\u049d",
+	0x049E:  "\u049f",
+	0x04A0:  "\u04a1",
+	0x04A2:  "\u04a3",
+	0x04A4:  "\u04a5",
+	0x04A6:  "\u04a7",
+	0x04A8:  "\u04a9",
+	0x04AA:  "\u04ab",
+	0x04AC:  "\u04ad",
+	0x04AE:  "\u04af",
+	0x04B0:  "\u04b1",
+	0x04B2:  "\u04b3",
+	0x04B4:  "\u04b5",
+	0x04B6:  "\u04b7",
+	0x04B8:  "\u04b9",
+	0x04BA:  "\u04bb",
+	0x04BC:  "\u04bd",
+	0x04BE:  "\u04bf",
+	0x04C1:  "\u04c2",
+	0x04C3:  "\u04c4",
+	0x04C5:  "\u04c6",
+	0x04C7:  "\u04c8",
+	0x04C9:  "\u04ca",
+	0x04CB:  "\u04cc",
+	0x04CD:  "\u04ce",
+	0x04D0:  "\u04d1",
+	0x04D2:  "\u04d3",
+	0x04D4:  "\u04d5",
+	0x04D6:  "\u04d7",
+	0x04D8:  "\u04d9",
+	0x04DA:  "\u04db",
+	0x04DC:  "\u04dd",
+	0x04DE:  "\u04df",
+	0x04E0:  "\u04e1",
+	0x04E2:  "\u04e3",
+	0x04E4:  "\u04e5",
+	0x04E6:  "\u04e7",
+	0x04E8:  "\u04e9",
+	0x04EA:  "\u04eb",
+	0x04EC:  "\u04ed",
+	0x04EE:  "\u04ef",
+	0x04F0:  "\u04f1",
+	0x04F2:  "\u04f3",
+	0x04F4:  "\u04f5",
+	0x04F6:  "\u04f7",
+	0x04F8:  "\u04f9",
+	0x04FA:  "\u04fb",
+	0x04FC:  "\u04fd",
+	0x04FE:  "\u04ff",
+	0x0500:  "\u0501",
+	0x0502:  "\u0503",
+	0x0504:  "\u0505",
+	0x0506:  "\u0507",
+	0x0508:  "\u0509",
+	0x050A:  "\u050b",
+	0x050C:  "\u050d",
+	0x050E:  "\u050f",
+	0x0510:  "\u0511",
+	0x0512:  "\u0513",
+	0x0514:  "\u0515",
+	0x0516:  "\u0517",
+	0x0518:  "\u0519",
+	0x051A:  "\u051b",
+	0x051C:  "\u051d",
+	0x051E:  "\u051f",
+	0x0520:  "\u0521",
+	0x0522:  "\u0523",
+	0x0524:  "\u0525",
+	0x0526:  "\u0527",
+	0x0528:  "\u0529",
+	0x052A:  "\u052b",
+	0x052C:  "\u052d",
+	0x052E:  "\u052f",
+	0x0531:  "\u0561",
+	0x0532:  "\u0562",
+	0x0533:  "\u0563",
+	0x0534:  "\u0564",
+	0x0535:  "\u0565",
+	0x0536:  "\u0566",
+	0x0537:  "\u0567",
+	0x0538:  "\u0568",
+	0x0539:  "\u0569",
+	0x053A:  "\u056a",
+	0x053B:  "\u056b",
+	0x053C:  "\u056c",
+	0x053D:  "\u056d",
+	0x053E:  "\u056e",
+	0x053F:  "\u056f",
+	0x0540:  "\u0570",
+	0x0541:  "\u0571",
+	0x0542:  "\u0572",
+	0x0543:  "\u0573",
+	0x0544:  "\u0574",
+	0x0545:  "\u0575",
+	0x0546:  "\u0576",
+	0x0547:  "\u0577",
+	0x0548:  "\u0578",
+	0x0549:  "\u0579",
+	0x054A:  "\u057a",
+	0x054B:  "\u057b",
+	0x054C:  "\u057c",
+	0x054D:  "\u057d",
+	0x054E:  "\u057e",
+	0x054F:  "\u057f",
+	0x0550:  "\u0580",
+	0x0551:  "\u0581",
+	0x0552:  "\u0582",
+	0x0553:  "\u0583",
+	0x0554:  "\u0584",
+	0x0555:  "\u0585",
+	0x0556:  "\u0586",
+	0x0587:  "\u0565\u0582",
+	0x0675:  "\u0627\u0674",
+	0x0676:  "\u0648\u0674",
+	0x0677:  "\u06c7\u0674",
+	0x0678:  "\u064a\u0674",
+	0x0958:  "\u0915\u093c",
+	0x0959:  "\u0916\u093c",
+	0x095A:  "\u0917\u093c",
+	0x095B:  "\u091c\u093c",
+	0x095C:  "\u0921\u093c",
+	0x095D:  "\u0922\u093c",
+	0x095E:  "\u092b\u093c",
+	0x095F:  "\u092f\u093c",
+	0x09DC:  "\u09a1\u09bc",
+	0x09DD:  "\u09a2\u09bc",
+	0x09DF:  "\u09af\u09bc",
+	0x0A33:  "\u0a32\u0a3c",
+	0x0A36:  "\u0a38\u0a3c",
+	0x0A59:  "\u0a16\u0a3c",
+	0x0A5A:  "\u0a17\u0a3c",
+	0x0A5B:  "\u0a1c\u0a3c",
+	0x0A5E:  "\u0a2b\u0a3c",
+	0x0B5C:  "\u0b21\u0b3c",
+	0x0B5D:  "\u0b22\u0b3c",
+	0x0E33:  "\u0e4d\u0e32",
+	0x0EB3:  "\u0ecd\u0eb2",
+	0x0EDC:  "\u0eab\u0e99",
+	0x0EDD:  "\u0eab\u0ea1",
+	0x0F43:  "\u0f42\u0fb7",
+	0x0F4D:  "\u0f4c\u0fb7",
+	0x0F52:  "\u0f51\u0fb7",
+	0x0F57:  "\u0f56\u0fb7",
+	0x0F5C:  "\u0f5b\u0fb7",
+	0x0F69:  "\u0f40\u0fb5",
+	0x0F73:  "\u0f71\u0f72",
+	0x0F75:  "\u0f71\u0f74",
+	0x0F76:  "\u0fb2\u0f80",
+	0x0F77:  "\u0fb2\u0f71\u0f80",
+	0x0F78:  "\u0fb3\u0f80",
+	0x0F79:  "\u0fb3\u0f71\u0f80",
+	0x0F81:  "\u0f71\u0f80",
+	0x0F93:  "\u0f92\u0fb7",
+	0x0F9D:  "\u0f9c\u0fb7",
+	0x0FA2:  "\u0fa1\u0fb7",
+	0x0FA7:  "\u0fa6\u0fb7",
+	0x0FAC:  "\u0fab\u0fb7",
+	0x0FB9:  "\u0f90\u0fb5",
+	0x10C7:  "\u2d27",
+	0x10CD:  "\u2d2d",
+	0x10FC:  "\u10dc",
+	0x13F8:  "\u13f0",
+	0x13F9:  "\u13f1",
+	0x13FA:  "\u13f2",
+	0x13FB:  "\u13f3",
+	0x13FC:  "\u13f4",
+	0x13FD:  "\u13f5",
+	0x1C80:  "\u0432",
+	0x1C81:  "\u0434",
+	0x1C82:  "\u043e",
+	0x1C83:  "\u0441",
+	0x1C84:  "\u0442",
+	0x1C85:  "\u0442",
+	0x1C86:  "\u044a",
+	0x1C87:  "\u0463",
+	0x1C88:  "\ua64b",
+	0x1C90:  "\u10d0",
+	0x1C91:  "\u10d1",
+	0x1C92:  "\u10d2",
+	0x1C93:  "\u10d3",
+	0x1C94:  "\u10d4",
+	0x1C95:  "\u10d5",
+	0x1C96:  "\u10d6",
+	0x1C97:  "\u10d7",
+	0x1C98:  "\u10d8",
+	0x1C99:  "\u10d9",
+	0x1C9A:  "\u10da",
+	0x1C9B:  "\u10db",
+	0x1C9C:  "\u10dc",
+	0x1C9D:  "\u10dd",
+	0x1C9E:  "\u10de",
+	0x1C9F:  "\u10df",
+	0x1CA0:  "\u10e0",
+	0x1CA1:  "\u10e1",
+	0x1CA2:  "\u10e2",
+	0x1CA3:  "\u10e3",
+	0x1CA4:  "\u10e4",
+	0x1CA5:  "\u10e5",
+	0x1CA6:  "\u10e6",
+	0x1CA7:  "\u10e7",
+	0x1CA8:  "\u10e8",
+	0x1CA9:  "\u10e9",
+	0x1CAA:  "\u10ea",
+	0x1CAB:  "\u10eb",
+	0x1CAC:  "\u10ec",
+	0x1CAD:  "\u10ed",
+	0x1CAE:  "\u10ee",
+	0x1CAF:  "\u10ef",
+	0x1CB0:  "\u10f0",
+	0x1CB1:  "\u10f1",
+	0x1CB2:  "\u10f2",
+	0x1CB3:  "\u10f3",
+	0x1CB4:  "\u10f4",
+	0x1CB5:  "\u10f5",
+	0x1CB6:  "\u10f6",
+	0x1CB7:  "\u10f7",
+	0x1CB8:  "\u10f8",
+	0x1CB9:  "\u10f9",
+	0x1CBA:  "\u10fa",
+	0x1CBD:  "\u10fd",
+	0x1CBE:  "\u10fe",
+	0x1CBF:  "\u10ff",
+	0x1D2C:  "a",
+	0x1D2D:  "\u00e6",
+	0x1D2E:  "b",
+	0x1D30:  "d",
+	0x1D31:  "e",
+	0x1D32:  "\u01dd",
+	0x1D33:  "g",
+	0x1D34:  "h",
+	0x1D35:  "i",
+	0x1D36:  "j",
+	0x1D37:  "k",
+	0x1D38:  "l",
+	0x1D39:  "m",
+	0x1D3A:  "n",
+	0x1D3C:  "o",
+	0x1D3D:  "\u0223",
+	0x1D3E:  "p",
+	0x1D3F:  "r",
+	0x1D40:  "t",
+	0x1D41:  "u",
+	0x1D42:  "w",
+	0x1D43:  "a",
+	0x1D44:  "\u0250",
+	0x1D45:  "\u0251",
+	0x1D46:  "\u1d02",
+	0x1D47:  "b",
+	0x1D48:  "d",
+	0x1D49:  "e",
+	0x1D4A:  "\u0259",
+	0x1D4B:  "\u025b",
+	0x1D4C:  "\u025c",
+	0x1D4D:  "g",
+	0x1D4F:  "k",
+	0x1D50:  "m",
+	0x1D51:  "\u014b",
+	0x1D52:  "o",
+	0x1D53:  "\u0254",
+	0x1D54:  "\u1d16",
+	0x1D55:  "\u1d17",
+	0x1D56:  "p",
+	0x1D57:  "t",
+	0x1D58:  "u",
+	0x1D59:  "\u1d1d",
+	0x1D5A:  "\u026f",
+	0x1D5B:  "v",
+	0x1D5C:  "\u1d25",
+	0x1D5D:  "\u03b2",
+	0x1D5E:  "\u03b3",
+	0x1D5F:  "\u03b4",
+	0x1D60:  "\u03c6",
+	0x1D61:  "\u03c7",
+	0x1D62:  "i",
+	0x1D63:  "r",
+	0x1D64:  "u",
+	0x1D65:  "v",
+	0x1D66:  "\u03b2",
+	0x1D67:  "\u03b3",
+	0x1D68:  "\u03c1",
+	0x1D69:  "\u03c6",
+	0x1D6A:  "\u03c7",
+	0x1D78:  "\u043d",
+	0x1D9B:  "\u0252",
+	0x1D9C:  "c",
+	0x1D9D:  "\u0255",
+	0x1D9E:  "\u00f0",
+	0x1D9F:  "\u025c",
+	0x1DA0:  "f",
+	0x1DA1:  "\u025f",
+	0x1DA2:  "\u0261",
+	0x1DA3:  "\u0265",
+	0x1DA4:  "\u0268",
+	0x1DA5:  "\u0269",
+	0x1DA6:  "\u026a",
+	0x1DA7:  "\u1d7b",
+	0x1DA8:  "\u029d",
+	0x1DA9:  "\u026d",
+	0x1DAA:  "\u1d85",
+	0x1DAB:  "\u029f",
+	0x1DAC:  "\u0271",
+	0x1DAD:  "\u0270",
+	0x1DAE:  "\u0272",
+	0x1DAF:  "\u0273",
+	0x1DB0:  "\u0274",
+	0x1DB1:  "\u0275",
+	0x1DB2:  "\u0278",
+	0x1DB3:  "\u0282",
+	0x1DB4:  "\u0283",
+	0x1DB5:  "\u01ab",
+	0x1DB6:  "\u0289",
+	0x1DB7:  "\u028a",
+	0x1DB8:  "\u1d1c",
+	0x1DB9:  "\u028b",
+	0x1DBA:  "\u028c",
+	0x1DBB:  "z",
+	0x1DBC:  "\u0290",
+	0x1DBD:  "\u0291",
+	0x1DBE:  "\u0292",
+	0x1DBF:  "\u03b8",
+	0x1E00:  "\u1e01",
+	0x1E02:  "\u1e03",
+	0x1E04:  "\u1e05",
+	0x1E06:  "\u1e07",
+	0x1E08:  "\u1e09",
+	0x1E0A:  "\u1e0b",
+	0x1E0C:  "\u1e0d",
+	0x1E0E:  "\u1e0f",
+	0x1E10:  "\u1e11",
+	0x1E12:  "\u1e13",
+	0x1E14:  "\u1e15",
+	0x1E16:  "\u1e17",
+	0x1E18:  "\u1e19",
+	0x1E1A:  "\u1e1b",
+	0x1E1C:  "\u1e1d",
+	0x1E1E:  "\u1e1f",
+	0x1E20:  "\u1e21",
+	0x1E22:  "\u1e23",
+	0x1E24:  "\u1e25",
+	0x1E26:  "\u1e27",
+	0x1E28:  "\u1e29",
+	0x1E2A:  "\u1e2b",
+	0x1E2C:  "\u1e2d",
+	0x1E2E:  "\u1e2f",
+	0x1E30:  "\u1e31",
+	0x1E32:  "\u1e33",
+	0x1E34:  "\u1e35",
+	0x1E36:  "\u1e37",
+	0x1E38:  "\u1e39",
+	0x1E3A:  "\u1e3b",
+	0x1E3C:  "\u1e3d",
+	0x1E3E:  "\u1e3f",
+	0x1E40:  "\u1e41",
+	0x1E42:  "\u1e43",
+	0x1E44:  "\u1e45",
+	0x1E46:  "\u1e47",
+	0x1E48:  "\u1e49",
+	0x1E4A:  "\u1e4b",
+	0x1E4C:  "\u1e4d",
+	0x1E4E:  "\u1e4f",
+	0x1E50:  "\u1e51",
+	0x1E52:  "\u1e53",
+	0x1E54:  "\u1e55",
+	0x1E56:  "\u1e57",
+	0x1E58:  "\u1e59",
+	0x1E5A:  "\u1e5b",
+	0x1E5C:  "\u1e5d",
+	0x1E5E:  "\u1e5f",
+	0x1E60:  "\u1e61",
+	0x1E62:  "\u1e63",
+	0x1E64:  "\u1e65",
+	0x1E66:  "\u1e67",
+	0x1E68:  "\u1e69",
+	0x1E6A:  "\u1e6b",
+	0x1E6C:  "\u1e6d",
+	0x1E6E:  "\u1e6f",
+	0x1E70:  "\u1e71",
+	0x1E72:  "\u1e73",
+	0x1E74:  "\u1e75",
+	0x1E76:  "\u1e77",
+	0x1E78:  "\u1e79",
+	0x1E7A:  "\u1e7b",
+	0x1E7C:  "\u1e7d",
+	0x1E7E:  "\u1e7f",
+	0x1E80:  "\u1e81",
+	0x1E82:  "\u1e83",
+	0x1E84:  "\u1e85",
+	0x1E86:  "\u1e87",
+	0x1E88:  "\u1e89",
+	0x1E8A:  "\u1e8b",
+	0x1E8C:  "\u1e8d",
+	0x1E8E:  "\u1e8f",
+	0x1E90:  "\u1e91",
+	0x1E92:  "\u1e93",
+	0x1E94:  "\u1e95",
+	0x1E9A:  "a\u02be",
+	0x1E9B:  "\u1e61",
+	0x1E9E:  "ss",
+	0x1EA0:  "\u1ea1",
+	0x1EA2:  "\u1ea3",
+	0x1EA4:  "\u1ea5",
+	0x1EA6:  "\u1ea7",
+	0x1EA8:  "\u1ea9",
+	0x1EAA:  "\u1eab",
+	0x1EAC:  "\u1ead",
+	0x1EAE:  "\u1eaf",
+	0x1EB0:  "\u1eb1",
+	0x1EB2:  "\u1eb3",
+	0x1EB4:  "\u1eb5",
+	0x1EB6:  "\u1eb7",
+	0x1EB8:  "\u1eb9",
+	0x1EBA:  "\u1ebb",
+	0x1EBC:  "\u1ebd",
+	0x1EBE:  "\u1ebf",
+	0x1EC0:  "\u1ec1",
+	0x1EC2:  "\u1ec3",
+	0x1EC4:  "\u1ec5",
+	0x1EC6:  "\u1ec7",
+	0x1EC8:  "\u1ec9",
+	0x1ECA:  "\u1ecb",
+	0x1ECC:  "\u1ecd",
+	0x1ECE:  "\u1ecf",
+	0x1ED0:  "\u1ed1",
+	0x1ED2:  "\u1ed3",
+	0x1ED4:  "\u1ed5",
+	0x1ED6:  "\u1ed7",
+	0x1ED8:  "\u1ed9",
+	0x1EDA:  "\u1edb",
+	0x1EDC:  "\u1edd",
+	0x1EDE:  "\u1edf",
+	0x1EE0:  "\u1ee1",
+	0x1EE2:  "\u1ee3",
+	0x1EE4:  "\u1ee5",
+	0x1EE6:  "\u1ee7",
+	0x1EE8:  "\u1ee9",
+	0x1EEA:  "\u1eeb",
+	0x1EEC:  "\u1eed",
+	0x1EEE:  "\u1eef",
+	0x1EF0:  "\u1ef1",
+	0x1EF2:  "\u1ef3",
+	0x1EF4:  "\u1ef5",
+	0x1EF6:  "\u1ef7",
+	0x1EF8:  "\u1ef9",
+	0x1EFA:  "\u1efb",
+	0x1EFC:  "\u1efd",
+	0x1EFE:  "\u1eff",
+	0x1F08:  "\u1f00",
+	0x1F09:  "\u1f01",
+	0x1F0A:  "\u1f02",
+	0x1F0B:  "\u1f03",
+	0x1F0C:  "\u1f04",
+	0x1F0D:  "\u1f05",
+	0x1F0E:  "\u1f06",
+	0x1F0F:  "\u1f07",
+	0x1F18:  "\u1f10",
+	0x1F19:  "\u1f11",
+	0x1F1A:  "\u1f12",
+	0x1F1B:  "\u1f13",
+	0x1F1C:  "\u1f14",
+	0x1F1D:  "\u1f15",
+	0x1F28:  "\u1f20",
+	0x1F29:  "\u1f21",
+	0x1F2A:  "\u1f22",
+	0x1F2B:  "\u1f23",
+	0x1F2C:  "\u1f24",
+	0x1F2D:  "\u1f25",
+	0x1F2E:  "\u1f26",
+	0x1F2F:  "\u1f27",
+	0x1F38:  "\u1f30",
+	0x1F39:  "\u1f31",
+	0x1F3A:  "\u1f32",
+	0x1F3B:  "\u1f33",
+	0x1F3C:  "\u1f34",
+	0x1F3D:  "\u1f35",
+	0x1F3E:  "\u1f36",
+	0x1F3F:  "\u1f37",
+	0x1F48:  "\u1f40",
+	0x1F49:  "\u1f41",
+	0x1F4A:  "\u1f42",
+	0x1F4B:  "\u1f43",
+	0x1F4C:  "\u1f44",
+	0x1F4D:  "\u1f45",
+	0x1F59:  "\u1f51",
+	0x1F5B:  "\u1f53",
+	0x1F5D:  "\u1f55",
+	0x1F5F:  "\u1f57",
+	0x1F68:  "\u1f60",
+	0x1F69:  "\u1f61",
+	0x1F6A:  "\u1f62",
+	0x1F6B:  "\u1f63",
+	0x1F6C:  "\u1f64",
+	0x1F6D:  "\u1f65",
+	0x1F6E:  "\u1f66",
+	0x1F6F:  "\u1f67",
+	0x1F71:  "\u03ac",
+	0x1F73:  "\u03ad",
+	0x1F75:  "\u03ae",
+	0x1F77:  "\u03af",
+	0x1F79:  "\u03cc",
+	0x1F7B:  "\u03cd",
+	0x1F7D:  "\u03ce",
+	0x1F80:  "\u1f00\u03b9",
+	0x1F81:  "\u1f01\u03b9",
+	0x1F82:  "\u1f02\u03b9",
+	0x1F83:  "\u1f03\u03b9",
+	0x1F84:  "\u1f04\u03b9",
+	0x1F85:  "\u1f05\u03b9",
+	0x1F86:  "\u1f06\u03b9",
+	0x1F87:  "\u1f07\u03b9",
+	0x1F88:  "\u1f00\u03b9",
+	0x1F89:  "\u1f01\u03b9",
+	0x1F8A:  "\u1f02\u03b9",
+	0x1F8B:  "\u1f03\u03b9",
+	0x1F8C:  "\u1f04\u03b9",
+	0x1F8D:  "\u1f05\u03b9",
+	0x1F8E:  "\u1f06\u03b9",
+	0x1F8F:  "\u1f07\u03b9",
+	0x1F90:  "\u1f20\u03b9",
+	0x1F91:  "\u1f21\u03b9",
+	0x1F92:  "\u1f22\u03b9",
+	0x1F93:  "\u1f23\u03b9",
+	0x1F94:  "\u1f24\u03b9",
+	0x1F95:  "\u1f25\u03b9",
+	0x1F96:  "\u1f26\u03b9",
+	0x1F97:  "\u1f27\u03b9",
+	0x1F98:  "\u1f20\u03b9",
+	0x1F99:  "\u1f21\u03b9",
+	0x1F9A:  "\u1f22\u03b9",
+	0x1F9B:  "\u1f23\u03b9",
+	0x1F9C:  "\u1f24\u03b9",
+	0x1F9D:  "\u1f25\u03b9",
+	0x1F9E:  "\u1f26\u03b9",
+	0x1F9F:  "\u1f27\u03b9",
+	0x1FA0:  "\u1f60\u03b9",
+	0x1FA1:  "\u1f61\u03b9",
+	0x1FA2:  "\u1f62\u03b9",
+	0x1FA3:  "\u1f63\u03b9",
+	0x1FA4:  "\u1f64\u03b9",
+	0x1FA5:  "\u1f65\u03b9",
+	0x1FA6:  "\u1f66\u03b9",
+	0x1FA7:  "\u1f67\u03b9",
+	0x1FA8:  "\u1f60\u03b9",
+	0x1FA9:  "\u1f61\u03b9",
+	0x1FAA:  "\u1f62\u03b9",
+	0x1FAB:  "\u1f63\u03b9",
+	0x1FAC:  "\u1f64\u03b9",
+	0x1FAD:  "\u1f65\u03b9",
+	0x1FAE:  "\u1f66\u03b9",
+	0x1FAF:  "\u1f67\u03b9",
+	0x1FB2:  "\u1f70\u03b9",
+	0x1FB3:  "\u03b1\u03b9",
+	0x1FB4:  "\u03ac\u03b9",
+	0x1FB7:  "\u1fb6\u03b9",
+	0x1FB8:  "\u1fb0",
+	0x1FB9:  "\u1fb1",
+	0x1FBA:  "\u1f70",
+	0x1FBB:  "\u03ac",
+	0x1FBC:  "\u03b1\u03b9",
+	0x1FBE:  "\u03b9",
+	0x1FC2:  "\u1f74\u03b9",
+	0x1FC3:  "\u03b7\u03b9",
+	0x1FC4:  "\u03ae\u03b9",
+	0x1FC7:  "\u1fc6\u03b9",
+	0x1FC8:  "\u1f72",
+	0x1FC9:  "\u03ad",
+	0x1FCA:  "\u1f74",
+	0x1FCB:  "\u03ae",
+	0x1FCC:  "\u03b7\u03b9",
+	0x1FD3:  "\u0390",
+	0x1FD8:  "\u1fd0",
+	0x1FD9:  "\u1fd1",
+	0x1FDA:  "\u1f76",
+	0x1FDB:  "\u03af",
+	0x1FE3:  "\u03b0",
+	0x1FE8:  "\u1fe0",
+	0x1FE9:  "\u1fe1",
+	0x1FEA:  "\u1f7a",
+	0x1FEB:  "\u03cd",
+	0x1FEC:  "\u1fe5",
+	0x1FF2:  "\u1f7c\u03b9",
+	0x1FF3:  "\u03c9\u03b9",
+	0x1FF4:  "\u03ce\u03b9",
+	0x1FF7:  "\u1ff6\u03b9",
+	0x1FF8:  "\u1f78",
+	0x1FF9:  "\u03cc",
+	0x1FFA:  "\u1f7c",
+	0x1FFB:  "\u03ce",
+	0x1FFC:  "\u03c9\u03b9",
+	0x2070:  "0",
+	0x2071:  "i",
+	0x2074:  "4",
+	0x2075:  "5",
+	0x2076:  "6",
+	0x2077:  "7",
+	0x2078:  "8",
+	0x2079:  "9",
+	0x207F:  "n",
+	0x2080:  "0",
+	0x2081:  "1",
+	0x2082:  "2",
+	0x2083:  "3",
+	0x2084:  "4",
+	0x2085:  "5",
+	0x2086:  "6",
+	0x2087:  "7",
+	0x2088:  "8",
+	0x2089:  "9",
+	0x2090:  "a",
+	0x2091:  "e",
+	0x2092:  "o",
+	0x2093:  "x",
+	0x2094:  "\u0259",
+	0x2095:  "h",
+	0x2096:  "k",
+	0x2097:  "l",
+	0x2098:  "m",
+	0x2099:  "n",
+	0x209A:  "p",
+	0x209B:  "s",
+	0x209C:  "t",
+	0x20A8:  "rs",
+	0x2102:  "c",
+	0x2107:  "\u025b",
+	0x210A:  "g",
+	0x210B:  "h",
+	0x210C:  "h",
+	0x210D:  "h",
+	0x210E:  "h",
+	0x210F:  "\u0127",
+	0x2110:  "i",
+	0x2111:  "i",
+	0x2112:  "l",
+	0x2113:  "l",
+	0x2115:  "n",
+	0x2116:  "no",
+	0x2119:  "p",
+	0x211A:  "q",
+	0x211B:  "r",
+	0x211C:  "r",
+	0x211D:  "r",
+	0x2120:  "sm",
+	0x2121:  "tel",
+	0x2122:  "tm",
+	0x2124:  "z",
+	0x2126:  "\u03c9",
+	0x2128:  "z",
+	0x212A:  "k",
+	0x212B:  "\u00e5",
+	0x212C:  "b",
+	0x212D:  "c",
+	0x212F:  "e",
+	0x2130:  "e",
+	0x2131:  "f",
+	0x2133:  "m",
+	0x2134:  "o",
+	0x2135:  "\u05d0",
+	0x2136:  "\u05d1",
+	0x2137:  "\u05d2",
+	0x2138:  "\u05d3",
+	0x2139:  "i",
+	0x213B:  "fax",
+	0x213C:  "\u03c0",
+	0x213D:  "\u03b3",
+	0x213E:  "\u03b3",
+	0x213F:  "\u03c0",
+	0x2145:  "d",
+	0x2146:  "d",
+	0x2147:  "e",
+	0x2148:  "i",
+	0x2149:  "j",
+	0x2150:  "1\u20447",
+	0x2151:  "1\u20449",
+	0x2152:  "1\u204410",
+	0x2153:  "1\u20443",
+	0x2154:  "2\u20443",
+	0x2155:  "1\u20445",
+	0x2156:  "2\u20445",
+	0x2157:  "3\u20445",
+	0x2158:  "4\u20445",
+	0x2159:  "1\u20446",
+	0x215A:  "5\u20446",
+	0x215B:  "1\u20448",
+	0x215C:  "3\u20448",
+	0x215D:  "5\u20448",
+	0x215E:  "7\u20448",
+	0x215F:  "1\u2044",
+	0x2160:  "i",
+	0x2161:  "ii",
+	0x2162:  "iii",
+	0x2163:  "iv",
+	0x2164:  "v",
+	0x2165:  "vi",
+	0x2166:  "vii",
+	0x2167:  "viii",
+	0x2168:  "ix",
+	0x2169:  "x",
+	0x216A:  "xi",
+	0x216B:  "xii",
+	0x216C:  "l",
+	0x216D:  "c",
+	0x216E:  "d",
+	0x216F:  "m",
+	0x2170:  "i",
+	0x2171:  "ii",
+	0x2172:  "iii",
+	0x2173:  "iv",
+	0x2174:  "v",
+	0x2175:  "vi",
+	0x2176:  "vii",
+	0x2177:  "viii",
+	0x2178:  "ix",
+	0x2179:  "x",
+	0x217A:  "xi",
+	0x217B:  "xii",
+	0x217C:  "l",
+	0x217D:  "c",
+	0x217E:  "d",
+	0x217F:  "m",
+	0x2189:  "0\u20443",
+	0x2460:  "1",
+	0x2461:  "2",
+	0x2462:  "3",
+	0x2463:  "4",
+	0x2464:  "5",
+	0x2465:  "6",
+	0x2466:  "7",
+	0x2467:  "8",
+	0x2468:  "9",
+	0x2469:  "10",
+	0x246A:  "11",
+	0x246B:  "12",
+	0x246C:  "13",
+	0x246D:  "14",
+	0x246E:  "15",
+	0x246F:  "16",
+	0x2470:  "17",
+	0x2471:  "18",
+	0x2472:  "19",
+	0x2473:  "20",
+	0x24B6:  "a",
+	0x24B7:  "b",
+	0x24B8:  "c",
+	0x24B9:  "d",
+	0x24BA:  "e",
+	0x24BB:  "f",
+	0x24BC:  "g",
+	0x24BD:  "h",
+	0x24BE:  "i",
+	0x24BF:  "j",
+	0x24C0:  "k",
+	0x24C1:  "l",
+	0x24C2:  "m",
+	0x24C3:  "n",
+	0x24C4:  "o",
+	0x24C5:  "p",
+	0x24C6:  "q",
+	0x24C7:  "r",
+	0x24C8:  "s",
+	0x24C9:  "t",
+	0x24CA:  "u",
+	0x24CB:  "v",
+	0x24CC:  "w",
+	0x24CD:  "x",
+	0x24CE:  "y",
+	0x24CF:  "z",
+	0x24D0:  "a",
+	0x24D1:  "b",
+	0x24D2:  "c",
+	0x24D3:  "d",
+	0x24D4:  "e",
+	0x24D5:  "f",
+	0x24D6:  "g",
+	0x24D7:  "h",
+	0x24D8:  "i",
+	0x24D9:  "j",
+	0x24DA:  "k",
+	0x24DB:  "l",
+	0x24DC:  "m",
+	0x24DD:  "n",
+	0x24DE:  "o",
+	0x24DF:  "p",
+	0x24E0:  "q",
+	0x24E1:  "r",
+	0x24E2:  "s",
+	0x24E3:  "t",
+	0x24E4:  "u",
+	0x24E5:  "v",
+	0x24E6:  "w",
+	0x24E7:  "x",
+	0x24E8:  "y",
+	0x24E9:  "z",
+	0x24EA:  "0",
+	0x2C00:  "\u2c30",
+	0x2C01:  "\u2c31",
+	0x2C02:  "\u2c32",
+	0x2C03:  "\u2c33",
+	0x2C04:  "\u2c34",
+	0x2C05:  "\u2c35",
+	0x2C06:  "\u2c36",
+	0x2C07:  "\u2c37",
+	0x2C08:  "\u2c38",
+	0x2C09:  "\u2c39",
+	0x2C0A:  "\u2c3a",
+	0x2C0B:  "\u2c3b",
+	0x2C0C:  "\u2c3c",
+	0x2C0D:  "\u2c3d",
+	0x2C0E:  "\u2c3e",
+	0x2C0F:  "\u2c3f",
+	0x2C10:  "\u2c40",
+	0x2C11:  "\u2c41",
+	0x2C12:  "\u2c42",
+	0x2C13:  "\u2c43",
+	0x2C14:  "\u2c44",
+	0x2C15:  "\u2c45",
+	0x2C16:  "\u2c46",
+	0x2C17:  "\u2c47",
+	0x2C18:  "\u2c48",
+	0x2C19:  "\u2c49",
+	0x2C1A:  "\u2c4a",
+	0x2C1B:  "\u2c4b",
+	0x2C1C:  "\u2c4c",
+	0x2C1D:  "\u2c4d",
+	0x2C1E:  "\u2c4e",
+	0x2C1F:  "\u2c4f",
+	0x2C20:  "\u2c50",
+	0x2C21:  "\u2c51",
+	0x2C22:  "\u2c52",
+	0x2C23:  "\u2c53",
+	0x2C24:  "\u2c54",
+	0x2C25:  "\u2c55",
+	0x2C26:  "\u2c56",
+	0x2C27:  "\u2c57",
+	0x2C28:  "\u2c58",
+	0x2C29:  "\u2c59",
+	0x2C2A:  "\u2c5a",
+	0x2C2B:  "\u2c5b",
+	0x2C2C:  "\u2c5c",
+	0x2C2D:  "\u2c5d",
+	0x2C2E:  "\u2c5e",
+	0x2C2F:  "\u2c5f",
+	0x2C60:  "\u2c61",
+	0x2C62:  "\u026b",
+	0x2C63:  "\u1d7d",
+	0x2C64:  "\u027d",
+	0x2C67:  "\u2c68",
+	0x2C69:  "\u2c6a",
+	0x2C6B:  "\u2c6c",
+	0x2C6D:  "\u0251",
+	0x2C6E:  "\u0271",
+	0x2C6F:  "\u0250",
+	0x2C70:  "\u0252",
+	0x2C72:  "\u2c73",
+	0x2C75:  "\u2c76",
+	0x2C7C:  "j",
+	0x2C7D:  "v",
+	0x2C7E:  "\u023f",
+	0x2C7F:  "\u0240",
+	0x2C80:  "\u2c81",
+	0x2C82:  "\u2c83",
+	0x2C84:  "\u2c85",
+	0x2C86:  "\u2c87",
+	0x2C88:  "\u2c89",
+	0x2C8A:  "\u2c8b",
+	0x2C8C:  "\u2c8d",
+	0x2C8E:  "\u2c8f",
+	0x2C90:  "\u2c91",
+	0x2C92:  "\u2c93",
+	0x2C94:  "\u2c95",
+	0x2C96:  "\u2c97",
+	0x2C98:  "\u2c99",
+	0x2C9A:  "\u2c9b",
+	0x2C9C:  "\u2c9d",
+	0x2C9E:  "\u2c9f",
+	0x2CA0:  "\u2ca1",
+	0x2CA2:  "\u2ca3",
+	0x2CA4:  "\u2ca5",
+	0x2CA6:  "\u2ca7",
+	0x2CA8:  "\u2ca9",
+	0x2CAA:  "\u2cab",
+	0x2CAC:  "\u2cad",
+	0x2CAE:  "\u2caf",
+	0x2CB0:  "\u2cb1",
+	0x2CB2:  "\u2cb3",
+	0x2CB4:  "\u2cb5",
+	0x2CB6:  "\u2cb7",
+	0x2CB8:  "\u2cb9",
+	0x2CBA:  "\u2cbb",
+	0x2CBC:  "\u2cbd",
+	0x2CBE:  "\u2cbf",
+	0x2CC0:  "\u2cc1",
+	0x2CC2:  "\u2cc3",
+	0x2CC4:  "\u2cc5",
+	0x2CC6:  "\u2cc7",
+	0x2CC8:  "\u2cc9",
+	0x2CCA:  "\u2ccb",
+	0x2CCC:  "\u2ccd",
+	0x2CCE:  "\u2ccf",
+	0x2CD0:  "\u2cd1",
+	0x2CD2:  "\u2cd3",
+	0x2CD4:  "\u2cd5",
+	0x2CD6:  "\u2cd7",
+	0x2CD8:  "\u2cd9",
+	0x2CDA:  "\u2cdb",
+	0x2CDC:  "\u2cdd",
+	0x2CDE:  "\u2cdf",
+	0x2CE0:  "\u2ce1",
+	0x2CE2:  "\u2ce3",
+	0x2CEB:  "\u2cec",
+	0x2CED:  "\u2cee",
+	0x2CF2:  "\u2cf3",
+	0x2D6F:  "\u2d61",
+	0x2E9F:  "\u6bcd",
+	0x2EF3:  "\u9f9f",
+	0x2F00:  "\u4e00",
+	0x2F01:  "\u4e28",
+	0x2F02:  "\u4e36",
+	0x2F03:  "\u4e3f",
+	0x2F04:  "\u4e59",
+	0x2F05:  "\u4e85",
+	0x2F06:  "\u4e8c",
+	0x2F07:  "\u4ea0",
+	0x2F08:  "\u4eba",
+	0x2F09:  "\u513f",
+	0x2F0A:  "\u5165",
+	0x2F0B:  "\u516b",
+	0x2F0C:  "\u5182",
+	0x2F0D:  "\u5196",
+	0x2F0E:  "\u51ab",
+	0x2F0F:  "\u51e0",
+	0x2F10:  "\u51f5",
+	0x2F11:  "\u5200",
+	0x2F12:  "\u529b",
+	0x2F13:  "\u52f9",
+	0x2F14:  "\u5315",
+	0x2F15:  "\u531a",
+	0x2F16:  "\u5338",
+	0x2F17:  "\u5341",
+	0x2F18:  "\u535c",
+	0x2F19:  "\u5369",
+	0x2F1A:  "\u5382",
+	0x2F1B:  "\u53b6",
+	0x2F1C:  "\u53c8",
+	0x2F1D:  "\u53e3",
+	0x2F1E:  "\u56d7",
+	0x2F1F:  "\u571f",
+	0x2F20:  "\u58eb",
+	0x2F21:  "\u5902",
+	0x2F22:  "\u590a",
+	0x2F23:  "\u5915",
+	0x2F24:  "\u5927",
+	0x2F25:  "\u5973",
+	0x2F26:  "\u5b50",
+	0x2F27:  "\u5b80",
+	0x2F28:  "\u5bf8",
+	0x2F29:  "\u5c0f",
+	0x2F2A:  "\u5c22",
+	0x2F2B:  "\u5c38",
+	0x2F2C:  "\u5c6e",
+	0x2F2D:  "\u5c71",
+	0x2F2E:  "\u5ddb",
+	0x2F2F:  "\u5de5",
+	0x2F30:  "\u5df1",
+	0x2F31:  "\u5dfe",
+	0x2F32:  "\u5e72",
+	0x2F33:  "\u5e7a",
+	0x2F34:  "\u5e7f",
+	0x2F35:  "\u5ef4",
+	0x2F36:  "\u5efe",
+	0x2F37:  "\u5f0b",
+	0x2F38:  "\u5f13",
+	0x2F39:  "\u5f50",
+	0x2F3A:  "\u5f61",
+	0x2F3B:  "\u5f73",
+	0x2F3C:  "\u5fc3",
+	0x2F3D:  "\u6208",
+	0x2F3E:  "\u6236",
+	0x2F3F:  "\u624b",
+	0x2F40:  "\u652f",
+	0x2F41:  "\u6534",
+	0x2F42:  "\u6587",
+	0x2F43:  "\u6597",
+	0x2F44:  "\u65a4",
+	0x2F45:  "\u65b9",
+	0x2F46:  "\u65e0",
+	0x2F47:  "\u65e5",
+	0x2F48:  "\u66f0",
+	0x2F49:  "\u6708",
+	0x2F4A:  "\u6728",
+	0x2F4B:  "\u6b20",
+	0x2F4C:  "\u6b62",
+	0x2F4D:  "\u6b79",
+	0x2F4E:  "\u6bb3",
+	0x2F4F:  "\u6bcb",
+	0x2F50:  "\u6bd4",
+	0x2F51:  "\u6bdb",
+	0x2F52:  "\u6c0f",
+	0x2F53:  "\u6c14",
+	0x2F54:  "\u6c34",
+	0x2F55:  "\u706b",
+	0x2F56:  "\u722a",
+	0x2F57:  "\u7236",
+	0x2F58:  "\u723b",
+	0x2F59:  "\u723f",
+	0x2F5A:  "\u7247",
+	0x2F5B:  "\u7259",
+	0x2F5C:  "\u725b",
+	0x2F5D:  "\u72ac",
+	0x2F5E:  "\u7384",
+	0x2F5F:  "\u7389",
+	0x2F60:  "\u74dc",
+	0x2F61:  "\u74e6",
+	0x2F62:  "\u7518",
+	0x2F63:  "\u751f",
+	0x2F64:  "\u7528",
+	0x2F65:  "\u7530",
+	0x2F66:  "\u758b",
+	0x2F67:  "\u7592",
+	0x2F68:  "\u7676",
+	0x2F69:  "\u767d",
+	0x2F6A:  "\u76ae",
+	0x2F6B:  "\u76bf",
+	0x2F6C:  "\u76ee",
+	0x2F6D:  "\u77db",
+	0x2F6E:  "\u77e2",
+	0x2F6F:  "\u77f3",
+	0x2F70:  "\u793a",
+	0x2F71:  "\u79b8",
+	0x2F72:  "\u79be",
+	0x2F73:  "\u7a74",
+	0x2F74:  "\u7acb",
+	0x2F75:  "\u7af9",
+	0x2F76:  "\u7c73",
+	0x2F77:  "\u7cf8",
+	0x2F78:  "\u7f36",
+	0x2F79:  "\u7f51",
+	0x2F7A:  "\u7f8a",
+	0x2F7B:  "\u7fbd",
+	0x2F7C:  "\u8001",
+	0x2F7D:  "\u800c",
+	0x2F7E:  "\u8012",
+	0x2F7F:  "\u8033",
+	0x2F80:  "\u807f",
+	0x2F81:  "\u8089",
+	0x2F82:  "\u81e3",
+	0x2F83:  "\u81ea",
+	0x2F84:  "\u81f3",
+	0x2F85:  "\u81fc",
+	0x2F86:  "\u820c",
+	0x2F87:  "\u821b",
+	0x2F88:  "\u821f",
+	0x2F89:  "\u826e",
+	0x2F8A:  "\u8272",
+	0x2F8B:  "\u8278",
+	0x2F8C:  "\u864d",
+	0x2F8D:  "\u866b",
+	0x2F8E:  "\u8840",
+	0x2F8F:  "\u884c",
+	0x2F90:  "\u8863",
+	0x2F91:  "\u897e",
+	0x2F92:  "\u898b",
+	0x2F93:  "\u89d2",
+	0x2F94:  "\u8a00",
+	0x2F95:  "\u8c37",
+	0x2F96:  "\u8c46",
+	0x2F97:  "\u8c55",
+	0x2F98:  "\u8c78",
+	0x2F99:  "\u8c9d",
+	0x2F9A:  "\u8d64",
+	0x2F9B:  "\u8d70",
+	0x2F9C:  "\u8db3",
+	0x2F9D:  "\u8eab",
+	0x2F9E:  "\u8eca",
+	0x2F9F:  "\u8f9b",
+	0x2FA0:  "\u8fb0",
+	0x2FA1:  "\u8fb5",
+	0x2FA2:  "\u9091",
+	0x2FA3:  "\u9149",
+	0x2FA4:  "\u91c6",
+	0x2FA5:  "\u91cc",
+	0x2FA6:  "\u91d1",
+	0x2FA7:  "\u9577",
+	0x2FA8:  "\u9580",
+	0x2FA9:  "\u961c",
+	0x2FAA:  "\u96b6",
+	0x2FAB:  "\u96b9",
+	0x2FAC:  "\u96e8",
+	0x2FAD:  "\u9751",
+	0x2FAE:  "\u975e",
+	0x2FAF:  "\u9762",
+	0x2FB0:  "\u9769",
+	0x2FB1:  "\u97cb",
+	0x2FB2:  "\u97ed",
+	0x2FB3:  "\u97f3",
+	0x2FB4:  "\u9801",
+	0x2FB5:  "\u98a8",
+	0x2FB6:  "\u98db",
+	0x2FB7:  "\u98df",
+	0x2FB8:  "\u9996",
+	0x2FB9:  "\u9999",
+	0x2FBA:  "\u99ac",
+	0x2FBB:  "\u9aa8",
+	0x2FBC:  "\u9ad8",
+	0x2FBD:  "\u9adf",
+	0x2FBE:  "\u9b25",
+	0x2FBF:  "\u9b2f",
+	0x2FC0:  "\u9b32",
+	0x2FC1:  "\u9b3c",
+	0x2FC2:  "\u9b5a",
+	0x2FC3:  "\u9ce5",
+	0x2FC4:  "\u9e75",
+	0x2FC5:  "\u9e7f",
+	0x2FC6:  "\u9ea5",
+	0x2FC7:  "\u9ebb",
+	0x2FC8:  "\u9ec3",
+	0x2FC9:  "\u9ecd",
+	0x2FCA:  "\u9ed1",
+	0x2FCB:  "\u9ef9",
+	0x2FCC:  "\u9efd",
+	0x2FCD:  "\u9f0e",
+	0x2FCE:  "\u9f13",
+	0x2FCF:  "\u9f20",
+	0x2FD0:  "\u9f3b",
+	0x2FD1:  "\u9f4a",
+	0x2FD2:  "\u9f52",
+	0x2FD3:  "\u9f8d",
+	0x2FD4:  "\u9f9c",
+	0x2FD5:  "\u9fa0",
+	0x3038:  "\u5341",
+	0x3039:  "\u5344",
+	0x303A:  "\u5345",
+	0x309F:  "\u3088\u308a",
+	0x30FF:  "\u30b3\u30c8",
+	0x3131:  "\u1100",
+	0x3132:  "\u1101",
+	0x3133:  "\u11aa",
+	0x3134:  "\u1102",
+	0x3135:  "\u11ac",
+	0x3136:  "\u11ad",
+	0x3137:  "\u1103",
+	0x3138:  "\u1104",
+	0x3139:  "\u1105",
+	0x313A:  "\u11b0",
+	0x313B:  "\u11b1",
+	0x313C:  "\u11b2",
+	0x313D:  "\u11b3",
+	0x313E:  "\u11b4",
+	0x313F:  "\u11b5",
+	0x3140:  "\u111a",
+	0x3141:  "\u1106",
+	0x3142:  "\u1107",
+	0x3143:  "\u1108",
+	0x3144:  "\u1121",
+	0x3145:  "\u1109",
+	0x3146:  "\u110a",
+	0x3147:  "\u110b",
+	0x3148:  "\u110c",
+	0x3149:  "\u110d",
+	0x314A:  "\u110e",
+	0x314B:  "\u110f",
+	0x314C:  "\u1110",
+	0x314D:  "\u1111",
+	0x314E:  "\u1112",
+	0x314F:  "\u1161",
+	0x3150:  "\u1162",
+	0x3151:  "\u1163",
+	0x3152:  "\u1164",
+	0x3153:  "\u1165",
+	0x3154:  "\u1166",
+	0x3155:  "\u1167",
+	0x3156:  "\u1168",
+	0x3157:  "\u1169",
+	0x3158:  "\u116a",
+	0x3159:  "\u116b",
+	0x315A:  "\u116c",
+	0x315B:  "\u116d",
+	0x315C:  "\u116e",
+	0x315D:  "\u116f",
+	0x315E:  "\u1170",
+	0x315F:  "\u1171",
+	0x3160:  "\u1172",
+	0x3161:  "\u1173",
+	0x3162:  "\u1174",
+	0x3163:  "\u1175",
+	0x3165:  "\u1114",
+	0x3166:  "\u1115",
+	0x3167:  "\u11c7",
+	0x3168:  "\u11c8",
+	0x3169:  "\u11cc",
+	0x316A:  "\u11ce",
+	0x316B:  "\u11d3",
+	0x316C:  "\u11d7",
+	0x316D:  "\u11d9",
+	0x316E:  "\u111c",
+	0x316F:  "\u11dd",
+	0x3170:  "\u11df",
+	0x3171:  "\u111d",
+	0x3172:  "\u111e",
+	0x3173:  "\u1120",
+	0x3174:  "\u1122",
+	0x3175:  "\u1123",
+	0x3176:  "\u1127",
+	0x3177:  "\u1129",
+	0x3178:  "\u112b",
+	0x3179:  "\u112c",
+	0x317A:  "\u112d",
+	0x317B:  "\u112e",
+	0x317C:  "\u112f",
+	0x317D:  "\u1132",
+	0x317E:  "\u1136",
+	0x317F:  "\u1140",
+	0x3180:  "\u1147",
+	0x3181:  "\u114c",
+	0x3182:  "\u11f1",
+	0x3183:  "\u11f2",
+	0x3184:  "\u1157",
+	0x3185:  "\u1158",
+	0x3186:  "\u1159",
+	0x3187:  "\u1184",
+	0x3188:  "\u1185",
+	0x3189:  "\u1188",
+	0x318A:  "\u1191",
+	0x318B:  "\u1192",
+	0x318C:  "\u1194",
+	0x318D:  "\u119e",
+	0x318E:  "\u11a1",
+	0x3192:  "\u4e00",
+	0x3193:  "\u4e8c",
+	0x3194:  "\u4e09",
+	0x3195:  "\u56db",
+	0x3196:  "\u4e0a",
+	0x3197:  "\u4e2d",
+	0x3198:  "\u4e0b",
+	0x3199:  "\u7532",
+	0x319A:  "\u4e59",
+	0x319B:  "\u4e19",
+	0x319C:  "\u4e01",
+	0x319D:  "\u5929",
+	0x319E:  "\u5730",
+	0x319F:  "\u4eba",
+	0x3244:  "\u554f",
+	0x3245:  "\u5e7c",
+	0x3246:  "\u6587",
+	0x3247:  "\u7b8f",
+	0x3250:  "pte",
+	0x3251:  "21",
+	0x3252:  "22",
+	0x3253:  "23",
+	0x3254:  "24",
+	0x3255:  "25",
+	0x3256:  "26",
+	0x3257:  "27",
+	0x3258:  "28",
+	0x3259:  "29",
+	0x325A:  "30",
+	0x325B:  "31",
+	0x325C:  "32",
+	0x325D:  "33",
+	0x325E:  "34",
+	0x325F:  "35",
+	0x3260:  "\u1100",
+	0x3261:  "\u1102",
+	0x3262:  "\u1103",
+	0x3263:  "\u1105",
+	0x3264:  "\u1106",
+	0x3265:  "\u1107",
+	0x3266:  "\u1109",
+	0x3267:  "\u110b",
+	0x3268:  "\u110c",
+	0x3269:  "\u110e",
+	0x326A:  "\u110f",
+	0x326B:  "\u1110",
+	0x326C:  "\u1111",
+	0x326D:  "\u1112",
+	0x326E:  "\uac00",
+	0x326F:  "\ub098",
+	0x3270:  "\ub2e4",
+	0x3271:  "\ub77c",
+	0x3272:  "\ub9c8",
+	0x3273:  "\ubc14",
+	0x3274:  "\uc0ac",
+	0x3275:  "\uc544",
+	0x3276:  "\uc790",
+	0x3277:  "\ucc28",
+	0x3278:  "\uce74",
+	0x3279:  "\ud0c0",
+	0x327A:  "\ud30c",
+	0x327B:  "\ud558",
+	0x327C:  "\ucc38\uace0",
+	0x327D:  "\uc8fc\uc758",
+	0x327E:  "\uc6b0",
+	0x3280:  "\u4e00",
+	0x3281:  "\u4e8c",
+	0x3282:  "\u4e09",
+	0x3283:  "\u56db",
+	0x3284:  "\u4e94",
+	0x3285:  "\u516d",
+	0x3286:  "\u4e03",
+	0x3287:  "\u516b",
+	0x3288:  "\u4e5d",
+	0x3289:  "\u5341",
+	0x328A:  "\u6708",
+	0x328B:  "\u706b",
+	0x328C:  "\u6c34",
+	0x328D:  "\u6728",
+	0x328E:  "\u91d1",
+	0x328F:  "\u571f",
+	0x3290:  "\u65e5",
+	0x3291:  "\u682a",
+	0x3292:  "\u6709",
+	0x3293:  "\u793e",
+	0x3294:  "\u540d",
+	0x3295:  "\u7279",
+	0x3296:  "\u8ca1",
+	0x3297:  "\u795d",
+	0x3298:  "\u52b4",
+	0x3299:  "\u79d8",
+	0x329A:  "\u7537",
+	0x329B:  "\u5973",
+	0x329C:  "\u9069",
+	0x329D:  "\u512a",
+	0x329E:  "\u5370",
+	0x329F:  "\u6ce8",
+	0x32A0:  "\u9805",
+	0x32A1:  "\u4f11",
+	0x32A2:  "\u5199",
+	0x32A3:  "\u6b63",
+	0x32A4:  "\u4e0a",
+	0x32A5:  "\u4e2d",
+	0x32A6:  "\u4e0b",
+	0x32A7:  "\u5de6",
+	0x32A8:  "\u53f3",
+	0x32A9:  "\u533b",
+	0x32AA:  "\u5b97",
+	0x32AB:  "\u5b66",
+	0x32AC:  "\u76e3",
+	0x32AD:  "\u4f01",
+	0x32AE:  "\u8cc7",
+	0x32AF:  "\u5354",
+	0x32B0:  "\u591c",
+	0x32B1:  "36",
+	0x32B2:  "37",
+	0x32B3:  "38",
+	0x32B4:  "39",
+	0x32B5:  "40",
+	0x32B6:  "41",
+	0x32B7:  "42",
+	0x32B8:  "43",
+	0x32B9:  "44",
+	0x32BA:  "45",
+	0x32BB:  "46",
+	0x32BC:  "47",
+	0x32BD:  "48",
+	0x32BE:  "49",
+	0x32BF:  "50",
+	0x32C0:  "1\u6708",
+	0x32C1:  "2\u6708",
+	0x32C2:  "3\u6708",
+	0x32C3:  "4\u6708",
+	0x32C4:  "5\u6708",
+	0x32C5:  "6\u6708",
+	0x32C6:  "7\u6708",
+	0x32C7:  "8\u6708",
+	0x32C8:  "9\u6708",
+	0x32C9:  "10\u6708",
+	0x32CA:  "11\u6708",
+	0x32CB:  "12\u6708",
+	0x32CC:  "hg",
+	0x32CD:  "erg",
+	0x32CE:  "ev",
+	0x32CF:  "ltd",
+	0x32D0:  "\u30a2",
+	0x32D1:  "\u30a4",
+	0x32D2:  "\u30a6",
+	0x32D3:  "\u30a8",
+	0x32D4:  "\u30aa",
+	0x32D5:  "\u30ab",
+	0x32D6:  "\u30ad",
+	0x32D7:  "\u30af",
+	0x32D8:  "\u30b1",
+	0x32D9:  "\u30b3",
+	0x32DA:  "\u30b5",
+	0x32DB:  "\u30b7",
+	0x32DC:  "\u30b9",
+	0x32DD:  "\u30bb",
+	0x32DE:  "\u30bd",
+	0x32DF:  "\u30bf",
+	0x32E0:  "\u30c1",
+	0x32E1:  "\u30c4",
+	0x32E2:  "\u30c6",
+	0x32E3:  "\u30c8",
+	0x32E4:  "\u30ca",
+	0x32E5:  "\u30cb",
+	0x32E6:  "\u30cc",
+	0x32E7:  "\u30cd",
+	0x32E8:  "\u30ce",
+	0x32E9:  "\u30cf",
+	0x32EA:  "\u30d2",
+	0x32EB:  "\u30d5",
+	0x32EC:  "\u30d8",
+	0x32ED:  "\u30db",
+	0x32EE:  "\u30de",
+	0x32EF:  "\u30df",
+	0x32F0:  "\u30e0",
+	0x32F1:  "\u30e1",
+	0x32F2:  "\u30e2",
+	0x32F3:  "\u30e4",
+	0x32F4:  "\u30e6",
+	0x32F5:  "\u30e8",
+	0x32F6:  "\u30e9",
+	0x32F7:  "\u30ea",
+	0x32F8:  "\u30eb",
+	0x32F9:  "\u30ec",
+	0x32FA:  "\u30ed",
+	0x32FB:  "\u30ef",
+	0x32FC:  "\u30f0",
+	0x32FD:  "\u30f1",
+	0x32FE:  "\u30f2",
+	0x32FF:  "\u4ee4\u548c",
+	0x3300:  "\u30a2\u30d1\u30fc\u30c8",
+	0x3301:  "\u30a2\u30eb\u30d5\u30a1",
+	0x3302:  "\u30a2\u30f3\u30da\u30a2",
+	0x3303:  "\u30a2\u30fc\u30eb",
+	0x3304:  "\u30a4\u30cb\u30f3\u30b0",
+	0x3305:  "\u30a4\u30f3\u30c1",
+	0x3306:  "\u30a6\u30a9\u30f3",
+	0x3307:  "\u30a8\u30b9\u30af\u30fc\u30c9",
+	0x3308:  "\u30a8\u30fc\u30ab\u30fc",
+	0x3309:  "\u30aa\u30f3\u30b9",
+	0x330A:  "\u30aa\u30fc\u30e0",
+	0x330B:  "\u30ab\u30a4\u30ea",
+	0x330C:  "\u30ab\u30e9\u30c3\u30c8",
+	0x330D:  "\u30ab\u30ed\u30ea\u30fc",
+	0x330E:  "\u30ac\u30ed\u30f3",
+	0x330F:  "\u30ac\u30f3\u30de",
+	0x3310:  "\u30ae\u30ac",
+	0x3311:  "\u30ae\u30cb\u30fc",
+	0x3312:  "\u30ad\u30e5\u30ea\u30fc",
+	0x3313:  "\u30ae\u30eb\u30c0\u30fc",
+	0x3314:  "\u30ad\u30ed",
+	0x3315:  "\u30ad\u30ed\u30b0\u30e9\u30e0",
+	0x3316:  "\u30ad\u30ed\u30e1\u30fc\u30c8\u30eb",
+	0x3317:  "\u30ad\u30ed\u30ef\u30c3\u30c8",
+	0x3318:  "\u30b0\u30e9\u30e0",
+	0x3319:  "\u30b0\u30e9\u30e0\u30c8\u30f3",
+	0x331A:  "\u30af\u30eb\u30bc\u30a4\u30ed",
+	0x331B:  "\u30af\u30ed\u30fc\u30cd",
+	0x331C:  "\u30b1\u30fc\u30b9",
+	0x331D:  "\u30b3\u30eb\u30ca",
+	0x331E:  "\u30b3\u30fc\u30dd",
+	0x331F:  "\u30b5\u30a4\u30af\u30eb",
+	0x3320:  "\u30b5\u30f3\u30c1\u30fc\u30e0",
+	0x3321:  "\u30b7\u30ea\u30f3\u30b0",
+	0x3322:  "\u30bb\u30f3\u30c1",
+	0x3323:  "\u30bb\u30f3\u30c8",
+	0x3324:  "\u30c0\u30fc\u30b9",
+	0x3325:  "\u30c7\u30b7",
+	0x3326:  "\u30c9\u30eb",
+	0x3327:  "\u30c8\u30f3",
+	0x3328:  "\u30ca\u30ce",
+	0x3329:  "\u30ce\u30c3\u30c8",
+	0x332A:  "\u30cf\u30a4\u30c4",
+	0x332B:  "\u30d1\u30fc\u30bb\u30f3\u30c8",
+	0x332C:  "\u30d1\u30fc\u30c4",
+	0x332D:  "\u30d0\u30fc\u30ec\u30eb",
+	0x332E:  "\u30d4\u30a2\u30b9\u30c8\u30eb",
+	0x332F:  "\u30d4\u30af\u30eb",
+	0x3330:  "\u30d4\u30b3",
+	0x3331:  "\u30d3\u30eb",
+	0x3332:  "\u30d5\u30a1\u30e9\u30c3\u30c9",
+	0x3333:  "\u30d5\u30a3\u30fc\u30c8",
+	0x3334:  "\u30d6\u30c3\u30b7\u30a7\u30eb",
+	0x3335:  "\u30d5\u30e9\u30f3",
+	0x3336:  "\u30d8\u30af\u30bf\u30fc\u30eb",
+	0x3337:  "\u30da\u30bd",
+	0x3338:  "\u30da\u30cb\u30d2",
+	0x3339:  "\u30d8\u30eb\u30c4",
+	0x333A:  "\u30da\u30f3\u30b9",
+	0x333B:  "\u30da\u30fc\u30b8",
+	0x333C:  "\u30d9\u30fc\u30bf",
+	0x333D:  "\u30dd\u30a4\u30f3\u30c8",
+	0x333E:  "\u30dc\u30eb\u30c8",
+	0x333F:  "\u30db\u30f3",
+	0x3340:  "\u30dd\u30f3\u30c9",
+	0x3341:  "\u30db\u30fc\u30eb",
+	0x3342:  "\u30db\u30fc\u30f3",
+	0x3343:  "\u30de\u30a4\u30af\u30ed",
+	0x3344:  "\u30de\u30a4\u30eb",
+	0x3345:  "\u30de\u30c3\u30cf",
+	0x3346:  "\u30de\u30eb\u30af",
+	0x3347:  "\u30de\u30f3\u30b7\u30e7\u30f3",
+	0x3348:  "\u30df\u30af\u30ed\u30f3",
+	0x3349:  "\u30df\u30ea",
+	0x334A:  "\u30df\u30ea\u30d0\u30fc\u30eb",
+	0x334B:  "\u30e1\u30ac",
+	0x334C:  "\u30e1\u30ac\u30c8\u30f3",
+	0x334D:  "\u30e1\u30fc\u30c8\u30eb",
+	0x334E:  "\u30e4\u30fc\u30c9",
+	0x334F:  "\u30e4\u30fc\u30eb",
+	0x3350:  "\u30e6\u30a2\u30f3",
+	0x3351:  "\u30ea\u30c3\u30c8\u30eb",
+	0x3352:  "\u30ea\u30e9",
+	0x3353:  "\u30eb\u30d4\u30fc",
+	0x3354:  "\u30eb\u30fc\u30d6\u30eb",
+	0x3355:  "\u30ec\u30e0",
+	0x3356:  "\u30ec\u30f3\u30c8\u30b2\u30f3",
+	0x3357:  "\u30ef\u30c3\u30c8",
+	0x3358:  "0\u70b9",
+	0x3359:  "1\u70b9",
+	0x335A:  "2\u70b9",
+	0x335B:  "3\u70b9",
+	0x335C:  "4\u70b9",
+	0x335D:  "5\u70b9",
+	0x335E:  "6\u70b9",
+	0x335F:  "7\u70b9",
+	0x3360:  "8\u70b9",
+	0x3361:  "9\u70b9",
+	0x3362:  "10\u70b9",
+	0x3363:  "11\u70b9",
+	0x3364:  "12\u70b9",
+	0x3365:  "13\u70b9",
+	0x3366:  "14\u70b9",
+	0x3367:  "15\u70b9",
+	0x3368:  "16\u70b9",
+	0x3369:  "17\u70b9",
+	0x336A:  "18\u70b9",
+	0x336B:  "19\u70b9",
+	0x336C:  "20\u70b9",
+	0x336D:  "21\u70b9",
+	0x336E:  "22\u70b9",
+	0x336F:  "23\u70b9",
+	0x3370:  "24\u70b9",
+	0x3371:  "hpa",
+	0x3372:  "da",
+	0x3373:  "au",
+	0x3374:  "bar",
+	0x3375:  "ov",
+	0x3376:  "pc",
+	0x3377:  "dm",
+	0x3378:  "dm2",
+	0x3379:  "dm3",
+	0x337A:  "iu",
+	0x337B:  "\u5e73\u6210",
+	0x337C:  "\u662d\u548c",
+	0x337D:  "\u5927\u6b63",
+	0x337E:  "\u660e\u6cbb",
+	0x337F:  "\u682a\u5f0f\u4f1a\u793e",
+	0x3380:  "pa",
+	0x3381:  "na",
+	0x3382:  "\u03bca",
+	0x3383:  "ma",
+	0x3384:  "ka",
+	0x3385:  "kb",
+	0x3386:  "mb",
+	0x3387:  "gb",
+	0x3388:  "cal",
+	0x3389:  "kcal",
+	0x338A:  "pf",
+	0x338B:  "nf",
+	0x338C:  "\u03bcf",
+	0x338D:  "\u03bcg",
+	0x338E:  "mg",
+	0x338F:  "kg",
+	0x3390:  "hz",
+	0x3391:  "khz",
+	0x3392:  "mhz",
+	0x3393:  "ghz",
+	0x3394:  "thz",
+	0x3395:  "\u03bcl",
+	0x3396:  "ml",
+	0x3397:  "dl",
+	0x3398:  "kl",
+	0x3399:  "fm",
+	0x339A:  "nm",
+	0x339B:  "\u03bcm",
+	0x339C:  "mm",
+	0x339D:  "cm",
+	0x339E:  "km",
+	0x339F:  "mm2",
+	0x33A0:  "cm2",
+	0x33A1:  "m2",
+	0x33A2:  "km2",
+	0x33A3:  "mm3",
+	0x33A4:  "cm3",
+	0x33A5:  "m3",
+	0x33A6:  "km3",
+	0x33A9:  "pa",
+	0x33AA:  "kpa",
+	0x33AB:  "mpa",
+	0x33AC:  "gpa",
+	0x33AD:  "rad",
+	0x33B0:  "ps",
+	0x33B1:  "ns",
+	0x33B2:  "\u03bcs",
+	0x33B3:  "ms",
+	0x33B4:  "pv",
+	0x33B5:  "nv",
+	0x33B6:  "\u03bcv",
+	0x33B7:  "mv",
+	0x33B8:  "kv",
+	0x33B9:  "mv",
+	0x33BA:  "pw",
+	0x33BB:  "nw",
+	0x33BC:  "\u03bcw",
+	0x33BD:  "mw",
+	0x33BE:  "kw",
+	0x33BF:  "mw",
+	0x33C0:  "k\u03c9",
+	0x33C1:  "m\u03c9",
+	0x33C3:  "bq",
+	0x33C4:  "cc",
+	0x33C5:  "cd",
+	0x33C8:  "db",
+	0x33C9:  "gy",
+	0x33CA:  "ha",
+	0x33CB:  "hp",
+	0x33CC:  "in",
+	0x33CD:  "kk",
+	0x33CE:  "km",
+	0x33CF:  "kt",
+	0x33D0:  "lm",
+	0x33D1:  "ln",
+	0x33D2:  "log",
+	0x33D3:  "lx",
+	0x33D4:  "mb",
+	0x33D5:  "mil",
+	0x33D6:  "mol",
+	0x33D7:  "ph",
+	0x33D9:  "ppm",
+	0x33DA:  "pr",
+	0x33DB:  "sr",
+	0x33DC:  "sv",
+	0x33DD:  "wb",
+	0x33E0:  "1\u65e5",
+	0x33E1:  "2\u65e5",
+	0x33E2:  "3\u65e5",
+	0x33E3:  "4\u65e5",
+	0x33E4:  "5\u65e5",
+	0x33E5:  "6\u65e5",
+	0x33E6:  "7\u65e5",
+	0x33E7:  "8\u65e5",
+	0x33E8:  "9\u65e5",
+	0x33E9:  "10\u65e5",
+	0x33EA:  "11\u65e5",
+	0x33EB:  "12\u65e5",
+	0x33EC:  "13\u65e5",
+	0x33ED:  "14\u65e5",
+	0x33EE:  "15\u65e5",
+	0x33EF:  "16\u65e5",
+	0x33F0:  "17\u65e5",
+	0x33F1:  "18\u65e5",
+	0x33F2:  "19\u65e5",
+	0x33F3:  "20\u65e5",
+	0x33F4:  "21\u65e5",
+	0x33F5:  "22\u65e5",
+	0x33F6:  "23\u65e5",
+	0x33F7:  "24\u65e5",
+	0x33F8:  "25\u65e5",
+	0x33F9:  "26\u65e5",
+	0x33FA:  "27\u65e5",
+	0x33FB:  "28\u65e5",
+	0x33FC:  "29\u65e5",
+	0x33FD:  "30\u65e5",
+	0x33FE:  "31\u65e5",
+	0x33FF:  "gal",
+	0xA640:  "\ua641",
+	0xA642:  "\ua643",
+	0xA644:  "\ua645",
+	0xA646:  "\ua647",
+	0xA648:  "\ua649",
+	0xA64A:  "\ua64b",
+	0xA64C:  "\ua64d",
+	0xA64E:  "\ua64f",
+	0xA650:  "\ua651",
+	0xA652:  "\ua653",
+	0xA654:  "\ua655",
+	0xA656:  "\ua657",
+	0xA658:  "\ua659",
+	0xA65A:  "\ua65b",
+	0xA65C:  "\ua65d",
+	0xA65E:  "\ua65f",
+	0xA660:  "\ua661",
+	0xA662:  "\ua663",
+	0xA664:  "\ua665",
+	0xA666:  "\ua667",
+	0xA668:  "\ua669",
+	0xA66A:  "\ua66b",
+	0xA66C:  "\ua66d",
+	0xA680:  "\ua681",
+	0xA682:  "\ua683",
+	0xA684:  "\ua685",
+	0xA686:  "\ua687",
+	0xA688:  "\ua689",
+	0xA68A:  "\ua68b",
+	0xA68C:  "\ua68d",
+	0xA68E:  "\ua68f",
+	0xA690:  "\ua691",
+	0xA692:  "\ua693",
+	0xA694:  "\ua695",
+	0xA696:  "\ua697",
+	0xA698:  "\ua699",
+	0xA69A:  "\ua69b",
+	0xA69C:  "\u044a",
+	0xA69D:  "\u044c",
+	0xA722:  "\ua723",
+	0xA724:  "\ua725",
+	0xA726:  "\ua727",
+	0xA728:  "\ua729",
+	0xA72A:  "\ua72b",
+	0xA72C:  "\ua72d",
+	0xA72E:  "\ua72f",
+	0xA732:  "\ua733",
+	0xA734:  "\ua735",
+	0xA736:  "\ua737",
+	0xA738:  "\ua739",
+	0xA73A:  "\ua73b",
+	0xA73C:  "\ua73d",
+	0xA73E:  "\ua73f",
+	0xA740:  "\ua741",
+	0xA742:  "\ua743",
+	0xA744:  "\ua745",
+	0xA746:  "\ua747",
+	0xA748:  "\ua749",
+	0xA74A:  "\ua74b",
+	0xA74C:  "\ua74d",
+	0xA74E:  "\ua74f",
+	0xA750:  "\ua751",
+	0xA752:  "\ua753",
+	0xA754:  "\ua755",
+	0xA756:  "\ua757",
+	0xA758:  "\ua759",
+	0xA75A:  "\ua75b",
+	0xA75C:  "\ua75d",
+	0xA75E:  "\ua75f",
+	0xA760:  "\ua761",
+	0xA762:  "\ua763",
+	0xA764:  "\ua765",
+	0xA766:  "\ua767",
+	0xA768:  "\ua769",
+	0xA76A:  "\ua76b",
+	0xA76C:  "\ua76d",
+	0xA76E:  "\ua76f",
+	0xA770:  "\ua76f",
+	0xA779:  "\ua77a",
+	0xA77B:  "\ua77c",
+	0xA77D:  "\u1d79",
+	0xA77E:  "\ua77f",
+	0xA780:  "\ua781",
+	0xA782:  "\ua783",
+	0xA784:  "\ua785",
+	0xA786:  "\ua787",
+	0xA78B:  "\ua78c",
+	0xA78D:  "\u0265",
+	0xA790:  "\ua791",
+	0xA792:  "\ua793",
+	0xA796:  "\ua797",
+	0xA798:  "\ua799",
+	0xA79A:  "\ua79b",
+	0xA79C:  "\ua79d",
+	0xA79E:  "\ua79f",
+	0xA7A0:  "\ua7a1",
+	0xA7A2:  "\ua7a3",
+	0xA7A4:  "\ua7a5",
+	0xA7A6:  "\ua7a7",
+	0xA7A8:  "\ua7a9",
+	0xA7AA:  "\u0266",
+	0xA7AB:  "\u025c",
+	0xA7AC:  "\u0261",
+	0xA7AD:  "\u026c",
+	0xA7AE:  "\u026a",
+	0xA7B0:  "\u029e",
+	0xA7B1:  "\u0287",
+	0xA7B2:  "\u029d",
+	0xA7B3:  "\uab53",
+	0xA7B4:  "\ua7b5",
+	0xA7B6:  "\ua7b7",
+	0xA7B8:  "\ua7b9",
+	0xA7BA:  "\ua7bb",
+	0xA7BC:  "\ua7bd",
+	0xA7BE:  "\ua7bf",
+	0xA7C0:  "\ua7c1",
+	0xA7C2:  "\ua7c3",
+	0xA7C4:  "\ua794",
+	0xA7C5:  "\u0282",
+	0xA7C6:  "\u1d8e",
+	0xA7C7:  "\ua7c8",
+	0xA7C9:  "\ua7ca",
+	0xA7D0:  "\ua7d1",
+	0xA7D6:  "\ua7d7",
+	0xA7D8:  "\ua7d9",
+	0xA7F2:  "c",
+	0xA7F3:  "f",
+	0xA7F4:  "q",
+	0xA7F5:  "\ua7f6",
+	0xA7F8:  "\u0127",
+	0xA7F9:  "\u0153",
+	0xAB5C:  "\ua727",
+	0xAB5D:  "\uab37",
+	0xAB5E:  "\u026b",
+	0xAB5F:  "\uab52",
+	0xAB69:  "\u028d",
+	0xAB70:  "\u13a0",
+	0xAB71:  "\u13a1",
+	0xAB72:  "\u13a2",
+	0xAB73:  "\u13a3",
+	0xAB74:  "\u13a4",
+	0xAB75:  "\u13a5",
+	0xAB76:  "\u13a6",
+	0xAB77:  "\u13a7",
+	0xAB78:  "\u13a8",
+	0xAB79:  "\u13a9",
+	0xAB7A:  "\u13aa",
+	0xAB7B:  "\u13ab",
+	0xAB7C:  "\u13ac",
+	0xAB7D:  "\u13ad",
+	0xAB7E:  "\u13ae",
+	0xAB7F:  "\u13af",
+	0xAB80:  "\u13b0",
+	0xAB81:  "\u13b1",
+	0xAB82:  "\u13b2",
+	0xAB83:  "\u13b3",
+	0xAB84:  "\u13b4",
+	0xAB85:  "\u13b5",
+	0xAB86:  "\u13b6",
+	0xAB87:  "\u13b7",
+	0xAB88:  "\u13b8",
+	0xAB89:  "\u13b9",
+	0xAB8A:  "\u13ba",
+	0xAB8B:  "\u13bb",
+	0xAB8C:  "\u13bc",
+	0xAB8D:  "\u13bd",
+	0xAB8E:  "\u13be",
+	0xAB8F:  "\u13bf",
+	0xAB90:  "\u13c0",
+	0xAB91:  "\u13c1",
+	0xAB92:  "\u13c2",
+	0xAB93:  "\u13c3",
+	0xAB94:  "\u13c4",
+	0xAB95:  "\u13c5",
+	0xAB96:  "\u13c6",
+	0xAB97:  "\u13c7",
+	0xAB98:  "\u13c8",
+	0xAB99:  "\u13c9",
+	0xAB9A:  "\u13ca",
+	0xAB9B:  "\u13cb",
+	0xAB9C:  "\u13cc",
+	0xAB9D:  "\u13cd",
+	0xAB9E:  "\u13ce",
+	0xAB9F:  "\u13cf",
+	0xABA0:  "\u13d0",
+	0xABA1:  "\u13d1",
+	0xABA2:  "\u13d2",
+	0xABA3:  "\u13d3",
+	0xABA4:  "\u13d4",
+	0xABA5:  "\u13d5",
+	0xABA6:  "\u13d6",
+	0xABA7:  "\u13d7",
+	0xABA8:  "\u13d8",
+	0xABA9:  "\u13d9",
+	0xABAA:  "\u13da",
+	0xABAB:  "\u13db",
+	0xABAC:  "\u13dc",
+	0xABAD:  "\u13dd",
+	0xABAE:  "\u13de",
+	0xABAF:  "\u13df",
+	0xABB0:  "\u13e0",
+	0xABB1:  "\u13e1",
+	0xABB2:  "\u13e2",
+	0xABB3:  "\u13e3",
+	0xABB4:  "\u13e4",
+	0xABB5:  "\u13e5",
+	0xABB6:  "\u13e6",
+	0xABB7:  "\u13e7",
+	0xABB8:  "\u13e8",
+	0xABB9:  "\u13e9",
+	0xABBA:  "\u13ea",
+	0xABBB:  "\u13eb",
+	0xABBC:  "\u13ec",
+	0xABBD:  "\u13ed",
+	0xABBE:  "\u13ee",
+	0xABBF:  "\u13ef",
+	0xF900:  "\u8c48",
+	0xF901:  "\u66f4",
+	0xF902:  "\u8eca",
+	0xF903:  "\u8cc8",
+	0xF904:  "\u6ed1",
+	0xF905:  "\u4e32",
+	0xF906:  "\u53e5",
+	0xF907:  "\u9f9c",
+	0xF908:  "\u9f9c",
+	0xF909:  "\u5951",
+	0xF90A:  "\u91d1",
+	0xF90B:  "\u5587",
+	0xF90C:  "\u5948",
+	0xF90D:  "\u61f6",
+	0xF90E:  "\u7669",
+	0xF90F:  "\u7f85",
+	0xF910:  "\u863f",
+	0xF911:  "\u87ba",
+	0xF912:  "\u88f8",
+	0xF913:  "\u908f",
+	0xF914:  "\u6a02",
+	0xF915:  "\u6d1b",
+	0xF916:  "\u70d9",
+	0xF917:  "\u73de",
+	0xF918:  "\u843d",
+	0xF919:  "\u916a",
+	0xF91A:  "\u99f1",
+	0xF91B:  "\u4e82",
+	0xF91C:  "\u5375",
+	0xF91D:  "\u6b04",
+	0xF91E:  "\u721b",
+	0xF91F:  "\u862d",
+	0xF920:  "\u9e1e",
+	0xF921:  "\u5d50",
+	0xF922:  "\u6feb",
+	0xF923:  "\u85cd",
+	0xF924:  "\u8964",
+	0xF925:  "\u62c9",
+	0xF926:  "\u81d8",
+	0xF927:  "\u881f",
+	0xF928:  "\u5eca",
+	0xF929:  "\u6717",
+	0xF92A:  "\u6d6a",
+	0xF92B:  "\u72fc",
+	0xF92C:  "\u90ce",
+	0xF92D:  "\u4f86",
+	0xF92E:  "\u51b7",
+	0xF92F:  "\u52de",
+	0xF930:  "\u64c4",
+	0xF931:  "\u6ad3",
+	0xF932:  "\u7210",
+	0xF933:  "\u76e7",
+	0xF934:  "\u8001",
+	0xF935:  "\u8606",
+	0xF936:  "\u865c",
+	0xF937:  "\u8def",
+	0xF938:  "\u9732",
+	0xF939:  "\u9b6f",
+	0xF93A:  "\u9dfa",
+	0xF93B:  "\u788c",
+	0xF93C:  "\u797f",
+	0xF93D:  "\u7da0",
+	0xF93E:  "\u83c9",
+	0xF93F:  "\u9304",
+	0xF940:  "\u9e7f",
+	0xF941:  "\u8ad6",
+	0xF942:  "\u58df",
+	0xF943:  "\u5f04",
+	0xF944:  "\u7c60",
+	0xF945:  "\u807e",
+	0xF946:  "\u7262",
+	0xF947:  "\u78ca",
+	0xF948:  "\u8cc2",
+	0xF949:  "\u96f7",
+	0xF94A:  "\u58d8",
+	0xF94B:  "\u5c62",
+	0xF94C:  "\u6a13",
+	0xF94D:  "\u6dda",
+	0xF94E:  "\u6f0f",
+	0xF94F:  "\u7d2f",
+	0xF950:  "\u7e37",
+	0xF951:  "\u964b",
+	0xF952:  "\u52d2",
+	0xF953:  "\u808b",
+	0xF954:  "\u51dc",
+	0xF955:  "\u51cc",
+	0xF956:  "\u7a1c",
+	0xF957:  "\u7dbe",
+	0xF958:  "\u83f1",
+	0xF959:  "\u9675",
+	0xF95A:  "\u8b80",
+	0xF95B:  "\u62cf",
+	0xF95C:  "\u6a02",
+	0xF95D:  "\u8afe",
+	0xF95E:  "\u4e39",
+	0xF95F:  "\u5be7",
+	0xF960:  "\u6012",
+	0xF961:  "\u7387",
+	0xF962:  "\u7570",
+	0xF963:  "\u5317",
+	0xF964:  "\u78fb",
+	0xF965:  "\u4fbf",
+	0xF966:  "\u5fa9",
+	0xF967:  "\u4e0d",
+	0xF968:  "\u6ccc",
+	0xF969:  "\u6578",
+	0xF96A:  "\u7d22",
+	0xF96B:  "\u53c3",
+	0xF96C:  "\u585e",
+	0xF96D:  "\u7701",
+	0xF96E:  "\u8449",
+	0xF96F:  "\u8aaa",
+	0xF970:  "\u6bba",
+	0xF971:  "\u8fb0",
+	0xF972:  "\u6c88",
+	0xF973:  "\u62fe",
+	0xF974:  "\u82e5",
+	0xF975:  "\u63a0",
+	0xF976:  "\u7565",
+	0xF977:  "\u4eae",
+	0xF978:  "\u5169",
+	0xF979:  "\u51c9",
+	0xF97A:  "\u6881",
+	0xF97B:  "\u7ce7",
+	0xF97C:  "\u826f",
+	0xF97D:  "\u8ad2",
+	0xF97E:  "\u91cf",
+	0xF97F:  "\u52f5",
+	0xF980:  "\u5442",
+	0xF981:  "\u5973",
+	0xF982:  "\u5eec",
+	0xF983:  "\u65c5",
+	0xF984:  "\u6ffe",
+	0xF985:  "\u792a",
+	0xF986:  "\u95ad",
+	0xF987:  "\u9a6a",
+	0xF988:  "\u9e97",
+	0xF989:  "\u9ece",
+	0xF98A:  "\u529b",
+	0xF98B:  "\u66c6",
+	0xF98C:  "\u6b77",
+	0xF98D:  "\u8f62",
+	0xF98E:  "\u5e74",
+	0xF98F:  "\u6190",
+	0xF990:  "\u6200",
+	0xF991:  "\u649a",
+	0xF992:  "\u6f23",
+	0xF993:  "\u7149",
+	0xF994:  "\u7489",
+	0xF995:  "\u79ca",
+	0xF996:  "\u7df4",
+	0xF997:  "\u806f",
+	0xF998:  "\u8f26",
+	0xF999:  "\u84ee",
+	0xF99A:  "\u9023",
+	0xF99B:  "\u934a",
+	0xF99C:  "\u5217",
+	0xF99D:  "\u52a3",
+	0xF99E:  "\u54bd",
+	0xF99F:  "\u70c8",
+	0xF9A0:  "\u88c2",
+	0xF9A1:  "\u8aaa",
+	0xF9A2:  "\u5ec9",
+	0xF9A3:  "\u5ff5",
+	0xF9A4:  "\u637b",
+	0xF9A5:  "\u6bae",
+	0xF9A6:  "\u7c3e",
+	0xF9A7:  "\u7375",
+	0xF9A8:  "\u4ee4",
+	0xF9A9:  "\u56f9",
+	0xF9AA:  "\u5be7",
+	0xF9AB:  "\u5dba",
+	0xF9AC:  "\u601c",
+	0xF9AD:  "\u73b2",
+	0xF9AE:  "\u7469",
+	0xF9AF:  "\u7f9a",
+	0xF9B0:  "\u8046",
+	0xF9B1:  "\u9234",
+	0xF9B2:  "\u96f6",
+	0xF9B3:  "\u9748",
+	0xF9B4:  "\u9818",
+	0xF9B5:  "\u4f8b",
+	0xF9B6:  "\u79ae",
+	0xF9B7:  "\u91b4",
+	0xF9B8:  "\u96b8",
+	0xF9B9:  "\u60e1",
+	0xF9BA:  "\u4e86",
+	0xF9BB:  "\u50da",
+	0xF9BC:  "\u5bee",
+	0xF9BD:  "\u5c3f",
+	0xF9BE:  "\u6599",
+	0xF9BF:  "\u6a02",
+	0xF9C0:  "\u71ce",
+	0xF9C1:  "\u7642",
+	0xF9C2:  "\u84fc",
+	0xF9C3:  "\u907c",
+	0xF9C4:  "\u9f8d",
+	0xF9C5:  "\u6688",
+	0xF9C6:  "\u962e",
+	0xF9C7:  "\u5289",
+	0xF9C8:  "\u677b",
+	0xF9C9:  "\u67f3",
+	0xF9CA:  "\u6d41",
+	0xF9CB:  "\u6e9c",
+	0xF9CC:  "\u7409",
+	0xF9CD:  "\u7559",
+	0xF9CE:  "\u786b",
+	0xF9CF:  "\u7d10",
+	0xF9D0:  "\u985e",
+	0xF9D1:  "\u516d",
+	0xF9D2:  "\u622e",
+	0xF9D3:  "\u9678",
+	0xF9D4:  "\u502b",
+	0xF9D5:  "\u5d19",
+	0xF9D6:  "\u6dea",
+	0xF9D7:  "\u8f2a",
+	0xF9D8:  "\u5f8b",
+	0xF9D9:  "\u6144",
+	0xF9DA:  "\u6817",
+	0xF9DB:  "\u7387",
+	0xF9DC:  "\u9686",
+	0xF9DD:  "\u5229",
+	0xF9DE:  "\u540f",
+	0xF9DF:  "\u5c65",
+	0xF9E0:  "\u6613",
+	0xF9E1:  "\u674e",
+	0xF9E2:  "\u68a8",
+	0xF9E3:  "\u6ce5",
+	0xF9E4:  "\u7406",
+	0xF9E5:  "\u75e2",
+	0xF9E6:  "\u7f79",
+	0xF9E7:  "\u88cf",
+	0xF9E8:  "\u88e1",
+	0xF9E9:  "\u91cc",
+	0xF9EA:  "\u96e2",
+	0xF9EB:  "\u533f",
+	0xF9EC:  "\u6eba",
+	0xF9ED:  "\u541d",
+	0xF9EE:  "\u71d0",
+	0xF9EF:  "\u7498",
+	0xF9F0:  "\u85fa",
+	0xF9F1:  "\u96a3",
+	0xF9F2:  "\u9c57",
+	0xF9F3:  "\u9e9f",
+	0xF9F4:  "\u6797",
+	0xF9F5:  "\u6dcb",
+	0xF9F6:  "\u81e8",
+	0xF9F7:  "\u7acb",
+	0xF9F8:  "\u7b20",
+	0xF9F9:  "\u7c92",
+	0xF9FA:  "\u72c0",
+	0xF9FB:  "\u7099",
+	0xF9FC:  "\u8b58",
+	0xF9FD:  "\u4ec0",
+	0xF9FE:  "\u8336",
+	0xF9FF:  "\u523a",
+	0xFA00:  "\u5207",
+	0xFA01:  "\u5ea6",
+	0xFA02:  "\u62d3",
+	0xFA03:  "\u7cd6",
+	0xFA04:  "\u5b85",
+	0xFA05:  "\u6d1e",
+	0xFA06:  "\u66b4",
+	0xFA07:  "\u8f3b",
+	0xFA08:  "\u884c",
+	0xFA09:  "\u964d",
+	0xFA0A:  "\u898b",
+	0xFA0B:  "\u5ed3",
+	0xFA0C:  "\u5140",
+	0xFA0D:  "\u55c0",
+	0xFA10:  "\u585a",
+	0xFA12:  "\u6674",
+	0xFA15:  "\u51de",
+	0xFA16:  "\u732a",
+	0xFA17:  "\u76ca",
+	0xFA18:  "\u793c",
+	0xFA19:  "\u795e",
+	0xFA1A:  "\u7965",
+	0xFA1B:  "\u798f",
+	0xFA1C:  "\u9756",
+	0xFA1D:  "\u7cbe",
+	0xFA1E:  "\u7fbd",
+	0xFA20:  "\u8612",
+	0xFA22:  "\u8af8",
+	0xFA25:  "\u9038",
+	0xFA26:  "\u90fd",
+	0xFA2A:  "\u98ef",
+	0xFA2B:  "\u98fc",
+	0xFA2C:  "\u9928",
+	0xFA2D:  "\u9db4",
+	0xFA2E:  "\u90de",
+	0xFA2F:  "\u96b7",
+	0xFA30:  "\u4fae",
+	0xFA31:  "\u50e7",
+	0xFA32:  "\u514d",
+	0xFA33:  "\u52c9",
+	0xFA34:  "\u52e4",
+	0xFA35:  "\u5351",
+	0xFA36:  "\u559d",
+	0xFA37:  "\u5606",
+	0xFA38:  "\u5668",
+	0xFA39:  "\u5840",
+	0xFA3A:  "\u58a8",
+	0xFA3B:  "\u5c64",
+	0xFA3C:  "\u5c6e",
+	0xFA3D:  "\u6094",
+	0xFA3E:  "\u6168",
+	0xFA3F:  "\u618e",
+	0xFA40:  "\u61f2",
+	0xFA41:  "\u654f",
+	0xFA42:  "\u65e2",
+	0xFA43:  "\u6691",
+	0xFA44:  "\u6885",
+	0xFA45:  "\u6d77",
+	0xFA46:  "\u6e1a",
+	0xFA47:  "\u6f22",
+	0xFA48:  "\u716e",
+	0xFA49:  "\u722b",
+	0xFA4A:  "\u7422",
+	0xFA4B:  "\u7891",
+	0xFA4C:  "\u793e",
+	0xFA4D:  "\u7949",
+	0xFA4E:  "\u7948",
+	0xFA4F:  "\u7950",
+	0xFA50:  "\u7956",
+	0xFA51:  "\u795d",
+	0xFA52:  "\u798d",
+	0xFA53:  "\u798e",
+	0xFA54:  "\u7a40",
+	0xFA55:  "\u7a81",
+	0xFA56:  "\u7bc0",
+	0xFA57:  "\u7df4",
+	0xFA58:  "\u7e09",
+	0xFA59:  "\u7e41",
+	0xFA5A:  "\u7f72",
+	0xFA5B:  "\u8005",
+	0xFA5C:  "\u81ed",
+	0xFA5D:  "\u8279",
+	0xFA5E:  "\u8279",
+	0xFA5F:  "\u8457",
+	0xFA60:  "\u8910",
+	0xFA61:  "\u8996",
+	0xFA62:  "\u8b01",
+	0xFA63:  "\u8b39",
+	0xFA64:  "\u8cd3",
+	0xFA65:  "\u8d08",
+	0xFA66:  "\u8fb6",
+	0xFA67:  "\u9038",
+	0xFA68:  "\u96e3",
+	0xFA69:  "\u97ff",
+	0xFA6A:  "\u983b",
+	0xFA6B:  "\u6075",
+	0xFA6C:  "\U000242ee",
+	0xFA6D:  "\u8218",
+	0xFA70:  "\u4e26",
+	0xFA71:  "\u51b5",
+	0xFA72:  "\u5168",
+	0xFA73:  "\u4f80",
+	0xFA74:  "\u5145",
+	0xFA75:  "\u5180",
+	0xFA76:  "\u52c7",
+	0xFA77:  "\u52fa",
+	0xFA78:  "\u559d",
+	0xFA79:  "\u5555",
+	0xFA7A:  "\u5599",
+	0xFA7B:  "\u55e2",
+	0xFA7C:  "\u585a",
+	0xFA7D:  "\u58b3",
+	0xFA7E:  "\u5944",
+	0xFA7F:  "\u5954",
+	0xFA80:  "\u5a62",
+	0xFA81:  "\u5b28",
+	0xFA82:  "\u5ed2",
+	0xFA83:  "\u5ed9",
+	0xFA84:  "\u5f69",
+	0xFA85:  "\u5fad",
+	0xFA86:  "\u60d8",
+	0xFA87:  "\u614e",
+	0xFA88:  "\u6108",
+	0xFA89:  "\u618e",
+	0xFA8A:  "\u6160",
+	0xFA8B:  "\u61f2",
+	0xFA8C:  "\u6234",
+	0xFA8D:  "\u63c4",
+	0xFA8E:  "\u641c",
+	0xFA8F:  "\u6452",
+	0xFA90:  "\u6556",
+	0xFA91:  "\u6674",
+	0xFA92:  "\u6717",
+	0xFA93:  "\u671b",
+	0xFA94:  "\u6756",
+	0xFA95:  "\u6b79",
+	0xFA96:  "\u6bba",
+	0xFA97:  "\u6d41",
+	0xFA98:  "\u6edb",
+	0xFA99:  "\u6ecb",
+	0xFA9A:  "\u6f22",
+	0xFA9B:  "\u701e",
+	0xFA9C:  "\u716e",
+	0xFA9D:  "\u77a7",
+	0xFA9E:  "\u7235",
+	0xFA9F:  "\u72af",
+	0xFAA0:  "\u732a",
+	0xFAA1:  "\u7471",
+	0xFAA2:  "\u7506",
+	0xFAA3:  "\u753b",
+	0xFAA4:  "\u761d",
+	0xFAA5:  "\u761f",
+	0xFAA6:  "\u76ca",
+	0xFAA7:  "\u76db",
+	0xFAA8:  "\u76f4",
+	0xFAA9:  "\u774a",
+	0xFAAA:  "\u7740",
+	0xFAAB:  "\u78cc",
+	0xFAAC:  "\u7ab1",
+	0xFAAD:  "\u7bc0",
+	0xFAAE:  "\u7c7b",
+	0xFAAF:  "\u7d5b",
+	0xFAB0:  "\u7df4",
+	0xFAB1:  "\u7f3e",
+	0xFAB2:  "\u8005",
+	0xFAB3:  "\u8352",
+	0xFAB4:  "\u83ef",
+	0xFAB5:  "\u8779",
+	0xFAB6:  "\u8941",
+	0xFAB7:  "\u8986",
+	0xFAB8:  "\u8996",
+	0xFAB9:  "\u8abf",
+	0xFABA:  "\u8af8",
+	0xFABB:  "\u8acb",
+	0xFABC:  "\u8b01",
+	0xFABD:  "\u8afe",
+	0xFABE:  "\u8aed",
+	0xFABF:  "\u8b39",
+	0xFAC0:  "\u8b8a",
+	0xFAC1:  "\u8d08",
+	0xFAC2:  "\u8f38",
+	0xFAC3:  "\u9072",
+	0xFAC4:  "\u9199",
+	0xFAC5:  "\u9276",
+	0xFAC6:  "\u967c",
+	0xFAC7:  "\u96e3",
+	0xFAC8:  "\u9756",
+	0xFAC9:  "\u97db",
+	0xFACA:  "\u97ff",
+	0xFACB:  "\u980b",
+	0xFACC:  "\u983b",
+	0xFACD:  "\u9b12",
+	0xFACE:  "\u9f9c",
+	0xFACF:  "\U0002284a",
+	0xFAD0:  "\U00022844",
+	0xFAD1:  "\U000233d5",
+	0xFAD2:  "\u3b9d",
+	0xFAD3:  "\u4018",
+	0xFAD4:  "\u4039",
+	0xFAD5:  "\U00025249",
+	0xFAD6:  "\U00025cd0",
+	0xFAD7:  "\U00027ed3",
+	0xFAD8:  "\u9f43",
+	0xFAD9:  "\u9f8e",
+	0xFB00:  "ff",
+	0xFB01:  "fi",
+	0xFB02:  "fl",
+	0xFB03:  "ffi",
+	0xFB04:  "ffl",
+	0xFB05:  "st",
+	0xFB06:  "st",
+	0xFB13:  "\u0574\u0576",
+	0xFB14:  "\u0574\u0565",
+	0xFB15:  "\u0574\u056b",
+	0xFB16:  "\u057e\u0576",
+	0xFB17:  "\u0574\u056d",
+	0xFB1D:  "\u05d9\u05b4",
+	0xFB1F:  "\u05f2\u05b7",
+	0xFB20:  "\u05e2",
+	0xFB21:  "\u05d0",
+	0xFB22:  "\u05d3",
+	0xFB23:  "\u05d4",
+	0xFB24:  "\u05db",
+	0xFB25:  "\u05dc",
+	0xFB26:  "\u05dd",
+	0xFB27:  "\u05e8",
+	0xFB28:  "\u05ea",
+	0xFB2A:  "\u05e9\u05c1",
+	0xFB2B:  "\u05e9\u05c2",
+	0xFB2C:  "\u05e9\u05bc\u05c1",
+	0xFB2D:  "\u05e9\u05bc\u05c2",
+	0xFB2E:  "\u05d0\u05b7",
+	0xFB2F:  "\u05d0\u05b8",
+	0xFB30:  "\u05d0\u05bc",
+	0xFB31:  "\u05d1\u05bc",
+	0xFB32:  "\u05d2\u05bc",
+	0xFB33:  "\u05d3\u05bc",
+	0xFB34:  "\u05d4\u05bc",
+	0xFB35:  "\u05d5\u05bc",
+	0xFB36:  "\u05d6\u05bc",
+	0xFB38:  "\u05d8\u05bc",
+	0xFB39:  "\u05d9\u05bc",
+	0xFB3A:  "\u05da\u05bc",
+	0xFB3B:  "\u05db\u05bc",
+	0xFB3C:  "\u05dc\u05bc",
+	0xFB3E:  "\u05de\u05bc",
+	0xFB40:  "\u05e0\u05bc",
+	0xFB41:  "\u05e1\u05bc",
+	0xFB43:  "\u05e3\u05bc",
+	0xFB44:  "\u05e4\u05bc",
+	0xFB46:  "\u05e6\u05bc",
+	0xFB47:  "\u05e7\u05bc",
+	0xFB48:  "\u05e8\u05bc",
+	0xFB49:  "\u05e9\u05bc",
+	0xFB4A:  "\u05ea\u05bc",
+	0xFB4B:  "\u05d5\u05b9",
+	0xFB4C:  "\u05d1\u05bf",
+	0xFB4D:  "\u05db\u05bf",
+	0xFB4E:  "\u05e4\u05bf",
+	0xFB4F:  "\u05d0\u05dc",
+	0xFB50:  "\u0671",
+	0xFB51:  "\u0671",
+	0xFB52:  "\u067b",
+	0xFB53:  "\u067b",
+	0xFB54:  "\u067b",
+	0xFB55:  "\u067b",
+	0xFB56:  "\u067e",
+	0xFB57:  "\u067e",
+	0xFB58:  "\u067e",
+	0xFB59:  "\u067e",
+	0xFB5A:  "\u0680",
+	0xFB5B:  "\u0680",
+	0xFB5C:  "\u0680",
+	0xFB5D:  "\u0680",
+	0xFB5E:  "\u067a",
+	0xFB5F:  "\u067a",
+	0xFB60:  "\u067a",
+	0xFB61:  "\u067a",
+	0xFB62:  "\u067f",
+	0xFB63:  "\u067f",
+	0xFB64:  "\u067f",
+	0xFB65:  "\u067f",
+	0xFB66:  "\u0679",
+	0xFB67:  "\u0679",
+	0xFB68:  "\u0679",
+	0xFB69:  "\u0679",
+	0xFB6A:  "\u06a4",
+	0xFB6B:  "\u06a4",
+	0xFB6C:  "\u06a4",
+	0xFB6D:  "\u06a4",
+	0xFB6E:  "\u06a6",
+	0xFB6F:  "\u06a6",
+	0xFB70:  "\u06a6",
+	0xFB71:  "\u06a6",
+	0xFB72:  "\u0684",
+	0xFB73:  "\u0684",
+	0xFB74:  "\u0684",
+	0xFB75:  "\u0684",
+	0xFB76:  "\u0683",
+	0xFB77:  "\u0683",
+	0xFB78:  "\u0683",
+	0xFB79:  "\u0683",
+	0xFB7A:  "\u0686",
+	0xFB7B:  "\u0686",
+	0xFB7C:  "\u0686",
+	0xFB7D:  "\u0686",
+	0xFB7E:  "\u0687",
+	0xFB7F:  "\u0687",
+	0xFB80:  "\u0687",
+	0xFB81:  "\u0687",
+	0xFB82:  "\u068d",
+	0xFB83:  "\u068d",
+	0xFB84:  "\u068c",
+	0xFB85:  "\u068c",
+	0xFB86:  "\u068e",
+	0xFB87:  "\u068e",
+	0xFB88:  "\u0688",
+	0xFB89:  "\u0688",
+	0xFB8A:  "\u0698",
+	0xFB8B:  "\u0698",
+	0xFB8C:  "\u0691",
+	0xFB8D:  "\u0691",
+	0xFB8E:  "\u06a9",
+	0xFB8F:  "\u06a9",
+	0xFB90:  "\u06a9",
+	0xFB91:  "\u06a9",
+	0xFB92:  "\u06af",
+	0xFB93:  "\u06af",
+	0xFB94:  "\u06af",
+	0xFB95:  "\u06af",
+	0xFB96:  "\u06b3",
+	0xFB97:  "\u06b3",
+	0xFB98:  "\u06b3",
+	0xFB99:  "\u06b3",
+	0xFB9A:  "\u06b1",
+	0xFB9B:  "\u06b1",
+	0xFB9C:  "\u06b1",
+	0xFB9D:  "\u06b1",
+	0xFB9E:  "\u06ba",
+	0xFB9F:  "\u06ba",
+	0xFBA0:  "\u06bb",
+	0xFBA1:  "\u06bb",
+	0xFBA2:  "\u06bb",
+	0xFBA3:  "\u06bb",
+	0xFBA4:  "\u06c0",
+	0xFBA5:  "\u06c0",
+	0xFBA6:  "\u06c1",
+	0xFBA7:  "\u06c1",
+	0xFBA8:  "\u06c1",
+	0xFBA9:  "\u06c1",
+	0xFBAA:  "\u06be",
+	0xFBAB:  "\u06be",
+	0xFBAC:  "\u06be",
+	0xFBAD:  "\u06be",
+	0xFBAE:  "\u06d2",
+	0xFBAF:  "\u06d2",
+	0xFBB0:  "\u06d3",
+	0xFBB1:  "\u06d3",
+	0xFBD3:  "\u06ad",
+	0xFBD4:  "\u06ad",
+	0xFBD5:  "\u06ad",
+	0xFBD6:  "\u06ad",
+	0xFBD7:  "\u06c7",
+	0xFBD8:  "\u06c7",
+	0xFBD9:  "\u06c6",
+	0xFBDA:  "\u06c6",
+	0xFBDB:  "\u06c8",
+	0xFBDC:  "\u06c8",
+	0xFBDD:  "\u06c7\u0674",
+	0xFBDE:  "\u06cb",
+	0xFBDF:  "\u06cb",
+	0xFBE0:  "\u06c5",
+	0xFBE1:  "\u06c5",
+	0xFBE2:  "\u06c9",
+	0xFBE3:  "\u06c9",
+	0xFBE4:  "\u06d0",
+	0xFBE5:  "\u06d0",
+	0xFBE6:  "\u06d0",
+	0xFBE7:  "\u06d0",
+	0xFBE8:  "\u0649",
+	0xFBE9:  "\u0649",
+	0xFBEA:  "\u0626\u0627",
+	0xFBEB:  "\u0626\u0627",
+	0xFBEC:  "\u0626\u06d5",
+	0xFBED:  "\u0626\u06d5",
+	0xFBEE:  "\u0626\u0648",
+	0xFBEF:  "\u0626\u0648",
+	0xFBF0:  "\u0626\u06c7",
+	0xFBF1:  "\u0626\u06c7",
+	0xFBF2:  "\u0626\u06c6",
+	0xFBF3:  "\u0626\u06c6",
+	0xFBF4:  "\u0626\u06c8",
+	0xFBF5:  "\u0626\u06c8",
+	0xFBF6:  "\u0626\u06d0",
+	0xFBF7:  "\u0626\u06d0",
+	0xFBF8:  "\u0626\u06d0",
+	0xFBF9:  "\u0626\u0649",
+	0xFBFA:  "\u0626\u0649",
+	0xFBFB:  "\u0626\u0649",
+	0xFBFC:  "\u06cc",
+	0xFBFD:  "\u06cc",
+	0xFBFE:  "\u06cc",
+	0xFBFF:  "\u06cc",
+	0xFC00:  "\u0626\u062c",
+	0xFC01:  "\u0626\u062d",
+	0xFC02:  "\u0626\u0645",
+	0xFC03:  "\u0626\u0649",
+	0xFC04:  "\u0626\u064a",
+	0xFC05:  "\u0628\u062c",
+	0xFC06:  "\u0628\u062d",
+	0xFC07:  "\u0628\u062e",
+	0xFC08:  "\u0628\u0645",
+	0xFC09:  "\u0628\u0649",
+	0xFC0A:  "\u0628\u064a",
+	0xFC0B:  "\u062a\u062c",
+	0xFC0C:  "\u062a\u062d",
+	0xFC0D:  "\u062a\u062e",
+	0xFC0E:  "\u062a\u0645",
+	0xFC0F:  "\u062a\u0649",
+	0xFC10:  "\u062a\u064a",
+	0xFC11:  "\u062b\u062c",
+	0xFC12:  "\u062b\u0645",
+	0xFC13:  "\u062b\u0649",
+	0xFC14:  "\u062b\u064a",
+	0xFC15:  "\u062c\u062d",
+	0xFC16:  "\u062c\u0645",
+	0xFC17:  "\u062d\u062c",
+	0xFC18:  "\u062d\u0645",
+	0xFC19:  "\u062e\u062c",
+	0xFC1A:  "\u062e\u062d",
+	0xFC1B:  "\u062e\u0645",
+	0xFC1C:  "\u0633\u062c",
+	0xFC1D:  "\u0633\u062d",
+	0xFC1E:  "\u0633\u062e",
+	0xFC1F:  "\u0633\u0645",
+	0xFC20:  "\u0635\u062d",
+	0xFC21:  "\u0635\u0645",
+	0xFC22:  "\u0636\u062c",
+	0xFC23:  "\u0636\u062d",
+	0xFC24:  "\u0636\u062e",
+	0xFC25:  "\u0636\u0645",
+	0xFC26:  "\u0637\u062d",
+	0xFC27:  "\u0637\u0645",
+	0xFC28:  "\u0638\u0645",
+	0xFC29:  "\u0639\u062c",
+	0xFC2A:  "\u0639\u0645",
+	0xFC2B:  "\u063a\u062c",
+	0xFC2C:  "\u063a\u0645",
+	0xFC2D:  "\u0641\u062c",
+	0xFC2E:  "\u0641\u062d",
+	0xFC2F:  "\u0641\u062e",
+	0xFC30:  "\u0641\u0645",
+	0xFC31:  "\u0641\u0649",
+	0xFC32:  "\u0641\u064a",
+	0xFC33:  "\u0642\u062d",
+	0xFC34:  "\u0642\u0645",
+	0xFC35:  "\u0642\u0649",
+	0xFC36:  "\u0642\u064a",
+	0xFC37:  "\u0643\u0627",
+	0xFC38:  "\u0643\u062c",
+	0xFC39:  "\u0643\u062d",
+	0xFC3A:  "\u0643\u062e",
+	0xFC3B:  "\u0643\u0644",
+	0xFC3C:  "\u0643\u0645",
+	0xFC3D:  "\u0643\u0649",
+	0xFC3E:  "\u0643\u064a",
+	0xFC3F:  "\u0644\u062c",
+	0xFC40:  "\u0644\u062d",
+	0xFC41:  "\u0644\u062e",
+	0xFC42:  "\u0644\u0645",
+	0xFC43:  "\u0644\u0649",
+	0xFC44:  "\u0644\u064a",
+	0xFC45:  "\u0645\u062c",
+	0xFC46:  "\u0645\u062d",
+	0xFC47:  "\u0645\u062e",
+	0xFC48:  "\u0645\u0645",
+	0xFC49:  "\u0645\u0649",
+	0xFC4A:  "\u0645\u064a",
+	0xFC4B:  "\u0646\u062c",
+	0xFC4C:  "\u0646\u062d",
+	0xFC4D:  "\u0646\u062e",
+	0xFC4E:  "\u0646\u0645",
+	0xFC4F:  "\u0646\u0649",
+	0xFC50:  "\u0646\u064a",
+	0xFC51:  "\u0647\u062c",
+	0xFC52:  "\u0647\u0645",
+	0xFC53:  "\u0647\u0649",
+	0xFC54:  "\u0647\u064a",
+	0xFC55:  "\u064a\u062c",
+	0xFC56:  "\u064a\u062d",
+	0xFC57:  "\u064a\u062e",
+	0xFC58:  "\u064a\u0645",
+	0xFC59:  "\u064a\u0649",
+	0xFC5A:  "\u064a\u064a",
+	0xFC5B:  "\u0630\u0670",
+	0xFC5C:  "\u0631\u0670",
+	0xFC5D:  "\u0649\u0670",
+	0xFC64:  "\u0626\u0631",
+	0xFC65:  "\u0626\u0632",
+	0xFC66:  "\u0626\u0645",
+	0xFC67:  "\u0626\u0646",
+	0xFC68:  "\u0626\u0649",
+	0xFC69:  "\u0626\u064a",
+	0xFC6A:  "\u0628\u0631",
+	0xFC6B:  "\u0628\u0632",
+	0xFC6C:  "\u0628\u0645",
+	0xFC6D:  "\u0628\u0646",
+	0xFC6E:  "\u0628\u0649",
+	0xFC6F:  "\u0628\u064a",
+	0xFC70:  "\u062a\u0631",
+	0xFC71:  "\u062a\u0632",
+	0xFC72:  "\u062a\u0645",
+	0xFC73:  "\u062a\u0646",
+	0xFC74:  "\u062a\u0649",
+	0xFC75:  "\u062a\u064a",
+	0xFC76:  "\u062b\u0631",
+	0xFC77:  "\u062b\u0632",
+	0xFC78:  "\u062b\u0645",
+	0xFC79:  "\u062b\u0646",
+	0xFC7A:  "\u062b\u0649",
+	0xFC7B:  "\u062b\u064a",
+	0xFC7C:  "\u0641\u0649",
+	0xFC7D:  "\u0641\u064a",
+	0xFC7E:  "\u0642\u0649",
+	0xFC7F:  "\u0642\u064a",
+	0xFC80:  "\u0643\u0627",
+	0xFC81:  "\u0643\u0644",
+	0xFC82:  "\u0643\u0645",
+	0xFC83:  "\u0643\u0649",
+	0xFC84:  "\u0643\u064a",
+	0xFC85:  "\u0644\u0645",
+	0xFC86:  "\u0644\u0649",
+	0xFC87:  "\u0644\u064a",
+	0xFC88:  "\u0645\u0627",
+	0xFC89:  "\u0645\u0645",
+	0xFC8A:  "\u0646\u0631",
+	0xFC8B:  "\u0646\u0632",
+	0xFC8C:  "\u0646\u0645",
+	0xFC8D:  "\u0646\u0646",
+	0xFC8E:  "\u0646\u0649",
+	0xFC8F:  "\u0646\u064a",
+	0xFC90:  "\u0649\u0670",
+	0xFC91:  "\u064a\u0631",
+	0xFC92:  "\u064a\u0632",
+	0xFC93:  "\u064a\u0645",
+	0xFC94:  "\u064a\u0646",
+	0xFC95:  "\u064a\u0649",
+	0xFC96:  "\u064a\u064a",
+	0xFC97:  "\u0626\u062c",
+	0xFC98:  "\u0626\u062d",
+	0xFC99:  "\u0626\u062e",
+	0xFC9A:  "\u0626\u0645",
+	0xFC9B:  "\u0626\u0647",
+	0xFC9C:  "\u0628\u062c",
+	0xFC9D:  "\u0628\u062d",
+	0xFC9E:  "\u0628\u062e",
+	0xFC9F:  "\u0628\u0645",
+	0xFCA0:  "\u0628\u0647",
+	0xFCA1:  "\u062a\u062c",
+	0xFCA2:  "\u062a\u062d",
+	0xFCA3:  "\u062a\u062e",
+	0xFCA4:  "\u062a\u0645",
+	0xFCA5:  "\u062a\u0647",
+	0xFCA6:  "\u062b\u0645",
+	0xFCA7:  "\u062c\u062d",
+	0xFCA8:  "\u062c\u0645",
+	0xFCA9:  "\u062d\u062c",
+	0xFCAA:  "\u062d\u0645",
+	0xFCAB:  "\u062e\u062c",
+	0xFCAC:  "\u062e\u0645",
+	0xFCAD:  "\u0633\u062c",
+	0xFCAE:  "\u0633\u062d",
+	0xFCAF:  "\u0633\u062e",
+	0xFCB0:  "\u0633\u0645",
+	0xFCB1:  "\u0635\u062d",
+	0xFCB2:  "\u0635\u062e",
+	0xFCB3:  "\u0635\u0645",
+	0xFCB4:  "\u0636\u062c",
+	0xFCB5:  "\u0636\u062d",
+	0xFCB6:  "\u0636\u062e",
+	0xFCB7:  "\u0636\u0645",
+	0xFCB8:  "\u0637\u062d",
+	0xFCB9:  "\u0638\u0645",
+	0xFCBA:  "\u0639\u062c",
+	0xFCBB:  "\u0639\u0645",
+	0xFCBC:  "\u063a\u062c",
+	0xFCBD:  "\u063a\u0645",
+	0xFCBE:  "\u0641\u062c",
+	0xFCBF:  "\u0641\u062d",
+	0xFCC0:  "\u0641\u062e",
+	0xFCC1:  "\u0641\u0645",
+	0xFCC2:  "\u0642\u062d",
+	0xFCC3:  "\u0642\u0645",
+	0xFCC4:  "\u0643\u062c",
+	0xFCC5:  "\u0643\u062d",
+	0xFCC6:  "\u0643\u062e",
+	0xFCC7:  "\u0643\u0644",
+	0xFCC8:  "\u0643\u0645",
+	0xFCC9:  "\u0644\u062c",
+	0xFCCA:  "\u0644\u062d",
+	0xFCCB:  "\u0644\u062e",
+	0xFCCC:  "\u0644\u0645",
+	0xFCCD:  "\u0644\u0647",
+	0xFCCE:  "\u0645\u062c",
+	0xFCCF:  "\u0645\u062d",
+	0xFCD0:  "\u0645\u062e",
+	0xFCD1:  "\u0645\u0645",
+	0xFCD2:  "\u0646\u062c",
+	0xFCD3:  "\u0646\u062d",
+	0xFCD4:  "\u0646\u062e",
+	0xFCD5:  "\u0646\u0645",
+	0xFCD6:  "\u0646\u0647",
+	0xFCD7:  "\u0647\u062c",
+	0xFCD8:  "\u0647\u0645",
+	0xFCD9:  "\u0647\u0670",
+	0xFCDA:  "\u064a\u062c",
+	0xFCDB:  "\u064a\u062d",
+	0xFCDC:  "\u064a\u062e",
+	0xFCDD:  "\u064a\u0645",
+	0xFCDE:  "\u064a\u0647",
+	0xFCDF:  "\u0626\u0645",
+	0xFCE0:  "\u0626\u0647",
+	0xFCE1:  "\u0628\u0645",
+	0xFCE2:  "\u0628\u0647",
+	0xFCE3:  "\u062a\u0645",
+	0xFCE4:  "\u062a\u0647",
+	0xFCE5:  "\u062b\u0645",
+	0xFCE6:  "\u062b\u0647",
+	0xFCE7:  "\u0633\u0645",
+	0xFCE8:  "\u0633\u0647",
+	0xFCE9:  "\u0634\u0645",
+	0xFCEA:  "\u0634\u0647",
+	0xFCEB:  "\u0643\u0644",
+	0xFCEC:  "\u0643\u0645",
+	0xFCED:  "\u0644\u0645",
+	0xFCEE:  "\u0646\u0645",
+	0xFCEF:  "\u0646\u0647",
+	0xFCF0:  "\u064a\u0645",
+	0xFCF1:  "\u064a\u0647",
+	0xFCF2:  "\u0640\u064e\u0651",
+	0xFCF3:  "\u0640\u064f\u0651",
+	0xFCF4:  "\u0640\u0650\u0651",
+	0xFCF5:  "\u0637\u0649",
+	0xFCF6:  "\u0637\u064a",
+	0xFCF7:  "\u0639\u0649",
+	0xFCF8:  "\u0639\u064a",
+	0xFCF9:  "\u063a\u0649",
+	0xFCFA:  "\u063a\u064a",
+	0xFCFB:  "\u0633\u0649",
+	0xFCFC:  "\u0633\u064a",
+	0xFCFD:  "\u0634\u0649",
+	0xFCFE:  "\u0634\u064a",
+	0xFCFF:  "\u062d\u0649",
+	0xFD00:  "\u062d\u064a",
+	0xFD01:  "\u062c\u0649",
+	0xFD02:  "\u062c\u064a",
+	0xFD03:  "\u062e\u0649",
+	0xFD04:  "\u062e\u064a",
+	0xFD05:  "\u0635\u0649",
+	0xFD06:  "\u0635\u064a",
+	0xFD07:  "\u0636\u0649",
+	0xFD08:  "\u0636\u064a",
+	0xFD09:  "\u0634\u062c",
+	0xFD0A:  "\u0634\u062d",
+	0xFD0B:  "\u0634\u062e",
+	0xFD0C:  "\u0634\u0645",
+	0xFD0D:  "\u0634\u0631",
+	0xFD0E:  "\u0633\u0631",
+	0xFD0F:  "\u0635\u0631",
+	0xFD10:  "\u0636\u0631",
+	0xFD11:  "\u0637\u0649",
+	0xFD12:  "\u0637\u064a",
+	0xFD13:  "\u0639\u0649",
+	0xFD14:  "\u0639\u064a",
+	0xFD15:  "\u063a\u0649",
+	0xFD16:  "\u063a\u064a",
+	0xFD17:  "\u0633\u0649",
+	0xFD18:  "\u0633\u064a",
+	0xFD19:  "\u0634\u0649",
+	0xFD1A:  "\u0634\u064a",
+	0xFD1B:  "\u062d\u0649",
+	0xFD1C:  "\u062d\u064a",
+	0xFD1D:  "\u062c\u0649",
+	0xFD1E:  "\u062c\u064a",
+	0xFD1F:  "\u062e\u0649",
+	0xFD20:  "\u062e\u064a",
+	0xFD21:  "\u0635\u0649",
+	0xFD22:  "\u0635\u064a",
+	0xFD23:  "\u0636\u0649",
+	0xFD24:  "\u0636\u064a",
+	0xFD25:  "\u0634\u062c",
+	0xFD26:  "\u0634\u062d",
+	0xFD27:  "\u0634\u062e",
+	0xFD28:  "\u0634\u0645",
+	0xFD29:  "\u0634\u0631",
+	0xFD2A:  "\u0633\u0631",
+	0xFD2B:  "\u0635\u0631",
+	0xFD2C:  "\u0636\u0631",
+	0xFD2D:  "\u0634\u062c",
+	0xFD2E:  "\u0634\u062d",
+	0xFD2F:  "\u0634\u062e",
+	0xFD30:  "\u0634\u0645",
+	0xFD31:  "\u0633\u0647",
+	0xFD32:  "\u0634\u0647",
+	0xFD33:  "\u0637\u0645",
+	0xFD34:  "\u0633\u062c",
+	0xFD35:  "\u0633\u062d",
+	0xFD36:  "\u0633\u062e",
+	0xFD37:  "\u0634\u062c",
+	0xFD38:  "\u0634\u062d",
+	0xFD39:  "\u0634\u062e",
+	0xFD3A:  "\u0637\u0645",
+	0xFD3B:  "\u0638\u0645",
+	0xFD3C:  "\u0627\u064b",
+	0xFD3D:  "\u0627\u064b",
+	0xFD50:  "\u062a\u062c\u0645",
+	0xFD51:  "\u062a\u062d\u062c",
+	0xFD52:  "\u062a\u062d\u062c",
+	0xFD53:  "\u062a\u062d\u0645",
+	0xFD54:  "\u062a\u062e\u0645",
+	0xFD55:  "\u062a\u0645\u062c",
+	0xFD56:  "\u062a\u0645\u062d",
+	0xFD57:  "\u062a\u0645\u062e",
+	0xFD58:  "\u062c\u0645\u062d",
+	0xFD59:  "\u062c\u0645\u062d",
+	0xFD5A:  "\u062d\u0645\u064a",
+	0xFD5B:  "\u062d\u0645\u0649",
+	0xFD5C:  "\u0633\u062d\u062c",
+	0xFD5D:  "\u0633\u062c\u062d",
+	0xFD5E:  "\u0633\u062c\u0649",
+	0xFD5F:  "\u0633\u0645\u062d",
+	0xFD60:  "\u0633\u0645\u062d",
+	0xFD61:  "\u0633\u0645\u062c",
+	0xFD62:  "\u0633\u0645\u0645",
+	0xFD63:  "\u0633\u0645\u0645",
+	0xFD64:  "\u0635\u062d\u062d",
+	0xFD65:  "\u0635\u062d\u062d",
+	0xFD66:  "\u0635\u0645\u0645",
+	0xFD67:  "\u0634\u062d\u0645",
+	0xFD68:  "\u0634\u062d\u0645",
+	0xFD69:  "\u0634\u062c\u064a",
+	0xFD6A:  "\u0634\u0645\u062e",
+	0xFD6B:  "\u0634\u0645\u062e",
+	0xFD6C:  "\u0634\u0645\u0645",
+	0xFD6D:  "\u0634\u0645\u0645",
+	0xFD6E:  "\u0636\u062d\u0649",
+	0xFD6F:  "\u0636\u062e\u0645",
+	0xFD70:  "\u0636\u062e\u0645",
+	0xFD71:  "\u0637\u0645\u062d",
+	0xFD72:  "\u0637\u0645\u062d",
+	0xFD73:  "\u0637\u0645\u0645",
+	0xFD74:  "\u0637\u0645\u064a",
+	0xFD75:  "\u0639\u062c\u0645",
+	0xFD76:  "\u0639\u0645\u0645",
+	0xFD77:  "\u0639\u0645\u0645",
+	0xFD78:  "\u0639\u0645\u0649",
+	0xFD79:  "\u063a\u0645\u0645",
+	0xFD7A:  "\u063a\u0645\u064a",
+	0xFD7B:  "\u063a\u0645\u0649",
+	0xFD7C:  "\u0641\u062e\u0645",
+	0xFD7D:  "\u0641\u062e\u0645",
+	0xFD7E:  "\u0642\u0645\u062d",
+	0xFD7F:  "\u0642\u0645\u0645",
+	0xFD80:  "\u0644\u062d\u0645",
+	0xFD81:  "\u0644\u062d\u064a",
+	0xFD82:  "\u0644\u062d\u0649",
+	0xFD83:  "\u0644\u062c\u062c",
+	0xFD84:  "\u0644\u062c\u062c",
+	0xFD85:  "\u0644\u062e\u0645",
+	0xFD86:  "\u0644\u062e\u0645",
+	0xFD87:  "\u0644\u0645\u062d",
+	0xFD88:  "\u0644\u0645\u062d",
+	0xFD89:  "\u0645\u062d\u062c",
+	0xFD8A:  "\u0645\u062d\u0645",
+	0xFD8B:  "\u0645\u062d\u064a",
+	0xFD8C:  "\u0645\u062c\u062d",
+	0xFD8D:  "\u0645\u062c\u0645",
+	0xFD8E:  "\u0645\u062e\u062c",
+	0xFD8F:  "\u0645\u062e\u0645",
+	0xFD92:  "\u0645\u062c\u062e",
+	0xFD93:  "\u0647\u0645\u062c",
+	0xFD94:  "\u0647\u0645\u0645",
+	0xFD95:  "\u0646\u062d\u0645",
+	0xFD96:  "\u0646\u062d\u0649",
+	0xFD97:  "\u0646\u062c\u0645",
+	0xFD98:  "\u0646\u062c\u0645",
+	0xFD99:  "\u0646\u062c\u0649",
+	0xFD9A:  "\u0646\u0645\u064a",
+	0xFD9B:  "\u0646\u0645\u0649",
+	0xFD9C:  "\u064a\u0645\u0645",
+	0xFD9D:  "\u064a\u0645\u0645",
+	0xFD9E:  "\u0628\u062e\u064a",
+	0xFD9F:  "\u062a\u062c\u064a",
+	0xFDA0:  "\u062a\u062c\u0649",
+	0xFDA1:  "\u062a\u062e\u064a",
+	0xFDA2:  "\u062a\u062e\u0649",
+	0xFDA3:  "\u062a\u0645\u064a",
+	0xFDA4:  "\u062a\u0645\u0649",
+	0xFDA5:  "\u062c\u0645\u064a",
+	0xFDA6:  "\u062c\u062d\u0649",
+	0xFDA7:  "\u062c\u0645\u0649",
+	0xFDA8:  "\u0633\u062e\u0649",
+	0xFDA9:  "\u0635\u062d\u064a",
+	0xFDAA:  "\u0634\u062d\u064a",
+	0xFDAB:  "\u0636\u062d\u064a",
+	0xFDAC:  "\u0644\u062c\u064a",
+	0xFDAD:  "\u0644\u0645\u064a",
+	0xFDAE:  "\u064a\u062d\u064a",
+	0xFDAF:  "\u064a\u062c\u064a",
+	0xFDB0:  "\u064a\u0645\u064a",
+	0xFDB1:  "\u0645\u0645\u064a",
+	0xFDB2:  "\u0642\u0645\u064a",
+	0xFDB3:  "\u0646\u062d\u064a",
+	0xFDB4:  "\u0642\u0645\u062d",
+	0xFDB5:  "\u0644\u062d\u0645",
+	0xFDB6:  "\u0639\u0645\u064a",
+	0xFDB7:  "\u0643\u0645\u064a",
+	0xFDB8:  "\u0646\u062c\u062d",
+	0xFDB9:  "\u0645\u062e\u064a",
+	0xFDBA:  "\u0644\u062c\u0645",
+	0xFDBB:  "\u0643\u0645\u0645",
+	0xFDBC:  "\u0644\u062c\u0645",
+	0xFDBD:  "\u0646\u062c\u062d",
+	0xFDBE:  "\u062c\u062d\u064a",
+	0xFDBF:  "\u062d\u062c\u064a",
+	0xFDC0:  "\u0645\u062c\u064a",
+	0xFDC1:  "\u0641\u0645\u064a",
+	0xFDC2:  "\u0628\u062d\u064a",
+	0xFDC3:  "\u0643\u0645\u0645",
+	0xFDC4:  "\u0639\u062c\u0645",
+	0xFDC5:  "\u0635\u0645\u0645",
+	0xFDC6:  "\u0633\u062e\u064a",
+	0xFDC7:  "\u0646\u062c\u064a",
+	0xFDF0:  "\u0635\u0644\u06d2",
+	0xFDF1:  "\u0642\u0644\u06d2",
+	0xFDF2:  "\u0627\u0644\u0644\u0647",
+	0xFDF3:  "\u0627\u0643\u0628\u0631",
+	0xFDF4:  "\u0645\u062d\u0645\u062f",
+	0xFDF5:  "\u0635\u0644\u0639\u0645",
+	0xFDF6:  "\u0631\u0633\u0648\u0644",
+	0xFDF7:  "\u0639\u0644\u064a\u0647",
+	0xFDF8:  "\u0648\u0633\u0644\u0645",
+	0xFDF9:  "\u0635\u0644\u0649",
+	0xFDFC:  "\u0631\u06cc\u0627\u0644",
+	0xFE33:  "_",
+	0xFE34:  "_",
+	0xFE4D:  "_",
+	0xFE4E:  "_",
+	0xFE4F:  "_",
+	0xFE63:  "-",
+	0xFE69:  "$",
+	0xFE71:  "\u0640\u064b",
+	0xFE77:  "\u0640\u064e",
+	0xFE79:  "\u0640\u064f",
+	0xFE7B:  "\u0640\u0650",
+	0xFE7D:  "\u0640\u0651",
+	0xFE7F:  "\u0640\u0652",
+	0xFE80:  "\u0621",
+	0xFE81:  "\u0622",
+	0xFE82:  "\u0622",
+	0xFE83:  "\u0623",
+	0xFE84:  "\u0623",
+	0xFE85:  "\u0624",
+	0xFE86:  "\u0624",
+	0xFE87:  "\u0625",
+	0xFE88:  "\u0625",
+	0xFE89:  "\u0626",
+	0xFE8A:  "\u0626",
+	0xFE8B:  "\u0626",
+	0xFE8C:  "\u0626",
+	0xFE8D:  "\u0627",
+	0xFE8E:  "\u0627",
+	0xFE8F:  "\u0628",
+	0xFE90:  "\u0628",
+	0xFE91:  "\u0628",
+	0xFE92:  "\u0628",
+	0xFE93:  "\u0629",
+	0xFE94:  "\u0629",
+	0xFE95:  "\u062a",
+	0xFE96:  "\u062a",
+	0xFE97:  "\u062a",
+	0xFE98:  "\u062a",
+	0xFE99:  "\u062b",
+	0xFE9A:  "\u062b",
+	0xFE9B:  "\u062b",
+	0xFE9C:  "\u062b",
+	0xFE9D:  "\u062c",
+	0xFE9E:  "\u062c",
+	0xFE9F:  "\u062c",
+	0xFEA0:  "\u062c",
+	0xFEA1:  "\u062d",
+	0xFEA2:  "\u062d",
+	0xFEA3:  "\u062d",
+	0xFEA4:  "\u062d",
+	0xFEA5:  "\u062e",
+	0xFEA6:  "\u062e",
+	0xFEA7:  "\u062e",
+	0xFEA8:  "\u062e",
+	0xFEA9:  "\u062f",
+	0xFEAA:  "\u062f",
+	0xFEAB:  "\u0630",
+	0xFEAC:  "\u0630",
+	0xFEAD:  "\u0631",
+	0xFEAE:  "\u0631",
+	0xFEAF:  "\u0632",
+	0xFEB0:  "\u0632",
+	0xFEB1:  "\u0633",
+	0xFEB2:  "\u0633",
+	0xFEB3:  "\u0633",
+	0xFEB4:  "\u0633",
+	0xFEB5:  "\u0634",
+	0xFEB6:  "\u0634",
+	0xFEB7:  "\u0634",
+	0xFEB8:  "\u0634",
+	0xFEB9:  "\u0635",
+	0xFEBA:  "\u0635",
+	0xFEBB:  "\u0635",
+	0xFEBC:  "\u0635",
+	0xFEBD:  "\u0636",
+	0xFEBE:  "\u0636",
+	0xFEBF:  "\u0636",
+	0xFEC0:  "\u0636",
+	0xFEC1:  "\u0637",
+	0xFEC2:  "\u0637",
+	0xFEC3:  "\u0637",
+	0xFEC4:  "\u0637",
+	0xFEC5:  "\u0638",
+	0xFEC6:  "\u0638",
+	0xFEC7:  "\u0638",
+	0xFEC8:  "\u0638",
+	0xFEC9:  "\u0639",
+	0xFECA:  "\u0639",
+	0xFECB:  "\u0639",
+	0xFECC:  "\u0639",
+	0xFECD:  "\u063a",
+	0xFECE:  "\u063a",
+	0xFECF:  "\u063a",
+	0xFED0:  "\u063a",
+	0xFED1:  "\u0641",
+	0xFED2:  "\u0641",
+	0xFED3:  "\u0641",
+	0xFED4:  "\u0641",
+	0xFED5:  "\u0642",
+	0xFED6:  "\u0642",
+	0xFED7:  "\u0642",
+	0xFED8:  "\u0642",
+	0xFED9:  "\u0643",
+	0xFEDA:  "\u0643",
+	0xFEDB:  "\u0643",
+	0xFEDC:  "\u0643",
+	0xFEDD:  "\u0644",
+	0xFEDE:  "\u0644",
+	0xFEDF:  "\u0644",
+	0xFEE0:  "\u0644",
+	0xFEE1:  "\u0645",
+	0xFEE2:  "\u0645",
+	0xFEE3:  "\u0645",
+	0xFEE4:  "\u0645",
+	0xFEE5:  "\u0646",
+	0xFEE6:  "\u0646",
+	0xFEE7:  "\u0646",
+	0xFEE8:  "\u0646",
+	0xFEE9:  "\u0647",
+	0xFEEA:  "\u0647",
+	0xFEEB:  "\u0647",
+	0xFEEC:  "\u0647",
+	0xFEED:  "\u0648",
+	0xFEEE:  "\u0648",
+	0xFEEF:  "\u0649",
+	0xFEF0:  "\u0649",
+	0xFEF1:  "\u064a",
+	0xFEF2:  "\u064a",
+	0xFEF3:  "\u064a",
+	0xFEF4:  "\u064a",
+	0xFEF5:  "\u0644\u0622",
+	0xFEF6:  "\u0644\u0622",
+	0xFEF7:  "\u0644\u0623",
+	0xFEF8:  "\u0644\u0623",
+	0xFEF9:  "\u0644\u0625",
+	0xFEFA:  "\u0644\u0625",
+	0xFEFB:  "\u0644\u0627",
+	0xFEFC:  "\u0644\u0627",
+	0xFF04:  "$",
+	0xFF0D:  "-",
+	0xFF10:  "0",
+	0xFF11:  "1",
+	0xFF12:  "2",
+	0xFF13:  "3",
+	0xFF14:  "4",
+	0xFF15:  "5",
+	0xFF16:  "6",
+	0xFF17:  "7",
+	0xFF18:  "8",
+	0xFF19:  "9",
+	0xFF21:  "a",
+	0xFF22:  "b",
+	0xFF23:  "c",
+	0xFF24:  "d",
+	0xFF25:  "e",
+	0xFF26:  "f",
+	0xFF27:  "g",
+	0xFF28:  "h",
+	0xFF29:  "i",
+	0xFF2A:  "j",
+	0xFF2B:  "k",
+	0xFF2C:  "l",
+	0xFF2D:  "m",
+	0xFF2E:  "n",
+	0xFF2F:  "o",
+	0xFF30:  "p",
+	0xFF31:  "q",
+	0xFF32:  "r",
+	0xFF33:  "s",
+	0xFF34:  "t",
+	0xFF35:  "u",
+	0xFF36:  "v",
+	0xFF37:  "w",
+	0xFF38:  "x",
+	0xFF39:  "y",
+	0xFF3A:  "z",
+	0xFF3F:  "_",
+	0xFF41:  "a",
+	0xFF42:  "b",
+	0xFF43:  "c",
+	0xFF44:  "d",
+	0xFF45:  "e",
+	0xFF46:  "f",
+	0xFF47:  "g",
+	0xFF48:  "h",
+	0xFF49:  "i",
+	0xFF4A:  "j",
+	0xFF4B:  "k",
+	0xFF4C:  "l",
+	0xFF4D:  "m",
+	0xFF4E:  "n",
+	0xFF4F:  "o",
+	0xFF50:  "p",
+	0xFF51:  "q",
+	0xFF52:  "r",
+	0xFF53:  "s",
+	0xFF54:  "t",
+	0xFF55:  "u",
+	0xFF56:  "v",
+	0xFF57:  "w",
+	0xFF58:  "x",
+	0xFF59:  "y",
+	0xFF5A:  "z",
+	0xFF65:  "\u30fb",
+	0xFF66:  "\u30f2",
+	0xFF67:  "\u30a1",
+	0xFF68:  "\u30a3",
+	0xFF69:  "\u30a5",
+	0xFF6A:  "\u30a7",
+	0xFF6B:  "\u30a9",
+	0xFF6C:  "\u30e3",
+	0xFF6D:  "\u30e5",
+	0xFF6E:  "\u30e7",
+	0xFF6F:  "\u30c3",
+	0xFF70:  "\u30fc",
+	0xFF71:  "\u30a2",
+	0xFF72:  "\u30a4",
+	0xFF73:  "\u30a6",
+	0xFF74:  "\u30a8",
+	0xFF75:  "\u30aa",
+	0xFF76:  "\u30ab",
+	0xFF77:  "\u30ad",
+	0xFF78:  "\u30af",
+	0xFF79:  "\u30b1",
+	0xFF7A:  "\u30b3",
+	0xFF7B:  "\u30b5",
+	0xFF7C:  "\u30b7",
+	0xFF7D:  "\u30b9",
+	0xFF7E:  "\u30bb",
+	0xFF7F:  "\u30bd",
+	0xFF80:  "\u30bf",
+	0xFF81:  "\u30c1",
+	0xFF82:  "\u30c4",
+	0xFF83:  "\u30c6",
+	0xFF84:  "\u30c8",
+	0xFF85:  "\u30ca",
+	0xFF86:  "\u30cb",
+	0xFF87:  "\u30cc",
+	0xFF88:  "\u30cd",
+	0xFF89:  "\u30ce",
+	0xFF8A:  "\u30cf",
+	0xFF8B:  "\u30d2",
+	0xFF8C:  "\u30d5",
+	0xFF8D:  "\u30d8",
+	0xFF8E:  "\u30db",
+	0xFF8F:  "\u30de",
+	0xFF90:  "\u30df",
+	0xFF91:  "\u30e0",
+	0xFF92:  "\u30e1",
+	0xFF93:  "\u30e2",
+	0xFF94:  "\u30e4",
+	0xFF95:  "\u30e6",
+	0xFF96:  "\u30e8",
+	0xFF97:  "\u30e9",
+	0xFF98:  "\u30ea",
+	0xFF99:  "\u30eb",
+	0xFF9A:  "\u30ec",
+	0xFF9B:  "\u30ed",
+	0xFF9C:  "\u30ef",
+	0xFF9D:  "\u30f3",
+	0xFF9E:  "\u3099",
+	0xFF9F:  "\u309a",
+	0xFFA1:  "\u1100",
+	0xFFA2:  "\u1101",
+	0xFFA3:  "\u11aa",
+	0xFFA4:  "\u1102",
+	0xFFA5:  "\u11ac",
+	0xFFA6:  "\u11ad",
+	0xFFA7:  "\u1103",
+	0xFFA8:  "\u1104",
+	0xFFA9:  "\u1105",
+	0xFFAA:  "\u11b0",
+	0xFFAB:  "\u11b1",
+	0xFFAC:  "\u11b2",
+	0xFFAD:  "\u11b3",
+	0xFFAE:  "\u11b4",
+	0xFFAF:  "\u11b5",
+	0xFFB0:  "\u111a",
+	0xFFB1:  "\u1106",
+	0xFFB2:  "\u1107",
+	0xFFB3:  "\u1108",
+	0xFFB4:  "\u1121",
+	0xFFB5:  "\u1109",
+	0xFFB6:  "\u110a",
+	0xFFB7:  "\u110b",
+	0xFFB8:  "\u110c",
+	0xFFB9:  "\u110d",
+	0xFFBA:  "\u110e",
+	0xFFBB:  "\u110f",
+	0xFFBC:  "\u1110",
+	0xFFBD:  "\u1111",
+	0xFFBE:  "\u1112",
+	0xFFC2:  "\u1161",
+	0xFFC3:  "\u1162",
+	0xFFC4:  "\u1163",
+	0xFFC5:  "\u1164",
+	0xFFC6:  "\u1165",
+	0xFFC7:  "\u1166",
+	0xFFCA:  "\u1167",
+	0xFFCB:  "\u1168",
+	0xFFCC:  "\u1169",
+	0xFFCD:  "\u116a",
+	0xFFCE:  "\u116b",
+	0xFFCF:  "\u116c",
+	0xFFD2:  "\u116d",
+	0xFFD3:  "\u116e",
+	0xFFD4:  "\u116f",
+	0xFFD5:  "\u1170",
+	0xFFD6:  "\u1171",
+	0xFFD7:  "\u1172",
+	0xFFDA:  "\u1173",
+	0xFFDB:  "\u1174",
+	0xFFDC:  "\u1175",
+	0x10400: "\U00010428",
+	0x10401: "\U00010429",
+	0x10402: "\U0001042a",
+	0x10403: "\U0001042b",
+	0x10404: "\U0001042c",
+	0x10405: "\U0001042d",
+	0x10406: "\U0001042e",
+	0x10407: "\U0001042f",
+	0x10408: "\U00010430",
+	0x10409: "\U00010431",
+	0x1040A: "\U00010432",
+	0x1040B: "\U00010433",
+	0x1040C: "\U00010434",
+	0x1040D: "\U00010435",
+	0x1040E: "\U00010436",
+	0x1040F: "\U00010437",
+	0x10410: "\U00010438",
+	0x10411: "\U00010439",
+	0x10412: "\U0001043a",
+	0x10413: "\U0001043b",
+	0x10414: "\U0001043c",
+	0x10415: "\U0001043d",
+	0x10416: "\U0001043e",
+	0x10417: "\U0001043f",
+	0x10418: "\U00010440",
+	0x10419: "\U00010441",
+	0x1041A: "\U00010442",
+	0x1041B: "\U00010443",
+	0x1041C: "\U00010444",
+	0x1041D: "\U00010445",
+	0x1041E: "\U00010446",
+	0x1041F: "\U00010447",
+	0x10420: "\U00010448",
+	0x10421: "\U00010449",
+	0x10422: "\U0001044a",
+	0x10423: "\U0001044b",
+	0x10424: "\U0001044c",
+	0x10425: "\U0001044d",
+	0x10426: "\U0001044e",
+	0x10427: "\U0001044f",
+	0x104B0: "\U000104d8",
+	0x104B1: "\U000104d9",
+	0x104B2: "\U000104da",
+	0x104B3: "\U000104db",
+	0x104B4: "\U000104dc",
+	0x104B5: "\U000104dd",
+	0x104B6: "\U000104de",
+	0x104B7: "\U000104df",
+	0x104B8: "\U000104e0",
+	0x104B9: "\U000104e1",
+	0x104BA: "\U000104e2",
+	0x104BB: "\U000104e3",
+	0x104BC: "\U000104e4",
+	0x104BD: "\U000104e5",
+	0x104BE: "\U000104e6",
+	0x104BF: "\U000104e7",
+	0x104C0: "\U000104e8",
+	0x104C1: "\U000104e9",
+	0x104C2: "\U000104ea",
+	0x104C3: "\U000104eb",
+	0x104C4: "\U000104ec",
+	0x104C5: "\U000104ed",
+	0x104C6: "\U000104ee",
+	0x104C7: "\U000104ef",
+	0x104C8: "\U000104f0",
+	0x104C9: "\U000104f1",
+	0x104CA: "\U000104f2",
+	0x104CB: "\U000104f3",
+	0x104CC: "\U000104f4",
+	0x104CD: "\U000104f5",
+	0x104CE: "\U000104f6",
+	0x104CF: "\U000104f7",
+	0x104D0: "\U000104f8",
+	0x104D1: "\U000104f9",
+	0x104D2: "\U000104fa",
+	0x104D3: "\U000104fb",
+	0x10570: "\U00010597",
+	0x10571: "\U00010598",
+	0x10572: "\U00010599",
+	0x10573: "\U0001059a",
+	0x10574: "\U0001059b",
+	0x10575: "\U0001059c",
+	0x10576: "\U0001059d",
+	0x10577: "\U0001059e",
+	0x10578: "\U0001059f",
+	0x10579: "\U000105a0",
+	0x1057A: "\U000105a1",
+	0x1057C: "\U000105a3",
+	0x1057D: "\U000105a4",
+	0x1057E: "\U000105a5",
+	0x1057F: "\U000105a6",
+	0x10580: "\U000105a7",
+	0x10581: "\U000105a8",
+	0x10582: "\U000105a9",
+	0x10583: "\U000105aa",
+	0x10584: "\U000105ab",
+	0x10585: "\U000105ac",
+	0x10586: "\U000105ad",
+	0x10587: "\U000105ae",
+	0x10588: "\U000105af",
+	0x10589: "\U000105b0",
+	0x1058A: "\U000105b1",
+	0x1058C: "\U000105b3",
+	0x1058D: "\U000105b4",
+	0x1058E: "\U000105b5",
+	0x1058F: "\U000105b6",
+	0x10590: "\U000105b7",
+	0x10591: "\U000105b8",
+	0x10592: "\U000105b9",
+	0x10594: "\U000105bb",
+	0x10595: "\U000105bc",
+	0x10781: "\u02d0",
+	0x10782: "\u02d1",
+	0x10783: "\u00e6",
+	0x10784: "\u0299",
+	0x10785: "\u0253",
+	0x10787: "\u02a3",
+	0x10788: "\uab66",
+	0x10789: "\u02a5",
+	0x1078A: "\u02a4",
+	0x1078B: "\u0256",
+	0x1078C: "\u0257",
+	0x1078D: "\u1d91",
+	0x1078E: "\u0258",
+	0x1078F: "\u025e",
+	0x10790: "\u02a9",
+	0x10791: "\u0264",
+	0x10792: "\u0262",
+	0x10793: "\u0260",
+	0x10794: "\u029b",
+	0x10795: "\u0127",
+	0x10796: "\u029c",
+	0x10797: "\u0267",
+	0x10798: "\u0284",
+	0x10799: "\u02aa",
+	0x1079A: "\u02ab",
+	0x1079B: "\u026c",
+	0x1079C: "\U0001df04",
+	0x1079D: "\ua78e",
+	0x1079E: "\u026e",
+	0x1079F: "\U0001df05",
+	0x107A0: "\u028e",
+	0x107A1: "\U0001df06",
+	0x107A2: "\u00f8",
+	0x107A3: "\u0276",
+	0x107A4: "\u0277",
+	0x107A5: "q",
+	0x107A6: "\u027a",
+	0x107A7: "\U0001df08",
+	0x107A8: "\u027d",
+	0x107A9: "\u027e",
+	0x107AA: "\u0280",
+	0x107AB: "\u02a8",
+	0x107AC: "\u02a6",
+	0x107AD: "\uab67",
+	0x107AE: "\u02a7",
+	0x107AF: "\u0288",
+	0x107B0: "\u2c71",
+	0x107B2: "\u028f",
+	0x107B3: "\u02a1",
+	0x107B4: "\u02a2",
+	0x107B5: "\u0298",
+	0x107B6: "\u01c0",
+	0x107B7: "\u01c1",
+	0x107B8: "\u01c2",
+	0x107B9: "\U0001df0a",
+	0x107BA: "\U0001df1e",
+	0x10C80: "\U00010cc0",
+	0x10C81: "\U00010cc1",
+	0x10C82: "\U00010cc2",
+	0x10C83: "\U00010cc3",
+	0x10C84: "\U00010cc4",
+	0x10C85: "\U00010cc5",
+	0x10C86: "\U00010cc6",
+	0x10C87: "\U00010cc7",
+	0x10C88: "\U00010cc8",
+	0x10C89: "\U00010cc9",
+	0x10C8A: "\U00010cca",
+	0x10C8B: "\U00010ccb",
+	0x10C8C: "\U00010ccc",
+	0x10C8D: "\U00010ccd",
+	0x10C8E: "\U00010cce",
+	0x10C8F: "\U00010ccf",
+	0x10C90: "\U00010cd0",
+	0x10C91: "\U00010cd1",
+	0x10C92: "\U00010cd2",
+	0x10C93: "\U00010cd3",
+	0x10C94: "\U00010cd4",
+	0x10C95: "\U00010cd5",
+	0x10C96: "\U00010cd6",
+	0x10C97: "\U00010cd7",
+	0x10C98: "\U00010cd8",
+	0x10C99: "\U00010cd9",
+	0x10C9A: "\U00010cda",
+	0x10C9B: "\U00010cdb",
+	0x10C9C: "\U00010cdc",
+	0x10C9D: "\U00010cdd",
+	0x10C9E: "\U00010cde",
+	0x10C9F: "\U00010cdf",
+	0x10CA0: "\U00010ce0",
+	0x10CA1: "\U00010ce1",
+	0x10CA2: "\U00010ce2",
+	0x10CA3: "\U00010ce3",
+	0x10CA4: "\U00010ce4",
+	0x10CA5: "\U00010ce5",
+	0x10CA6: "\U00010ce6",
+	0x10CA7: "\U00010ce7",
+	0x10CA8: "\U00010ce8",
+	0x10CA9: "\U00010ce9",
+	0x10CAA: "\U00010cea",
+	0x10CAB: "\U00010ceb",
+	0x10CAC: "\U00010cec",
+	0x10CAD: "\U00010ced",
+	0x10CAE: "\U00010cee",
+	0x10CAF: "\U00010cef",
+	0x10CB0: "\U00010cf0",
+	0x10CB1: "\U00010cf1",
+	0x10CB2: "\U00010cf2",
+	0x118A0: "\U000118c0",
+	0x118A1: "\U000118c1",
+	0x118A2: "\U000118c2",
+	0x118A3: "\U000118c3",
+	0x118A4: "\U000118c4",
+	0x118A5: "\U000118c5",
+	0x118A6: "\U000118c6",
+	0x118A7: "\U000118c7",
+	0x118A8: "\U000118c8",
+	0x118A9: "\U000118c9",
+	0x118AA: "\U000118ca",
+	0x118AB: "\U000118cb",
+	0x118AC: "\U000118cc",
+	0x118AD: "\U000118cd",
+	0x118AE: "\U000118ce",
+	0x118AF: "\U000118cf",
+	0x118B0: "\U000118d0",
+	0x118B1: "\U000118d1",
+	0x118B2: "\U000118d2",
+	0x118B3: "\U000118d3",
+	0x118B4: "\U000118d4",
+	0x118B5: "\U000118d5",
+	0x118B6: "\U000118d6",
+	0x118B7: "\U000118d7",
+	0x118B8: "\U000118d8",
+	0x118B9: "\U000118d9",
+	0x118BA: "\U000118da",
+	0x118BB: "\U000118db",
+	0x118BC: "\U000118dc",
+	0x118BD: "\U000118dd",
+	0x118BE: "\U000118de",
+	0x118BF: "\U000118df",
+	0x16E40: "\U00016e60",
+	0x16E41: "\U00016e61",
+	0x16E42: "\U00016e62",
+	0x16E43: "\U00016e63",
+	0x16E44: "\U00016e64",
+	0x16E45: "\U00016e65",
+	0x16E46: "\U00016e66",
+	0x16E47: "\U00016e67",
+	0x16E48: "\U00016e68",
+	0x16E49: "\U00016e69",
+	0x16E4A: "\U00016e6a",
+	0x16E4B: "\U00016e6b",
+	0x16E4C: "\U00016e6c",
+	0x16E4D: "\U00016e6d",
+	0x16E4E: "\U00016e6e",
+	0x16E4F: "\U00016e6f",
+	0x16E50: "\U00016e70",
+	0x16E51: "\U00016e71",
+	0x16E52: "\U00016e72",
+	0x16E53: "\U00016e73",
+	0x16E54: "\U00016e74",
+	0x16E55: "\U00016e75",
+	0x16E56: "\U00016e76",
+	0x16E57: "\U00016e77",
+	0x16E58: "\U00016e78",
+	0x16E59: "\U00016e79",
+	0x16E5A: "\U00016e7a",
+	0x16E5B: "\U00016e7b",
+	0x16E5C: "\U00016e7c",
+	0x16E5D: "\U00016e7d",
+	0x16E5E: "\U00016e7e",
+	0x16E5F: "\U00016e7f",
+	0x1D400: "a",
+	0x1D401: "b",
+	0x1D402: "c",
+	0x1D403: "d",
+	0x1D404: "e",
+	0x1D405: "f",
+	0x1D406: "g",
+	0x1D407: "h",
+	0x1D408: "i",
+	0x1D409: "j",
+	0x1D40A: "k",
+	0x1D40B: "l",
+	0x1D40C: "m",
+	0x1D40D: "n",
+	0x1D40E: "o",
+	0x1D40F: "p",
+	0x1D410: "q",
+	0x1D411: "r",
+	0x1D412: "s",
+	0x1D413: "t",
+	0x1D414: "u",
+	0x1D415: "v",
+	0x1D416: "w",
+	0x1D417: "x",
+	0x1D418: "y",
+	0x1D419: "z",
+	0x1D41A: "a",
+	0x1D41B: "b",
+	0x1D41C: "c",
+	0x1D41D: "d",
+	0x1D41E: "e",
+	0x1D41F: "f",
+	0x1D420: "g",
+	0x1D421: "h",
+	0x1D422: "i",
+	0x1D423: "j",
+	0x1D424: "k",
+	0x1D425: "l",
+	0x1D426: "m",
+	0x1D427: "n",
+	0x1D428: "o",
+	0x1D429: "p",
+	0x1D42A: "q",
+	0x1D42B: "r",
+	0x1D42C: "s",
+	0x1D42D: "t",
+	0x1D42E: "u",
+	0x1D42F: "v",
+	0x1D430: "w",
+	0x1D431: "x",
+	0x1D432: "y",
+	0x1D433: "z",
+	0x1D434: "a",
+	0x1D435: "b",
+	0x1D436: "c",
+	0x1D437: "d",
+	0x1D438: "e",
+	0x1D439: "f",
+	0x1D43A: "g",
+	0x1D43B: "h",
+	0x1D43C: "i",
+	0x1D43D: "j",
+	0x1D43E: "k",
+	0x1D43F: "l",
+	0x1D440: "m",
+	0x1D441: "n",
+	0x1D442: "o",
+	0x1D443: "p",
+	0x1D444: "q",
+	0x1D445: "r",
+	0x1D446: "s",
+	0x1D447: "t",
+	0x1D448: "u",
+	0x1D449: "v",
+	0x1D44A: "w",
+	0x1D44B: "x",
+	0x1D44C: "y",
+	0x1D44D: "z",
+	0x1D44E: "a",
+	0x1D44F: "b",
+	0x1D450: "c",
+	0x1D451: "d",
+	0x1D452: "e",
+	0x1D453: "f",
+	0x1D454: "g",
+	0x1D456: "i",
+	0x1D457: "j",
+	0x1D458: "k",
+	0x1D459: "l",
+	0x1D45A: "m",
+	0x1D45B: "n",
+	0x1D45C: "o",
+	0x1D45D: "p",
+	0x1D45E: "q",
+	0x1D45F: "r",
+	0x1D460: "s",
+	0x1D461: "t",
+	0x1D462: "u",
+	0x1D463: "v",
+	0x1D464: "w",
+	0x1D465: "x",
+	0x1D466: "y",
+	0x1D467: "z",
+	0x1D468: "a",
+	0x1D469: "b",
+	0x1D46A: "c",
+	0x1D46B: "d",
+	0x1D46C: "e",
+	0x1D46D: "f",
+	0x1D46E: "g",
+	0x1D46F: "h",
+	0x1D470: "i",
+	0x1D471: "j",
+	0x1D472: "k",
+	0x1D473: "l",
+	0x1D474: "m",
+	0x1D475: "n",
+	0x1D476: "o",
+	0x1D477: "p",
+	0x1D478: "q",
+	0x1D479: "r",
+	0x1D47A: "s",
+	0x1D47B: "t",
+	0x1D47C: "u",
+	0x1D47D: "v",
+	0x1D47E: "w",
+	0x1D47F: "x",
+	0x1D480: "y",
+	0x1D481: "z",
+	0x1D482: "a",
+	0x1D483: "b",
+	0x1D484: "c",
+	0x1D485: "d",
+	0x1D486: "e",
+	0x1D487: "f",
+	0x1D488: "g",
+	0x1D489: "h",
+	0x1D48A: "i",
+	0x1D48B: "j",
+	0x1D48C: "k",
+	0x1D48D: "l",
+	0x1D48E: "m",
+	0x1D48F: "n",
+	0x1D490: "o",
+	0x1D491: "p",
+	0x1D492: "q",
+	0x1D493: "r",
+	0x1D494: "s",
+	0x1D495: "t",
+	0x1D496: "u",
+	0x1D497: "v",
+	0x1D498: "w",
+	0x1D499: "x",
+	0x1D49A: "y",
+	0x1D49B: "z",
+	0x1D49C: "a",
+	0x1D49E: "c",
+	0x1D49F: "d",
+	0x1D4A2: "g",
+	0x1D4A5: "j",
+	0x1D4A6: "k",
+	0x1D4A9: "n",
+	0x1D4AA: "o",
+	0x1D4AB: "p",
+	0x1D4AC: "q",
+	0x1D4AE: "s",
+	0x1D4AF: "t",
+	0x1D4B0: "u",
+	0x1D4B1: "v",
+	0x1D4B2: "w",
+	0x1D4B3: "x",
+	0x1D4B4: "y",
+	0x1D4B5: "z",
+	0x1D4B6: "a",
+	0x1D4B7: "b",
+	0x1D4B8: "c",
+	0x1D4B9: "d",
+	0x1D4BB: "f",
+	0x1D4BD: "h",
+	0x1D4BE: "i",
+	0x1D4BF: "j",
+	0x1D4C0: "k",
+	0x1D4C1: "l",
+	0x1D4C2: "m",
+	0x1D4C3: "n",
+	0x1D4C5: "p",
+	0x1D4C6: "q",
+	0x1D4C7: "r",
+	0x1D4C8: "s",
+	0x1D4C9: "t",
+	0x1D4CA: "u",
+	0x1D4CB: "v",
+	0x1D4CC: "w",
+	0x1D4CD: "x",
+	0x1D4CE: "y",
+	0x1D4CF: "z",
+	0x1D4D0: "a",
+	0x1D4D1: "b",
+	0x1D4D2: "c",
+	0x1D4D3: "d",
+	0x1D4D4: "e",
+	0x1D4D5: "f",
+	0x1D4D6: "g",
+	0x1D4D7: "h",
+	0x1D4D8: "i",
+	0x1D4D9: "j",
+	0x1D4DA: "k",
+	0x1D4DB: "l",
+	0x1D4DC: "m",
+	0x1D4DD: "n",
+	0x1D4DE: "o",
+	0x1D4DF: "p",
+	0x1D4E0: "q",
+	0x1D4E1: "r",
+	0x1D4E2: "s",
+	0x1D4E3: "t",
+	0x1D4E4: "u",
+	0x1D4E5: "v",
+	0x1D4E6: "w",
+	0x1D4E7: "x",
+	0x1D4E8: "y",
+	0x1D4E9: "z",
+	0x1D4EA: "a",
+	0x1D4EB: "b",
+	0x1D4EC: "c",
+	0x1D4ED: "d",
+	0x1D4EE: "e",
+	0x1D4EF: "f",
+	0x1D4F0: "g",
+	0x1D4F1: "h",
+	0x1D4F2: "i",
+	0x1D4F3: "j",
+	0x1D4F4: "k",
+	0x1D4F5: "l",
+	0x1D4F6: "m",
+	0x1D4F7: "n",
+	0x1D4F8: "o",
+	0x1D4F9: "p",
+	0x1D4FA: "q",
+	0x1D4FB: "r",
+	0x1D4FC: "s",
+	0x1D4FD: "t",
+	0x1D4FE: "u",
+	0x1D4FF: "v",
+	0x1D500: "w",
+	0x1D501: "x",
+	0x1D502: "y",
+	0x1D503: "z",
+	0x1D504: "a",
+	0x1D505: "b",
+	0x1D507: "d",
+	0x1D508: "e",
+	0x1D509: "f",
+	0x1D50A: "g",
+	0x1D50D: "j",
+	0x1D50E: "k",
+	0x1D50F: "l",
+	0x1D510: "m",
+	0x1D511: "n",
+	0x1D512: "o",
+	0x1D513: "p",
+	0x1D514: "q",
+	0x1D516: "s",
+	0x1D517: "t",
+	0x1D518: "u",
+	0x1D519: "v",
+	0x1D51A: "w",
+	0x1D51B: "x",
+	0x1D51C: "y",
+	0x1D51E: "a",
+	0x1D51F: "b",
+	0x1D520: "c",
+	0x1D521: "d",
+	0x1D522: "e",
+	0x1D523: "f",
+	0x1D524: "g",
+	0x1D525: "h",
+	0x1D526: "i",
+	0x1D527: "j",
+	0x1D528: "k",
+	0x1D529: "l",
+	0x1D52A: "m",
+	0x1D52B: "n",
+	0x1D52C: "o",
+	0x1D52D: "p",
+	0x1D52E: "q",
+	0x1D52F: "r",
+	0x1D530: "s",
+	0x1D531: "t",
+	0x1D532: "u",
+	0x1D533: "v",
+	0x1D534: "w",
+	0x1D535: "x",
+	0x1D536: "y",
+	0x1D537: "z",
+	0x1D538: "a",
+	0x1D539: "b",
+	0x1D53B: "d",
+	0x1D53C: "e",
+	0x1D53D: "f",
+	0x1D53E: "g",
+	0x1D540: "i",
+	0x1D541: "j",
+	0x1D542: "k",
+	0x1D543: "l",
+	0x1D544: "m",
+	0x1D546: "o",
+	0x1D54A: "s",
+	0x1D54B: "t",
+	0x1D54C: "u",
+	0x1D54D: "v",
+	0x1D54E: "w",
+	0x1D54F: "x",
+	0x1D550: "y",
+	0x1D552: "a",
+	0x1D553: "b",
+	0x1D554: "c",
+	0x1D555: "d",
+	0x1D556: "e",
+	0x1D557: "f",
+	0x1D558: "g",
+	0x1D559: "h",
+	0x1D55A: "i",
+	0x1D55B: "j",
+	0x1D55C: "k",
+	0x1D55D: "l",
+	0x1D55E: "m",
+	0x1D55F: "n",
+	0x1D560: "o",
+	0x1D561: "p",
+	0x1D562: "q",
+	0x1D563: "r",
+	0x1D564: "s",
+	0x1D565: "t",
+	0x1D566: "u",
+	0x1D567: "v",
+	0x1D568: "w",
+	0x1D569: "x",
+	0x1D56A: "y",
+	0x1D56B: "z",
+	0x1D56C: "a",
+	0x1D56D: "b",
+	0x1D56E: "c",
+	0x1D56F: "d",
+	0x1D570: "e",
+	0x1D571: "f",
+	0x1D572: "g",
+	0x1D573: "h",
+	0x1D574: "i",
+	0x1D575: "j",
+	0x1D576: "k",
+	0x1D577: "l",
+	0x1D578: "m",
+	0x1D579: "n",
+	0x1D57A: "o",
+	0x1D57B: "p",
+	0x1D57C: "q",
+	0x1D57D: "r",
+	0x1D57E: "s",
+	0x1D57F: "t",
+	0x1D580: "u",
+	0x1D581: "v",
+	0x1D582: "w",
+	0x1D583: "x",
+	0x1D584: "y",
+	0x1D585: "z",
+	0x1D586: "a",
+	0x1D587: "b",
+	0x1D588: "c",
+	0x1D589: "d",
+	0x1D58A: "e",
+	0x1D58B: "f",
+	0x1D58C: "g",
+	0x1D58D: "h",
+	0x1D58E: "i",
+	0x1D58F: "j",
+	0x1D590: "k",
+	0x1D591: "l",
+	0x1D592: "m",
+	0x1D593: "n",
+	0x1D594: "o",
+	0x1D595: "p",
+	0x1D596: "q",
+	0x1D597: "r",
+	0x1D598: "s",
+	0x1D599: "t",
+	0x1D59A: "u",
+	0x1D59B: "v",
+	0x1D59C: "w",
+	0x1D59D: "x",
+	0x1D59E: "y",
+	0x1D59F: "z",
+	0x1D5A0: "a",
+	0x1D5A1: "b",
+	0x1D5A2: "c",
+	0x1D5A3: "d",
+	0x1D5A4: "e",
+	0x1D5A5: "f",
+	0x1D5A6: "g",
+	0x1D5A7: "h",
+	0x1D5A8: "i",
+	0x1D5A9: "j",
+	0x1D5AA: "k",
+	0x1D5AB: "l",
+	0x1D5AC: "m",
+	0x1D5AD: "n",
+	0x1D5AE: "o",
+	0x1D5AF: "p",
+	0x1D5B0: "q",
+	0x1D5B1: "r",
+	0x1D5B2: "s",
+	0x1D5B3: "t",
+	0x1D5B4: "u",
+	0x1D5B5: "v",
+	0x1D5B6: "w",
+	0x1D5B7: "x",
+	0x1D5B8: "y",
+	0x1D5B9: "z",
+	0x1D5BA: "a",
+	0x1D5BB: "b",
+	0x1D5BC: "c",
+	0x1D5BD: "d",
+	0x1D5BE: "e",
+	0x1D5BF: "f",
+	0x1D5C0: "g",
+	0x1D5C1: "h",
+	0x1D5C2: "i",
+	0x1D5C3: "j",
+	0x1D5C4: "k",
+	0x1D5C5: "l",
+	0x1D5C6: "m",
+	0x1D5C7: "n",
+	0x1D5C8: "o",
+	0x1D5C9: "p",
+	0x1D5CA: "q",
+	0x1D5CB: "r",
+	0x1D5CC: "s",
+	0x1D5CD: "t",
+	0x1D5CE: "u",
+	0x1D5CF: "v",
+	0x1D5D0: "w",
+	0x1D5D1: "x",
+	0x1D5D2: "y",
+	0x1D5D3: "z",
+	0x1D5D4: "a",
+	0x1D5D5: "b",
+	0x1D5D6: "c",
+	0x1D5D7: "d",
+	0x1D5D8: "e",
+	0x1D5D9: "f",
+	0x1D5DA: "g",
+	0x1D5DB: "h",
+	0x1D5DC: "i",
+	0x1D5DD: "j",
+	0x1D5DE: "k",
+	0x1D5DF: "l",
+	0x1D5E0: "m",
+	0x1D5E1: "n",
+	0x1D5E2: "o",
+	0x1D5E3: "p",
+	0x1D5E4: "q",
+	0x1D5E5: "r",
+	0x1D5E6: "s",
+	0x1D5E7: "t",
+	0x1D5E8: "u",
+	0x1D5E9: "v",
+	0x1D5EA: "w",
+	0x1D5EB: "x",
+	0x1D5EC: "y",
+	0x1D5ED: "z",
+	0x1D5EE: "a",
+	0x1D5EF: "b",
+	0x1D5F0: "c",
+	0x1D5F1: "d",
+	0x1D5F2: "e",
+	0x1D5F3: "f",
+	0x1D5F4: "g",
+	0x1D5F5: "h",
+	0x1D5F6: "i",
+	0x1D5F7: "j",
+	0x1D5F8: "k",
+	0x1D5F9: "l",
+	0x1D5FA: "m",
+	0x1D5FB: "n",
+	0x1D5FC: "o",
+	0x1D5FD: "p",
+	0x1D5FE: "q",
+	0x1D5FF: "r",
+	0x1D600: "s",
+	0x1D601: "t",
+	0x1D602: "u",
+	0x1D603: "v",
+	0x1D604: "w",
+	0x1D605: "x",
+	0x1D606: "y",
+	0x1D607: "z",
+	0x1D608: "a",
+	0x1D609: "b",
+	0x1D60A: "c",
+	0x1D60B: "d",
+	0x1D60C: "e",
+	0x1D60D: "f",
+	0x1D60E: "g",
+	0x1D60F: "h",
+	0x1D610: "i",
+	0x1D611: "j",
+	0x1D612: "k",
+	0x1D613: "l",
+	0x1D614: "m",
+	0x1D615: "n",
+	0x1D616: "o",
+	0x1D617: "p",
+	0x1D618: "q",
+	0x1D619: "r",
+	0x1D61A: "s",
+	0x1D61B: "t",
+	0x1D61C: "u",
+	0x1D61D: "v",
+	0x1D61E: "w",
+	0x1D61F: "x",
+	0x1D620: "y",
+	0x1D621: "z",
+	0x1D622: "a",
+	0x1D623: "b",
+	0x1D624: "c",
+	0x1D625: "d",
+	0x1D626: "e",
+	0x1D627: "f",
+	0x1D628: "g",
+	0x1D629: "h",
+	0x1D62A: "i",
+	0x1D62B: "j",
+	0x1D62C: "k",
+	0x1D62D: "l",
+	0x1D62E: "m",
+	0x1D62F: "n",
+	0x1D630: "o",
+	0x1D631: "p",
+	0x1D632: "q",
+	0x1D633: "r",
+	0x1D634: "s",
+	0x1D635: "t",
+	0x1D636: "u",
+	0x1D637: "v",
+	0x1D638: "w",
+	0x1D639: "x",
+	0x1D63A: "y",
+	0x1D63B: "z",
+	0x1D63C: "a",
+	0x1D63D: "b",
+	0x1D63E: "c",
+	0x1D63F: "d",
+	0x1D640: "e",
+	0x1D641: "f",
+	0x1D642: "g",
+	0x1D643: "h",
+	0x1D644: "i",
+	0x1D645: "j",
+	0x1D646: "k",
+	0x1D647: "l",
+	0x1D648: "m",
+	0x1D649: "n",
+	0x1D64A: "o",
+	0x1D64B: "p",
+	0x1D64C: "q",
+	0x1D64D: "r",
+	0x1D64E: "s",
+	0x1D64F: "t",
+	0x1D650: "u",
+	0x1D651: "v",
+	0x1D652: "w",
+	0x1D653: "x",
+	0x1D654: "y",
+	0x1D655: "z",
+	0x1D656: "a",
+	0x1D657: "b",
+	0x1D658: "c",
+	0x1D659: "d",
+	0x1D65A: "e",
+	0x1D65B: "f",
+	0x1D65C: "g",
+	0x1D65D: "h",
+	0x1D65E: "i",
+	0x1D65F: "j",
+	0x1D660: "k",
+	0x1D661: "l",
+	0x1D662: "m",
+	0x1D663: "n",
+	0x1D664: "o",
+	0x1D665: "p",
+	0x1D666: "q",
+	0x1D667: "r",
+	0x1D668: "s",
+	0x1D669: "t",
+	0x1D66A: "u",
+	0x1D66B: "v",
+	0x1D66C: "w",
+	0x1D66D: "x",
+	0x1D66E: "y",
+	0x1D66F: "z",
+	0x1D670: "a",
+	0x1D671: "b",
+	0x1D672: "c",
+	0x1D673: "d",
+	0x1D674: "e",
+	0x1D675: "f",
+	0x1D676: "g",
+	0x1D677: "h",
+	0x1D678: "i",
+	0x1D679: "j",
+	0x1D67A: "k",
+	0x1D67B: "l",
+	0x1D67C: "m",
+	0x1D67D: "n",
+	0x1D67E: "o",
+	0x1D67F: "p",
+	0x1D680: "q",
+	0x1D681: "r",
+	0x1D682: "s",
+	0x1D683: "t",
+	0x1D684: "u",
+	0x1D685: "v",
+	0x1D686: "w",
+	0x1D687: "x",
+	0x1D688: "y",
+	0x1D689: "z",
+	0x1D68A: "a",
+	0x1D68B: "b",
+	0x1D68C: "c",
+	0x1D68D: "d",
+	0x1D68E: "e",
+	0x1D68F: "f",
+	0x1D690: "g",
+	0x1D691: "h",
+	0x1D692: "i",
+	0x1D693: "j",
+	0x1D694: "k",
+	0x1D695: "l",
+	0x1D696: "m",
+	0x1D697: "n",
+	0x1D698: "o",
+	0x1D699: "p",
+	0x1D69A: "q",
+	0x1D69B: "r",
+	0x1D69C: "s",
+	0x1D69D: "t",
+	0x1D69E: "u",
+	0x1D69F: "v",
+	0x1D6A0: "w",
+	0x1D6A1: "x",
+	0x1D6A2: "y",
+	0x1D6A3: "z",
+	0x1D6A4: "\u0131",
+	0x1D6A5: "\u0237",
+	0x1D6A8: "\u03b1",
+	0x1D6A9: "\u03b2",
+	0x1D6AA: "\u03b3",
+	0x1D6AB: "\u03b4",
+	0x1D6AC: "\u03b5",
+	0x1D6AD: "\u03b6",
+	0x1D6AE: "\u03b7",
+	0x1D6AF: "\u03b8",
+	0x1D6B0: "\u03b9",
+	0x1D6B1: "\u03ba",
+	0x1D6B2: "\u03bb",
+	0x1D6B3: "\u03bc",
+	0x1D6B4: "\u03bd",
+	0x1D6B5: "\u03be",
+	0x1D6B6: "\u03bf",
+	0x1D6B7: "\u03c0",
+	0x1D6B8: "\u03c1",
+	0x1D6B9: "\u03b8",
+	0x1D6BA: "\u03c3",
+	0x1D6BB: "\u03c4",
+	0x1D6BC: "\u03c5",
+	0x1D6BD: "\u03c6",
+	0x1D6BE: "\u03c7",
+	0x1D6BF: "\u03c8",
+	0x1D6C0: "\u03c9",
+	0x1D6C2: "\u03b1",
+	0x1D6C3: "\u03b2",
+	0x1D6C4: "\u03b3",
+	0x1D6C5: "\u03b4",
+	0x1D6C6: "\u03b5",
+	0x1D6C7: "\u03b6",
+	0x1D6C8: "\u03b7",
+	0x1D6C9: "\u03b8",
+	0x1D6CA: "\u03b9",
+	0x1D6CB: "\u03ba",
+	0x1D6CC: "\u03bb",
+	0x1D6CD: "\u03bc",
+	0x1D6CE: "\u03bd",
+	0x1D6CF: "\u03be",
+	0x1D6D0: "\u03bf",
+	0x1D6D1: "\u03c0",
+	0x1D6D2: "\u03c1",
+	0x1D6D3: "\u03c3",
+	0x1D6D4: "\u03c3",
+	0x1D6D5: "\u03c4",
+	0x1D6D6: "\u03c5",
+	0x1D6D7: "\u03c6",
+	0x1D6D8: "\u03c7",
+	0x1D6D9: "\u03c8",
+	0x1D6DA: "\u03c9",
+	0x1D6DC: "\u03b5",
+	0x1D6DD: "\u03b8",
+	0x1D6DE: "\u03ba",
+	0x1D6DF: "\u03c6",
+	0x1D6E0: "\u03c1",
+	0x1D6E1: "\u03c0",
+	0x1D6E2: "\u03b1",
+	0x1D6E3: "\u03b2",
+	0x1D6E4: "\u03b3",
+	0x1D6E5: "\u03b4",
+	0x1D6E6: "\u03b5",
+	0x1D6E7: "\u03b6",
+	0x1D6E8: "\u03b7",
+	0x1D6E9: "\u03b8",
+	0x1D6EA: "\u03b9",
+	0x1D6EB: "\u03ba",
+	0x1D6EC: "\u03bb",
+	0x1D6ED: "\u03bc",
+	0x1D6EE: "\u03bd",
+	0x1D6EF: "\u03be",
+	0x1D6F0: "\u03bf",
+	0x1D6F1: "\u03c0",
+	0x1D6F2: "\u03c1",
+	0x1D6F3: "\u03b8",
+	0x1D6F4: "\u03c3",
+	0x1D6F5: "\u03c4",
+	0x1D6F6: "\u03c5",
+	0x1D6F7: "\u03c6",
+	0x1D6F8: "\u03c7",
+	0x1D6F9: "\u03c8",
+	0x1D6FA: "\u03c9",
+	0x1D6FC: "\u03b1",
+	0x1D6FD: "\u03b2",
+	0x1D6FE: "\u03b3",
+	0x1D6FF: "\u03b4",
+	0x1D700: "\u03b5",
+	0x1D701: "\u03b6",
+	0x1D702: "\u03b7",
+	0x1D703: "\u03b8",
+	0x1D704: "\u03b9",
+	0x1D705: "\u03ba",
+	0x1D706: "\u03bb",
+	0x1D707: "\u03bc",
+	0x1D708: "\u03bd",
+	0x1D709: "\u03be",
+	0x1D70A: "\u03bf",
+	0x1D70B: "\u03c0",
+	0x1D70C: "\u03c1",
+	0x1D70D: "\u03c3",
+	0x1D70E: "\u03c3",
+	0x1D70F: "\u03c4",
+	0x1D710: "\u03c5",
+	0x1D711: "\u03c6",
+	0x1D712: "\u03c7",
+	0x1D713: "\u03c8",
+	0x1D714: "\u03c9",
+	0x1D716: "\u03b5",
+	0x1D717: "\u03b8",
+	0x1D718: "\u03ba",
+	0x1D719: "\u03c6",
+	0x1D71A: "\u03c1",
+	0x1D71B: "\u03c0",
+	0x1D71C: "\u03b1",
+	0x1D71D: "\u03b2",
+	0x1D71E: "\u03b3",
+	0x1D71F: "\u03b4",
+	0x1D720: "\u03b5",
+	0x1D721: "\u03b6",
+	0x1D722: "\u03b7",
+	0x1D723: "\u03b8",
+	0x1D724: "\u03b9",
+	0x1D725: "\u03ba",
+	0x1D726: "\u03bb",
+	0x1D727: "\u03bc",
+	0x1D728: "\u03bd",
+	0x1D729: "\u03be",
+	0x1D72A: "\u03bf",
+	0x1D72B: "\u03c0",
+	0x1D72C: "\u03c1",
+	0x1D72D: "\u03b8",
+	0x1D72E: "\u03c3",
+	0x1D72F: "\u03c4",
+	0x1D730: "\u03c5",
+	0x1D731: "\u03c6",
+	0x1D732: "\u03c7",
+	0x1D733: "\u03c8",
+	0x1D734: "\u03c9",
+	0x1D736: "\u03b1",
+	0x1D737: "\u03b2",
+	0x1D738: "\u03b3",
+	0x1D739: "\u03b4",
+	0x1D73A: "\u03b5",
+	0x1D73B: "\u03b6",
+	0x1D73C: "\u03b7",
+	0x1D73D: "\u03b8",
+	0x1D73E: "\u03b9",
+	0x1D73F: "\u03ba",
+	0x1D740: "\u03bb",
+	0x1D741: "\u03bc",
+	0x1D742: "\u03bd",
+	0x1D743: "\u03be",
+	0x1D744: "\u03bf",
+	0x1D745: "\u03c0",
+	0x1D746: "\u03c1",
+	0x1D747: "\u03c3",
+	0x1D748: "\u03c3",
+	0x1D749: "\u03c4",
+	0x1D74A: "\u03c5",
+	0x1D74B: "\u03c6",
+	0x1D74C: "\u03c7",
+	0x1D74D: "\u03c8",
+	0x1D74E: "\u03c9",
+	0x1D750: "\u03b5",
+	0x1D751: "\u03b8",
+	0x1D752: "\u03ba",
+	0x1D753: "\u03c6",
+	0x1D754: "\u03c1",
+	0x1D755: "\u03c0",
+	0x1D756: "\u03b1",
+	0x1D757: "\u03b2",
+	0x1D758: "\u03b3",
+	0x1D759: "\u03b4",
+	0x1D75A: "\u03b5",
+	0x1D75B: "\u03b6",
+	0x1D75C: "\u03b7",
+	0x1D75D: "\u03b8",
+	0x1D75E: "\u03b9",
+	0x1D75F: "\u03ba",
+	0x1D760: "\u03bb",
+	0x1D761: "\u03bc",
+	0x1D762: "\u03bd",
+	0x1D763: "\u03be",
+	0x1D764: "\u03bf",
+	0x1D765: "\u03c0",
+	0x1D766: "\u03c1",
+	0x1D767: "\u03b8",
+	0x1D768: "\u03c3",
+	0x1D769: "\u03c4",
+	0x1D76A: "\u03c5",
+	0x1D76B: "\u03c6",
+	0x1D76C: "\u03c7",
+	0x1D76D: "\u03c8",
+	0x1D76E: "\u03c9",
+	0x1D770: "\u03b1",
+	0x1D771: "\u03b2",
+	0x1D772: "\u03b3",
+	0x1D773: "\u03b4",
+	0x1D774: "\u03b5",
+	0x1D775: "\u03b6",
+	0x1D776: "\u03b7",
+	0x1D777: "\u03b8",
+	0x1D778: "\u03b9",
+	0x1D779: "\u03ba",
+	0x1D77A: "\u03bb",
+	0x1D77B: "\u03bc",
+	0x1D77C: "\u03bd",
+	0x1D77D: "\u03be",
+	0x1D77E: "\u03bf",
+	0x1D77F: "\u03c0",
+	0x1D780: "\u03c1",
+	0x1D781: "\u03c3",
+	0x1D782: "\u03c3",
+	0x1D783: "\u03c4",
+	0x1D784: "\u03c5",
+	0x1D785: "\u03c6",
+	0x1D786: "\u03c7",
+	0x1D787: "\u03c8",
+	0x1D788: "\u03c9",
+	0x1D78A: "\u03b5",
+	0x1D78B: "\u03b8",
+	0x1D78C: "\u03ba",
+	0x1D78D: "\u03c6",
+	0x1D78E: "\u03c1",
+	0x1D78F: "\u03c0",
+	0x1D790: "\u03b1",
+	0x1D791: "\u03b2",
+	0x1D792: "\u03b3",
+	0x1D793: "\u03b4",
+	0x1D794: "\u03b5",
+	0x1D795: "\u03b6",
+	0x1D796: "\u03b7",
+	0x1D797: "\u03b8",
+	0x1D798: "\u03b9",
+	0x1D799: "\u03ba",
+	0x1D79A: "\u03bb",
+	0x1D79B: "\u03bc",
+	0x1D79C: "\u03bd",
+	0x1D79D: "\u03be",
+	0x1D79E: "\u03bf",
+	0x1D79F: "\u03c0",
+	0x1D7A0: "\u03c1",
+	0x1D7A1: "\u03b8",
+	0x1D7A2: "\u03c3",
+	0x1D7A3: "\u03c4",
+	0x1D7A4: "\u03c5",
+	0x1D7A5: "\u03c6",
+	0x1D7A6: "\u03c7",
+	0x1D7A7: "\u03c8",
+	0x1D7A8: "\u03c9",
+	0x1D7AA: "\u03b1",
+	0x1D7AB: "\u03b2",
+	0x1D7AC: "\u03b3",
+	0x1D7AD: "\u03b4",
+	0x1D7AE: "\u03b5",
+	0x1D7AF: "\u03b6",
+	0x1D7B0: "\u03b7",
+	0x1D7B1: "\u03b8",
+	0x1D7B2: "\u03b9",
+	0x1D7B3: "\u03ba",
+	0x1D7B4: "\u03bb",
+	0x1D7B5: "\u03bc",
+	0x1D7B6: "\u03bd",
+	0x1D7B7: "\u03be",
+	0x1D7B8: "\u03bf",
+	0x1D7B9: "\u03c0",
+	0x1D7BA: "\u03c1",
+	0x1D7BB: "\u03c3",
+	0x1D7BC: "\u03c3",
+	0x1D7BD: "\u03c4",
+	0x1D7BE: "\u03c5",
+	0x1D7BF: "\u03c6",
+	0x1D7C0: "\u03c7",
+	0x1D7C1: "\u03c8",
+	0x1D7C2: "\u03c9",
+	0x1D7C4: "\u03b5",
+	0x1D7C5: "\u03b8",
+	0x1D7C6: "\u03ba",
+	0x1D7C7: "\u03c6",
+	0x1D7C8: "\u03c1",
+	0x1D7C9: "\u03c0",
+	0x1D7CA: "\u03dd",
+	0x1D7CB: "\u03dd",
+	0x1D7CE: "0",
+	0x1D7CF: "1",
+	0x1D7D0: "2",
+	0x1D7D1: "3",
+	0x1D7D2: "4",
+	0x1D7D3: "5",
+	0x1D7D4: "6",
+	0x1D7D5: "7",
+	0x1D7D6: "8",
+	0x1D7D7: "9",
+	0x1D7D8: "0",
+	0x1D7D9: "1",
+	0x1D7DA: "2",
+	0x1D7DB: "3",
+	0x1D7DC: "4",
+	0x1D7DD: "5",
+	0x1D7DE: "6",
+	0x1D7DF: "7",
+	0x1D7E0: "8",
+	0x1D7E1: "9",
+	0x1D7E2: "0",
+	0x1D7E3: "1",
+	0x1D7E4: "2",
+	0x1D7E5: "3",
+	0x1D7E6: "4",
+	0x1D7E7: "5",
+	0x1D7E8: "6",
+	0x1D7E9: "7",
+	0x1D7EA: "8",
+	0x1D7EB: "9",
+	0x1D7EC: "0",
+	0x1D7ED: "1",
+	0x1D7EE: "2",
+	0x1D7EF: "3",
+	0x1D7F0: "4",
+	0x1D7F1: "5",
+	0x1D7F2: "6",
+	0x1D7F3: "7",
+	0x1D7F4: "8",
+	0x1D7F5: "9",
+	0x1D7F6: "0",
+	0x1D7F7: "1",
+	0x1D7F8: "2",
+	0x1D7F9: "3",
+	0x1D7FA: "4",
+	0x1D7FB: "5",
+	0x1D7FC: "6",
+	0x1D7FD: "7",
+	0x1D7FE: "8",
+	0x1D7FF: "9",
+	0x1E030: "\u0430",
+	0x1E031: "\u0431",
+	0x1E032: "\u0432",
+	0x1E033: "\u0433",
+	0x1E034: "\u0434",
+	0x1E035: "\u0435",
+	0x1E036: "\u0436",
+	0x1E037: "\u0437",
+	0x1E038: "\u0438",
+	0x1E039: "\u043a",
+	0x1E03A: "\u043b",
+	0x1E03B: "\u043c",
+	0x1E03C: "\u043e",
+	0x1E03D: "\u043f",
+	0x1E03E: "\u0440",
+	0x1E03F: "\u0441",
+	0x1E040: "\u0442",
+	0x1E041: "\u0443",
+	0x1E042: "\u0444",
+	0x1E043: "\u0445",
+	0x1E044: "\u0446",
+	0x1E045: "\u0447",
+	0x1E046: "\u0448",
+	0x1E047: "\u044b",
+	0x1E048: "\u044d",
+	0x1E049: "\u044e",
+	0x1E04A: "\ua689",
+	0x1E04B: "\u04d9",
+	0x1E04C: "\u0456",
+	0x1E04D: "\u0458",
+	0x1E04E: "\u04e9",
+	0x1E04F: "\u04af",
+	0x1E050: "\u04cf",
+	0x1E051: "\u0430",
+	0x1E052: "\u0431",
+	0x1E053: "\u0432",
+	0x1E054: "\u0433",
+	0x1E055: "\u0434",
+	0x1E056: "\u0435",
+	0x1E057: "\u0436",
+	0x1E058: "\u0437",
+	0x1E059: "\u0438",
+	0x1E05A: "\u043a",
+	0x1E05B: "\u043b",
+	0x1E05C: "\u043e",
+	0x1E05D: "\u043f",
+	0x1E05E: "\u0441",
+	0x1E05F: "\u0443",
+	0x1E060: "\u0444",
+	0x1E061: "\u0445",
+	0x1E062: "\u0446",
+	0x1E063: "\u0447",
+	0x1E064: "\u0448",
+	0x1E065: "\u044a",
+	0x1E066: "\u044b",
+	0x1E067: "\u0491",
+	0x1E068: "\u0456",
+	0x1E069: "\u0455",
+	0x1E06A: "\u045f",
+	0x1E06B: "\u04ab",
+	0x1E06C: "\ua651",
+	0x1E06D: "\u04b1",
+	0x1E900: "\U0001e922",
+	0x1E901: "\U0001e923",
+	0x1E902: "\U0001e924",
+	0x1E903: "\U0001e925",
+	0x1E904: "\U0001e926",
+	0x1E905: "\U0001e927",
+	0x1E906: "\U0001e928",
+	0x1E907: "\U0001e929",
+	0x1E908: "\U0001e92a",
+	0x1E909: "\U0001e92b",
+	0x1E90A: "\U0001e92c",
+	0x1E90B: "\U0001e92d",
+	0x1E90C: "\U0001e92e",
+	0x1E90D: "\U0001e92f",
+	0x1E90E: "\U0001e930",
+	0x1E90F: "\U0001e931",
+	0x1E910: "\U0001e932",
+	0x1E911: "\U0001e933",
+	0x1E912: "\U0001e934",
+	0x1E913: "\U0001e935",
+	0x1E914: "\U0001e936",
+	0x1E915: "\U0001e937",
+	0x1E916: "\U0001e938",
+	0x1E917: "\U0001e939",
+	0x1E918: "\U0001e93a",
+	0x1E919: "\U0001e93b",
+	0x1E91A: "\U0001e93c",
+	0x1E91B: "\U0001e93d",
+	0x1E91C: "\U0001e93e",
+	0x1E91D: "\U0001e93f",
+	0x1E91E: "\U0001e940",
+	0x1E91F: "\U0001e941",
+	0x1E920: "\U0001e942",
+	0x1E921: "\U0001e943",
+	0x1EE00: "\u0627",
+	0x1EE01: "\u0628",
+	0x1EE02: "\u062c",
+	0x1EE03: "\u062f",
+	0x1EE05: "\u0648",
+	0x1EE06: "\u0632",
+	0x1EE07: "\u062d",
+	0x1EE08: "\u0637",
+	0x1EE09: "\u064a",
+	0x1EE0A: "\u0643",
+	0x1EE0B: "\u0644",
+	0x1EE0C: "\u0645",
+	0x1EE0D: "\u0646",
+	0x1EE0E: "\u0633",
+	0x1EE0F: "\u0639",
+	0x1EE10: "\u0641",
+	0x1EE11: "\u0635",
+	0x1EE12: "\u0642",
+	0x1EE13: "\u0631",
+	0x1EE14: "\u0634",
+	0x1EE15: "\u062a",
+	0x1EE16: "\u062b",
+	0x1EE17: "\u062e",
+	0x1EE18: "\u0630",
+	0x1EE19: "\u0636",
+	0x1EE1A: "\u0638",
+	0x1EE1B: "\u063a",
+	0x1EE1C: "\u066e",
+	0x1EE1D: "\u06ba",
+	0x1EE1E: "\u06a1",
+	0x1EE1F: "\u066f",
+	0x1EE21: "\u0628",
+	0x1EE22: "\u062c",
+	0x1EE24: "\u0647",
+	0x1EE27: "\u062d",
+	0x1EE29: "\u064a",
+	0x1EE2A: "\u0643",
+	0x1EE2B: "\u0644",
+	0x1EE2C: "\u0645",
+	0x1EE2D: "\u0646",
+	0x1EE2E: "\u0633",
+	0x1EE2F: "\u0639",
+	0x1EE30: "\u0641",
+	0x1EE31: "\u0635",
+	0x1EE32: "\u0642",
+	0x1EE34: "\u0634",
+	0x1EE35: "\u062a",
+	0x1EE36: "\u062b",
+	0x1EE37: "\u062e",
+	0x1EE39: "\u0636",
+	0x1EE3B: "\u063a",
+	0x1EE42: "\u062c",
+	0x1EE47: "\u062d",
+	0x1EE49: "\u064a",
+	0x1EE4B: "\u0644",
+	0x1EE4D: "\u0646",
+	0x1EE4E: "\u0633",
+	0x1EE4F: "\u0639",
+	0x1EE51: "\u0635",
+	0x1EE52: "\u0642",
+	0x1EE54: "\u0634",
+	0x1EE57: "\u062e",
+	0x1EE59: "\u0636",
+	0x1EE5B: "\u063a",
+	0x1EE5D: "\u06ba",
+	0x1EE5F: "\u066f",
+	0x1EE61: "\u0628",
+	0x1EE62: "\u062c",
+	0x1EE64: "\u0647",
+	0x1EE67: "\u062d",
+	0x1EE68: "\u0637",
+	0x1EE69: "\u064a",
+	0x1EE6A: "\u0643",
+	0x1EE6C: "\u0645",
+	0x1EE6D: "\u0646",
+	0x1EE6E: "\u0633",
+	0x1EE6F: "\u0639",
+	0x1EE70: "\u0641",
+	0x1EE71: "\u0635",
+	0x1EE72: "\u0642",
+	0x1EE74: "\u0634",
+	0x1EE75: "\u062a",
+	0x1EE76: "\u062b",
+	0x1EE77: "\u062e",
+	0x1EE79: "\u0636",
+	0x1EE7A: "\u0638",
+	0x1EE7B: "\u063a",
+	0x1EE7C: "\u066e",
+	0x1EE7E: "\u06a1",
+	0x1EE80: "\u0627",
+	0x1EE81: "\u0628",
+	0x1EE82: "\u062c",
+	0x1EE83: "\u062f",
+	0x1EE84: "\u0647",
+	0x1EE85: "\u0648",
+	0x1EE86: "\u0632",
+	0x1EE87: "\u062d",
+	0x1EE88: "\u0637",
+	0x1EE89: "\u064a",
+	0x1EE8B: "\u0644",
+	0x1EE8C: "\u0645",
+	0x1EE8D: "\u0646",
+	0x1EE8E: "\u0633",
+	0x1EE8F: "\u0639",
+	0x1EE90: "\u0641",
+	0x1EE91: "\u0635",
+	0x1EE92: "\u0642",
+	0x1EE93: "\u0631",
+	0x1EE94: "\u0634",
+	0x1EE95: "\u062a",
+	0x1EE96: "\u062b",
+	0x1EE97: "\u062e",
+	0x1EE98: "\u0630",
+	0x1EE99: "\u0636",
+	0x1EE9A: "\u0638",
+	0x1EE9B: "\u063a",
+	0x1EEA1: "\u0628",
+	0x1EEA2: "\u062c",
+	0x1EEA3: "\u062f",
+	0x1EEA5: "\u0648",
+	0x1EEA6: "\u0632",
+	0x1EEA7: "\u062d",
+	0x1EEA8: "\u0637",
+	0x1EEA9: "\u064a",
+	0x1EEAB: "\u0644",
+	0x1EEAC: "\u0645",
+	0x1EEAD: "\u0646",
+	0x1EEAE: "\u0633",
+	0x1EEAF: "\u0639",
+	0x1EEB0: "\u0641",
+	0x1EEB1: "\u0635",
+	0x1EEB2: "\u0642",
+	0x1EEB3: "\u0631",
+	0x1EEB4: "\u0634",
+	0x1EEB5: "\u062a",
+	0x1EEB6: "\u062b",
+	0x1EEB7: "\u062e",
+	0x1EEB8: "\u0630",
+	0x1EEB9: "\u0636",
+	0x1EEBA: "\u0638",
+	0x1EEBB: "\u063a",
+	0x1F12B: "c",
+	0x1F12C: "r",
+	0x1F12D: "cd",
+	0x1F12E: "wz",
+	0x1F130: "a",
+	0x1F131: "b",
+	0x1F132: "c",
+	0x1F133: "d",
+	0x1F134: "e",
+	0x1F135: "f",
+	0x1F136: "g",
+	0x1F137: "h",
+	0x1F138: "i",
+	0x1F139: "j",
+	0x1F13A: "k",
+	0x1F13B: "l",
+	0x1F13C: "m",
+	0x1F13D: "n",
+	0x1F13E: "o",
+	0x1F13F: "p",
+	0x1F140: "q",
+	0x1F141: "r",
+	0x1F142: "s",
+	0x1F143: "t",
+	0x1F144: "u",
+	0x1F145: "v",
+	0x1F146: "w",
+	0x1F147: "x",
+	0x1F148: "y",
+	0x1F149: "z",
+	0x1F14A: "hv",
+	0x1F14B: "mv",
+	0x1F14C: "sd",
+	0x1F14D: "ss",
+	0x1F14E: "ppv",
+	0x1F14F: "wc",
+	0x1F16A: "mc",
+	0x1F16B: "md",
+	0x1F16C: "mr",
+	0x1F190: "dj",
+	0x1F200: "\u307b\u304b",
+	0x1F201: "\u30b3\u30b3",
+	0x1F202: "\u30b5",
+	0x1F210: "\u624b",
+	0x1F211: "\u5b57",
+	0x1F212: "\u53cc",
+	0x1F213: "\u30c7",
+	0x1F214: "\u4e8c",
+	0x1F215: "\u591a",
+	0x1F216: "\u89e3",
+	0x1F217: "\u5929",
+	0x1F218: "\u4ea4",
+	0x1F219: "\u6620",
+	0x1F21A: "\u7121",
+	0x1F21B: "\u6599",
+	0x1F21C: "\u524d",
+	0x1F21D: "\u5f8c",
+	0x1F21E: "\u518d",
+	0x1F21F: "\u65b0",
+	0x1F220: "\u521d",
+	0x1F221: "\u7d42",
+	0x1F222: "\u751f",
+	0x1F223: "\u8ca9",
+	0x1F224: "\u58f0",
+	0x1F225: "\u5439",
+	0x1F226: "\u6f14",
+	0x1F227: "\u6295",
+	0x1F228: "\u6355",
+	0x1F229: "\u4e00",
+	0x1F22A: "\u4e09",
+	0x1F22B: "\u904a",
+	0x1F22C: "\u5de6",
+	0x1F22D: "\u4e2d",
+	0x1F22E: "\u53f3",
+	0x1F22F: "\u6307",
+	0x1F230: "\u8d70",
+	0x1F231: "\u6253",
+	0x1F232: "\u7981",
+	0x1F233: "\u7a7a",
+	0x1F234: "\u5408",
+	0x1F235: "\u6e80",
+	0x1F236: "\u6709",
+	0x1F237: "\u6708",
+	0x1F238: "\u7533",
+	0x1F239: "\u5272",
+	0x1F23A: "\u55b6",
+	0x1F23B: "\u914d",
+	0x1F250: "\u5f97",
+	0x1F251: "\u53ef",
+	0x1FBF0: "0",
+	0x1FBF1: "1",
+	0x1FBF2: "2",
+	0x1FBF3: "3",
+	0x1FBF4: "4",
+	0x1FBF5: "5",
+	0x1FBF6: "6",
+	0x1FBF7: "7",
+	0x1FBF8: "8",
+	0x1FBF9: "9",
+	0x2F800: "\u4e3d",
+	0x2F801: "\u4e38",
+	0x2F802: "\u4e41",
+	0x2F803: "\U00020122",
+	0x2F804: "\u4f60",
+	0x2F805: "\u4fae",
+	0x2F806: "\u4fbb",
+	0x2F807: "\u5002",
+	0x2F808: "\u507a",
+	0x2F809: "\u5099",
+	0x2F80A: "\u50e7",
+	0x2F80B: "\u50cf",
+	0x2F80C: "\u349e",
+	0x2F80D: "\U0002063a",
+	0x2F80E: "\u514d",
+	0x2F80F: "\u5154",
+	0x2F810: "\u5164",
+	0x2F811: "\u5177",
+	0x2F812: "\U0002051c",
+	0x2F813: "\u34b9",
+	0x2F814: "\u5167",
+	0x2F815: "\u518d",
+	0x2F816: "\U0002054b",
+	0x2F817: "\u5197",
+	0x2F818: "\u51a4",
+	0x2F819: "\u4ecc",
+	0x2F81A: "\u51ac",
+	0x2F81B: "\u51b5",
+	0x2F81C: "\U000291df",
+	0x2F81D: "\u51f5",
+	0x2F81E: "\u5203",
+	0x2F81F: "\u34df",
+	0x2F820: "\u523b",
+	0x2F821: "\u5246",
+	0x2F822: "\u5272",
+	0x2F823: "\u5277",
+	0x2F824: "\u3515",
+	0x2F825: "\u52c7",
+	0x2F826: "\u52c9",
+	0x2F827: "\u52e4",
+	0x2F828: "\u52fa",
+	0x2F829: "\u5305",
+	0x2F82A: "\u5306",
+	0x2F82B: "\u5317",
+	0x2F82C: "\u5349",
+	0x2F82D: "\u5351",
+	0x2F82E: "\u535a",
+	0x2F82F: "\u5373",
+	0x2F830: "\u537d",
+	0x2F831: "\u537f",
+	0x2F832: "\u537f",
+	0x2F833: "\u537f",
+	0x2F834: "\U00020a2c",
+	0x2F835: "\u7070",
+	0x2F836: "\u53ca",
+	0x2F837: "\u53df",
+	0x2F838: "\U00020b63",
+	0x2F839: "\u53eb",
+	0x2F83A: "\u53f1",
+	0x2F83B: "\u5406",
+	0x2F83C: "\u549e",
+	0x2F83D: "\u5438",
+	0x2F83E: "\u5448",
+	0x2F83F: "\u5468",
+	0x2F840: "\u54a2",
+	0x2F841: "\u54f6",
+	0x2F842: "\u5510",
+	0x2F843: "\u5553",
+	0x2F844: "\u5563",
+	0x2F845: "\u5584",
+	0x2F846: "\u5584",
+	0x2F847: "\u5599",
+	0x2F848: "\u55ab",
+	0x2F849: "\u55b3",
+	0x2F84A: "\u55c2",
+	0x2F84B: "\u5716",
+	0x2F84C: "\u5606",
+	0x2F84D: "\u5717",
+	0x2F84E: "\u5651",
+	0x2F84F: "\u5674",
+	0x2F850: "\u5207",
+	0x2F851: "\u58ee",
+	0x2F852: "\u57ce",
+	0x2F853: "\u57f4",
+	0x2F854: "\u580d",
+	0x2F855: "\u578b",
+	0x2F856: "\u5832",
+	0x2F857: "\u5831",
+	0x2F858: "\u58ac",
+	0x2F859: "\U000214e4",
+	0x2F85A: "\u58f2",
+	0x2F85B: "\u58f7",
+	0x2F85C: "\u5906",
+	0x2F85D: "\u591a",
+	0x2F85E: "\u5922",
+	0x2F85F: "\u5962",
+	0x2F860: "\U000216a8",
+	0x2F861: "\U000216ea",
+	0x2F862: "\u59ec",
+	0x2F863: "\u5a1b",
+	0x2F864: "\u5a27",
+	0x2F865: "\u59d8",
+	0x2F866: "\u5a66",
+	0x2F867: "\u36ee",
+	0x2F869: "\u5b08",
+	0x2F86A: "\u5b3e",
+	0x2F86B: "\u5b3e",
+	0x2F86C: "\U000219c8",
+	0x2F86D: "\u5bc3",
+	0x2F86E: "\u5bd8",
+	0x2F86F: "\u5be7",
+	0x2F870: "\u5bf3",
+	0x2F871: "\U00021b18",
+	0x2F872: "\u5bff",
+	0x2F873: "\u5c06",
+	0x2F875: "\u5c22",
+	0x2F876: "\u3781",
+	0x2F877: "\u5c60",
+	0x2F878: "\u5c6e",
+	0x2F879: "\u5cc0",
+	0x2F87A: "\u5c8d",
+	0x2F87B: "\U00021de4",
+	0x2F87C: "\u5d43",
+	0x2F87D: "\U00021de6",
+	0x2F87E: "\u5d6e",
+	0x2F87F: "\u5d6b",
+	0x2F880: "\u5d7c",
+	0x2F881: "\u5de1",
+	0x2F882: "\u5de2",
+	0x2F883: "\u382f",
+	0x2F884: "\u5dfd",
+	0x2F885: "\u5e28",
+	0x2F886: "\u5e3d",
+	0x2F887: "\u5e69",
+	0x2F888: "\u3862",
+	0x2F889: "\U00022183",
+	0x2F88A: "\u387c",
+	0x2F88B: "\u5eb0",
+	0x2F88C: "\u5eb3",
+	0x2F88D: "\u5eb6",
+	0x2F88E: "\u5eca",
+	0x2F88F: "\U0002a392",
+	0x2F890: "\u5efe",
+	0x2F891: "\U00022331",
+	0x2F892: "\U00022331",
+	0x2F893: "\u8201",
+	0x2F894: "\u5f22",
+	0x2F895: "\u5f22",
+	0x2F896: "\u38c7",
+	0x2F897: "\U000232b8",
+	0x2F898: "\U000261da",
+	0x2F899: "\u5f62",
+	0x2F89A: "\u5f6b",
+	0x2F89B: "\u38e3",
+	0x2F89C: "\u5f9a",
+	0x2F89D: "\u5fcd",
+	0x2F89E: "\u5fd7",
+	0x2F89F: "\u5ff9",
+	0x2F8A0: "\u6081",
+	0x2F8A1: "\u393a",
+	0x2F8A2: "\u391c",
+	0x2F8A3: "\u6094",
+	0x2F8A4: "\U000226d4",
+	0x2F8A5: "\u60c7",
+	0x2F8A6: "\u6148",
+	0x2F8A7: "\u614c",
+	0x2F8A8: "\u614e",
+	0x2F8A9: "\u614c",
+	0x2F8AA: "\u617a",
+	0x2F8AB: "\u618e",
+	0x2F8AC: "\u61b2",
+	0x2F8AD: "\u61a4",
+	0x2F8AE: "\u61af",
+	0x2F8AF: "\u61de",
+	0x2F8B0: "\u61f2",
+	0x2F8B1: "\u61f6",
+	0x2F8B2: "\u6210",
+	0x2F8B3: "\u621b",
+	0x2F8B4: "\u625d",
+	0x2F8B5: "\u62b1",
+	0x2F8B6: "\u62d4",
+	0x2F8B7: "\u6350",
+	0x2F8B8: "\U00022b0c",
+	0x2F8B9: "\u633d",
+	0x2F8BA: "\u62fc",
+	0x2F8BB: "\u6368",
+	0x2F8BC: "\u6383",
+	0x2F8BD: "\u63e4",
+	0x2F8BE: "\U00022bf1",
+	0x2F8BF: "\u6422",
+	0x2F8C0: "\u63c5",
+	0x2F8C1: "\u63a9",
+	0x2F8C2: "\u3a2e",
+	0x2F8C3: "\u6469",
+	0x2F8C4: "\u647e",
+	0x2F8C5: "\u649d",
+	0x2F8C6: "\u6477",
+	0x2F8C7: "\u3a6c",
+	0x2F8C8: "\u654f",
+	0x2F8C9: "\u656c",
+	0x2F8CA: "\U0002300a",
+	0x2F8CB: "\u65e3",
+	0x2F8CC: "\u66f8",
+	0x2F8CD: "\u6649",
+	0x2F8CE: "\u3b19",
+	0x2F8CF: "\u6691",
+	0x2F8D0: "\u3b08",
+	0x2F8D1: "\u3ae4",
+	0x2F8D2: "\u5192",
+	0x2F8D3: "\u5195",
+	0x2F8D4: "\u6700",
+	0x2F8D5: "\u669c",
+	0x2F8D6: "\u80ad",
+	0x2F8D7: "\u43d9",
+	0x2F8D8: "\u6717",
+	0x2F8D9: "\u671b",
+	0x2F8DA: "\u6721",
+	0x2F8DB: "\u675e",
+	0x2F8DC: "\u6753",
+	0x2F8DD: "\U000233c3",
+	0x2F8DE: "\u3b49",
+	0x2F8DF: "\u67fa",
+	0x2F8E0: "\u6785",
+	0x2F8E1: "\u6852",
+	0x2F8E2: "\u6885",
+	0x2F8E3: "\U0002346d",
+	0x2F8E4: "\u688e",
+	0x2F8E5: "\u681f",
+	0x2F8E6: "\u6914",
+	0x2F8E7: "\u3b9d",
+	0x2F8E8: "\u6942",
+	0x2F8E9: "\u69a3",
+	0x2F8EA: "\u69ea",
+	0x2F8EB: "\u6aa8",
+	0x2F8EC: "\U000236a3",
+	0x2F8ED: "\u6adb",
+	0x2F8EE: "\u3c18",
+	0x2F8EF: "\u6b21",
+	0x2F8F0: "\U000238a7",
+	0x2F8F1: "\u6b54",
+	0x2F8F2: "\u3c4e",
+	0x2F8F3: "\u6b72",
+	0x2F8F4: "\u6b9f",
+	0x2F8F5: "\u6bba",
+	0x2F8F6: "\u6bbb",
+	0x2F8F7: "\U00023a8d",
+	0x2F8F8: "\U00021d0b",
+	0x2F8F9: "\U00023afa",
+	0x2F8FA: "\u6c4e",
+	0x2F8FB: "\U00023cbc",
+	0x2F8FC: "\u6cbf",
+	0x2F8FD: "\u6ccd",
+	0x2F8FE: "\u6c67",
+	0x2F8FF: "\u6d16",
+	0x2F900: "\u6d3e",
+	0x2F901: "\u6d77",
+	0x2F902: "\u6d41",
+	0x2F903: "\u6d69",
+	0x2F904: "\u6d78",
+	0x2F905: "\u6d85",
+	0x2F906: "\U00023d1e",
+	0x2F907: "\u6d34",
+	0x2F908: "\u6e2f",
+	0x2F909: "\u6e6e",
+	0x2F90A: "\u3d33",
+	0x2F90B: "\u6ecb",
+	0x2F90C: "\u6ec7",
+	0x2F90D: "\U00023ed1",
+	0x2F90E: "\u6df9",
+	0x2F90F: "\u6f6e",
+	0x2F910: "\U00023f5e",
+	0x2F911: "\U00023f8e",
+	0x2F912: "\u6fc6",
+	0x2F913: "\u7039",
+	0x2F914: "\u701e",
+	0x2F915: "\u701b",
+	0x2F916: "\u3d96",
+	0x2F917: "\u704a",
+	0x2F918: "\u707d",
+	0x2F919: "\u7077",
+	0x2F91A: "\u70ad",
+	0x2F91B: "\U00020525",
+	0x2F91C: "\u7145",
+	0x2F91D: "\U00024263",
+	0x2F91E: "\u719c",
+	0x2F920: "\u7228",
+	0x2F921: "\u7235",
+	0x2F922: "\u7250",
+	0x2F923: "\U00024608",
+	0x2F924: "\u7280",
+	0x2F925: "\u7295",
+	0x2F926: "\U00024735",
+	0x2F927: "\U00024814",
+	0x2F928: "\u737a",
+	0x2F929: "\u738b",
+	0x2F92A: "\u3eac",
+	0x2F92B: "\u73a5",
+	0x2F92C: "\u3eb8",
+	0x2F92D: "\u3eb8",
+	0x2F92E: "\u7447",
+	0x2F92F: "\u745c",
+	0x2F930: "\u7471",
+	0x2F931: "\u7485",
+	0x2F932: "\u74ca",
+	0x2F933: "\u3f1b",
+	0x2F934: "\u7524",
+	0x2F935: "\U00024c36",
+	0x2F936: "\u753e",
+	0x2F937: "\U00024c92",
+	0x2F938: "\u7570",
+	0x2F939: "\U0002219f",
+	0x2F93A: "\u7610",
+	0x2F93B: "\U00024fa1",
+	0x2F93C: "\U00024fb8",
+	0x2F93D: "\U00025044",
+	0x2F93E: "\u3ffc",
+	0x2F93F: "\u4008",
+	0x2F940: "\u76f4",
+	0x2F941: "\U000250f3",
+	0x2F942: "\U000250f2",
+	0x2F943: "\U00025119",
+	0x2F944: "\U00025133",
+	0x2F945: "\u771e",
+	0x2F946: "\u771f",
+	0x2F947: "\u771f",
+	0x2F948: "\u774a",
+	0x2F949: "\u4039",
+	0x2F94A: "\u778b",
+	0x2F94B: "\u4046",
+	0x2F94C: "\u4096",
+	0x2F94D: "\U0002541d",
+	0x2F94E: "\u784e",
+	0x2F94F: "\u788c",
+	0x2F950: "\u78cc",
+	0x2F951: "\u40e3",
+	0x2F952: "\U00025626",
+	0x2F953: "\u7956",
+	0x2F954: "\U0002569a",
+	0x2F955: "\U000256c5",
+	0x2F956: "\u798f",
+	0x2F957: "\u79eb",
+	0x2F958: "\u412f",
+	0x2F959: "\u7a40",
+	0x2F95A: "\u7a4a",
+	0x2F95B: "\u7a4f",
+	0x2F95C: "\U0002597c",
+	0x2F95D: "\U00025aa7",
+	0x2F95E: "\U00025aa7",
+	0x2F960: "\u4202",
+	0x2F961: "\U00025bab",
+	0x2F962: "\u7bc6",
+	0x2F963: "\u7bc9",
+	0x2F964: "\u4227",
+	0x2F965: "\U00025c80",
+	0x2F966: "\u7cd2",
+	0x2F967: "\u42a0",
+	0x2F968: "\u7ce8",
+	0x2F969: "\u7ce3",
+	0x2F96A: "\u7d00",
+	0x2F96B: "\U00025f86",
+	0x2F96C: "\u7d63",
+	0x2F96D: "\u4301",
+	0x2F96E: "\u7dc7",
+	0x2F96F: "\u7e02",
+	0x2F970: "\u7e45",
+	0x2F971: "\u4334",
+	0x2F972: "\U00026228",
+	0x2F973: "\U00026247",
+	0x2F974: "\u4359",
+	0x2F975: "\U000262d9",
+	0x2F976: "\u7f7a",
+	0x2F977: "\U0002633e",
+	0x2F978: "\u7f95",
+	0x2F979: "\u7ffa",
+	0x2F97A: "\u8005",
+	0x2F97B: "\U000264da",
+	0x2F97C: "\U00026523",
+	0x2F97D: "\u8060",
+	0x2F97E: "\U000265a8",
+	0x2F97F: "\u8070",
+	0x2F980: "\U0002335f",
+	0x2F981: "\u43d5",
+	0x2F982: "\u80b2",
+	0x2F983: "\u8103",
+	0x2F984: "\u440b",
+	0x2F985: "\u813e",
+	0x2F986: "\u5ab5",
+	0x2F987: "\U000267a7",
+	0x2F988: "\U000267b5",
+	0x2F989: "\U00023393",
+	0x2F98A: "\U0002339c",
+	0x2F98B: "\u8201",
+	0x2F98C: "\u8204",
+	0x2F98D: "\u8f9e",
+	0x2F98E: "\u446b",
+	0x2F98F: "\u8291",
+	0x2F990: "\u828b",
+	0x2F991: "\u829d",
+	0x2F992: "\u52b3",
+	0x2F993: "\u82b1",
+	0x2F994: "\u82b3",
+	0x2F995: "\u82bd",
+	0x2F996: "\u82e6",
+	0x2F997: "\U00026b3c",
+	0x2F998: "\u82e5",
+	0x2F999: "\u831d",
+	0x2F99A: "\u8363",
+	0x2F99B: "\u83ad",
+	0x2F99C: "\u8323",
+	0x2F99D: "\u83bd",
+	0x2F99E: "\u83e7",
+	0x2F99F: "\u8457",
+	0x2F9A0: "\u8353",
+	0x2F9A1: "\u83ca",
+	0x2F9A2: "\u83cc",
+	0x2F9A3: "\u83dc",
+	0x2F9A4: "\U00026c36",
+	0x2F9A5: "\U00026d6b",
+	0x2F9A6: "\U00026cd5",
+	0x2F9A7: "\u452b",
+	0x2F9A8: "\u84f1",
+	0x2F9A9: "\u84f3",
+	0x2F9AA: "\u8516",
+	0x2F9AB: "\U000273ca",
+	0x2F9AC: "\u8564",
+	0x2F9AD: "\U00026f2c",
+	0x2F9AE: "\u455d",
+	0x2F9AF: "\u4561",
+	0x2F9B0: "\U00026fb1",
+	0x2F9B1: "\U000270d2",
+	0x2F9B2: "\u456b",
+	0x2F9B3: "\u8650",
+	0x2F9B4: "\u865c",
+	0x2F9B5: "\u8667",
+	0x2F9B6: "\u8669",
+	0x2F9B7: "\u86a9",
+	0x2F9B8: "\u8688",
+	0x2F9B9: "\u870e",
+	0x2F9BA: "\u86e2",
+	0x2F9BB: "\u8779",
+	0x2F9BC: "\u8728",
+	0x2F9BD: "\u876b",
+	0x2F9BE: "\u8786",
+	0x2F9C0: "\u87e1",
+	0x2F9C1: "\u8801",
+	0x2F9C2: "\u45f9",
+	0x2F9C3: "\u8860",
+	0x2F9C4: "\u8863",
+	0x2F9C5: "\U00027667",
+	0x2F9C6: "\u88d7",
+	0x2F9C7: "\u88de",
+	0x2F9C8: "\u4635",
+	0x2F9C9: "\u88fa",
+	0x2F9CA: "\u34bb",
+	0x2F9CB: "\U000278ae",
+	0x2F9CC: "\U00027966",
+	0x2F9CD: "\u46be",
+	0x2F9CE: "\u46c7",
+	0x2F9CF: "\u8aa0",
+	0x2F9D0: "\u8aed",
+	0x2F9D1: "\u8b8a",
+	0x2F9D2: "\u8c55",
+	0x2F9D3: "\U00027ca8",
+	0x2F9D4: "\u8cab",
+	0x2F9D5: "\u8cc1",
+	0x2F9D6: "\u8d1b",
+	0x2F9D7: "\u8d77",
+	0x2F9D8: "\U00027f2f",
+	0x2F9D9: "\U00020804",
+	0x2F9DA: "\u8dcb",
+	0x2F9DB: "\u8dbc",
+	0x2F9DC: "\u8df0",
+	0x2F9DD: "\U000208de",
+	0x2F9DE: "\u8ed4",
+	0x2F9DF: "\u8f38",
+	0x2F9E0: "\U000285d2",
+	0x2F9E1: "\U000285ed",
+	0x2F9E2: "\u9094",
+	0x2F9E3: "\u90f1",
+	0x2F9E4: "\u9111",
+	0x2F9E5: "\U0002872e",
+	0x2F9E6: "\u911b",
+	0x2F9E7: "\u9238",
+	0x2F9E8: "\u92d7",
+	0x2F9E9: "\u92d8",
+	0x2F9EA: "\u927c",
+	0x2F9EB: "\u93f9",
+	0x2F9EC: "\u9415",
+	0x2F9ED: "\U00028bfa",
+	0x2F9EE: "\u958b",
+	0x2F9EF: "\u4995",
+	0x2F9F0: "\u95b7",
+	0x2F9F1: "\U00028d77",
+	0x2F9F2: "\u49e6",
+	0x2F9F3: "\u96c3",
+	0x2F9F4: "\u5db2",
+	0x2F9F5: "\u9723",
+	0x2F9F6: "\U00029145",
+	0x2F9F7: "\U0002921a",
+	0x2F9F8: "\u4a6e",
+	0x2F9F9: "\u4a76",
+	0x2F9FA: "\u97e0",
+	0x2F9FB: "\U0002940a",
+	0x2F9FC: "\u4ab2",
+	0x2F9FD: "\U00029496",
+	0x2F9FE: "\u980b",
+	0x2F9FF: "\u980b",
+	0x2FA00: "\u9829",
+	0x2FA01: "\U000295b6",
+	0x2FA02: "\u98e2",
+	0x2FA03: "\u4b33",
+	0x2FA04: "\u9929",
+	0x2FA05: "\u99a7",
+	0x2FA06: "\u99c2",
+	0x2FA07: "\u99fe",
+	0x2FA08: "\u4bce",
+	0x2FA09: "\U00029b30",
+	0x2FA0A: "\u9b12",
+	0x2FA0B: "\u9c40",
+	0x2FA0C: "\u9cfd",
+	0x2FA0D: "\u4cce",
+	0x2FA0E: "\u4ced",
+	0x2FA0F: "\u9d67",
+	0x2FA10: "\U0002a0ce",
+	0x2FA11: "\u4cf8",
+	0x2FA12: "\U0002a105",
+	0x2FA13: "\U0002a20e",
+	0x2FA14: "\U0002a291",
+	0x2FA15: "\u9ebb",
+	0x2FA16: "\u4d56",
+	0x2FA17: "\u9ef9",
+	0x2FA18: "\u9efe",
+	0x2FA19: "\u9f05",
+	0x2FA1A: "\u9f0f",
+	0x2FA1B: "\u9f16",
+	0x2FA1C: "\u9f3b",
+	0x2FA1D: "\U0002a600",
+}
+
+// fencedCharacters are allowed within a label but not at its start or end,
+// or next to each other
+var fencedCharacters = map[rune]string{
+	0x00B7: "middle dot",
+	0x05F3: "Hebrew geresh",
+	0x05F4: "Hebrew gershayim",
+	0x2019: "apostrophe",
+	0x2027: "hyphenation point",
+	0x2044: "fraction slash",
+	0x30FB: "katakana middle dot",
+}
+
+// scriptGroups are the groups of characters that can be used together in
+// a label, in order of preference
+var scriptGroups = []*scriptGroup{
+	{name: "Latin", primary: normalizationTable4, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Adlam", primary: normalizationTable6, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Ahom", primary: normalizationTable7, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Anatolian_Hieroglyphs", primary: normalizationTable8, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Arabic", primary: normalizationTable9, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Armenian", primary: normalizationTable10, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Avestan", primary: normalizationTable11, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Balinese", primary: normalizationTable12, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Bamum", primary: normalizationTable13, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Bassa_Vah", primary: normalizationTable14, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Batak", primary: normalizationTable15, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Bengali", primary: normalizationTable16, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Bhaiksuki", primary: normalizationTable17, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Bopomofo", primary: normalizationTable18, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Brahmi", primary: normalizationTable19, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Buginese", primary: normalizationTable20, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Buhid", primary: normalizationTable21, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Canadian_Aboriginal", primary: normalizationTable22, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Carian", primary: normalizationTable23, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Caucasian_Albanian", primary: normalizationTable24, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Chakma", primary: normalizationTable25, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Cham", primary: normalizationTable26, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Cherokee", primary: normalizationTable27, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Chorasmian", primary: normalizationTable28, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Coptic", primary: normalizationTable29, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Cuneiform", primary: normalizationTable30, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Cypriot", primary: normalizationTable31, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Cypro_Minoan", primary: normalizationTable32, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Cyrillic", primary: normalizationTable33, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Deseret", primary: normalizationTable34, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Devanagari", primary: normalizationTable35, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Dives_Akuru", primary: normalizationTable36, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Dogra", primary: normalizationTable37, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Duployan", primary: normalizationTable38, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Egyptian_Hieroglyphs", primary: normalizationTable39, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Elbasan", primary: normalizationTable40, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Elymaic", primary: normalizationTable41, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Ethiopic", primary: normalizationTable42, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Georgian", primary: normalizationTable43, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Glagolitic", primary: normalizationTable44, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Gothic", primary: normalizationTable45, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Grantha", primary: normalizationTable46, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Greek", primary: normalizationTable47, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Gujarati", primary: normalizationTable48, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Gunjala_Gondi", primary: normalizationTable49, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Gurmukhi", primary: normalizationTable50, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Han", primary: normalizationTable51, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Hangul", primary: normalizationTable52, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Hanifi_Rohingya", primary: normalizationTable53, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Hanunoo", primary: normalizationTable54, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Hatran", primary: normalizationTable55, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Hebrew", primary: normalizationTable56, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Hiragana", primary: normalizationTable57, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Imperial_Aramaic", primary: normalizationTable58, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Inscriptional_Pahlavi", primary: normalizationTable59, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Inscriptional_Parthian", primary: normalizationTable60, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Javanese", primary: normalizationTable61, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Kaithi", primary: normalizationTable62, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Kannada", primary: normalizationTable63, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Katakana", primary: normalizationTable64, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Kawi", primary: normalizationTable65, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Kayah_Li", primary: normalizationTable66, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Kharoshthi", primary: normalizationTable67, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Khitan_Small_Script", primary: normalizationTable68, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Khmer", primary: normalizationTable69, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Khojki", primary: normalizationTable70, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Khudawadi", primary: normalizationTable71, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Lao", primary: normalizationTable72, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Lepcha", primary: normalizationTable73, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Limbu", primary: normalizationTable74, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Linear_A", primary: normalizationTable75, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Linear_B", primary: normalizationTable76, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Lisu", primary: normalizationTable77, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Lycian", primary: normalizationTable78, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Lydian", primary: normalizationTable79, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Mahajani", primary: normalizationTable80, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Makasar", primary: normalizationTable81, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Malayalam", primary: normalizationTable82, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Mandaic", primary: normalizationTable83, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Manichaean", primary: normalizationTable84, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Marchen", primary: normalizationTable85, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Masaram_Gondi", primary: normalizationTable86, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Medefaidrin", primary: normalizationTable87, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Meetei_Mayek", primary: normalizationTable88, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Mende_Kikakui", primary: normalizationTable89, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Meroitic_Cursive", primary: normalizationTable90, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Meroitic_Hieroglyphs", primary: normalizationTable91, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Miao", primary: normalizationTable92, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Modi", primary: normalizationTable93, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Mongolian", primary: normalizationTable94, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Mro", primary: normalizationTable95, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Multani", primary: normalizationTable96, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Myanmar", primary: normalizationTable97, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Nabataean", primary: normalizationTable98, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Nag_Mundari", primary: normalizationTable99, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Nandinagari", primary: normalizationTable100, secondary: normalizationTable5, combiningMarks: false},
+	{name: "New_Tai_Lue", primary: normalizationTable101, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Newa", primary: normalizationTable102, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Nko", primary: normalizationTable103, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Nushu", primary: normalizationTable104, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Nyiakeng_Puachue_Hmong", primary: normalizationTable105, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Ogham", primary: normalizationTable106, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Ol_Chiki", primary: normalizationTable107, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Old_Hungarian", primary: normalizationTable108, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Old_Italic", primary: normalizationTable109, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Old_North_Arabian", primary: normalizationTable110, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Old_Permic", primary: normalizationTable111, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Old_Persian", primary: normalizationTable112, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Old_Sogdian", primary: normalizationTable113, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Old_South_Arabian", primary: normalizationTable114, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Old_Turkic", primary: normalizationTable115, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Old_Uyghur", primary: normalizationTable116, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Oriya", primary: normalizationTable117, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Osage", primary: normalizationTable118, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Osmanya", primary: normalizationTable119, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Pahawh_Hmong", primary: normalizationTable120, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Palmyrene", primary: normalizationTable121, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Pau_Cin_Hau", primary: normalizationTable122, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Phags_Pa", primary: normalizationTable123, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Phoenician", primary: normalizationTable124, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Psalter_Pahlavi", primary: normalizationTable125, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Rejang", primary: normalizationTable126, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Runic", primary: normalizationTable127, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Samaritan", primary: normalizationTable128, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Saurashtra", primary: normalizationTable129, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Sharada", primary: normalizationTable130, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Shavian", primary: normalizationTable131, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Siddham", primary: normalizationTable132, secondary: normalizationTable5, combiningMarks: false},
+	{name: "SignWriting", primary: normalizationTable133, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Sinhala", primary: normalizationTable134, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Sogdian", primary: normalizationTable135, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Sora_Sompeng", primary: normalizationTable136, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Soyombo", primary: normalizationTable137, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Sundanese", primary: normalizationTable138, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Syloti_Nagri", primary: normalizationTable139, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Syriac", primary: normalizationTable140, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Tagalog", primary: normalizationTable141, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Tagbanwa", primary: normalizationTable142, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Tai_Le", primary: normalizationTable143, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Tai_Tham", primary: normalizationTable144, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Tai_Viet", primary: normalizationTable145, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Takri", primary: normalizationTable146, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Tamil", primary: normalizationTable147, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Tangsa", primary: normalizationTable148, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Tangut", primary: normalizationTable149, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Telugu", primary: normalizationTable150, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Thaana", primary: normalizationTable151, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Thai", primary: normalizationTable152, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Tibetan", primary: normalizationTable153, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Tifinagh", primary: normalizationTable154, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Tirhuta", primary: normalizationTable155, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Toto", primary: normalizationTable156, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Ugaritic", primary: normalizationTable157, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Vai", primary: normalizationTable158, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Vithkuqi", primary: normalizationTable159, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Wancho", primary: normalizationTable160, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Warang_Citi", primary: normalizationTable161, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Yezidi", primary: normalizationTable162, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Yi", primary: normalizationTable163, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Zanabazar_Square", primary: normalizationTable164, secondary: normalizationTable5, combiningMarks: false},
+	{name: "Japanese", primary: normalizationTable165, secondary: normalizationTable166, combiningMarks: false},
+	{name: "Chinese", primary: normalizationTable167, secondary: normalizationTable166, combiningMarks: false},
+	{name: "Korean", primary: normalizationTable168, secondary: normalizationTable166, combiningMarks: false},
+}
+
+// wholeConfusables are sets of characters that look alike, used to find
+// labels that can be mistaken for labels in another group
+var wholeConfusables = [][]rune{
+	{0x0061, 0x0251, 0x03B1, 0x0430},
+	{0x0062, 0x0180},
+	{0x0063, 0x03F2, 0x0441},
+	{0x0064, 0x0111, 0x0501},
+	{0x0065, 0x0435, 0x04BD},
+	{0x0067, 0x0261},
+	{0x0068, 0x0127, 0x04BB, 0x0570},
+	{0x0069, 0x0131, 0x0268, 0x0269, 0x03B9, 0x0456},
+	{0x006A, 0x0237, 0x03F3, 0x0458},
+	{0x006B, 0x03BA},
+	{0x006C, 0x0142, 0x04CF},
+	{0x006E, 0x03B7, 0x0578},
+	{0x006F, 0x00F8, 0x03BF, 0x043E, 0x0585},
+	{0x0070, 0x03C1, 0x0440},
+	{0x0071, 0x051B, 0x0566},
+	{0x0072, 0x0433},
+	{0x0073, 0x0455},
+	{0x0074, 0x0167},
+	{0x0075, 0x03C5, 0x057D},
+	{0x0076, 0x03BD, 0x0475},
+	{0x0077, 0x051D, 0x0561},
+	{0x0078, 0x03C7, 0x0445},
+	{0x0079, 0x03B3, 0x0443, 0x04AF},
+	{0x007A, 0x01B6},
+}
+
+var normalizationTable0 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0024, 0x0024, 1},
+		{0x002D, 0x002D, 1},
+		{0x0030, 0x0039, 1},
+		{0x005F, 0x005F, 1},
+		{0x0061, 0x007A, 1},
+		{0x00B7, 0x00B7, 1},
+		{0x00DF, 0x00F6, 1},
+		{0x00F8, 0x00FF, 1},
+		{0x0101, 0x0101, 1},
+		{0x0103, 0x0103, 1},
+		{0x0105, 0x0105, 1},
+		{0x0107, 0x0107, 1},
+		{0x0109, 0x0109, 1},
+		{0x010B, 0x010B, 1},
+		{0x010D, 0x010D, 1},
+		{0x010F, 0x010F, 1},
+		{0x0111, 0x0111, 1},
+		{0x0113, 0x0113, 1},
+		{0x0115, 0x0115, 1},
+		{0x0117, 0x0117, 1},
+		{0x0119, 0x0119, 1},
+		{0x011B, 0x011B, 1},
+		{0x011D, 0x011D, 1},
+		{0x011F, 0x011F, 1},
+		{0x0121, 0x0121, 1},
+		{0x0123, 0x0123, 1},
+		{0x0125, 0x0125, 1},
+		{0x0127, 0x0127, 1},
+		{0x0129, 0x0129, 1},
+		{0x012B, 0x012B, 1},
+		{0x012D, 0x012D, 1},
+		{0x012F, 0x012F, 1},
+		{0x0131, 0x0131, 1},
+		{0x0135, 0x0135, 1},
+		{0x0137, 0x0138, 1},
+		{0x013A, 0x013A, 1},
+		{0x013C, 0x013C, 1},
+		{0x013E, 0x013E, 1},
+		{0x0142, 0x0142, 1},
+		{0x0144, 0x0144, 1},
+		{0x0146, 0x0146, 1},
+		{0x0148, 0x0148, 1},
+		{0x014B, 0x014B, 1},
+		{0x014D, 0x014D, 1},
+		{0x014F, 0x014F, 1},
+		{0x0151, 0x0151, 1},
+		{0x0153, 0x0153, 1},
+		{0x0155, 0x0155, 1},
+		{0x0157, 0x0157, 1},
+		{0x0159, 0x0159, 1},
+		{0x015B, 0x015B, 1},
+		{0x015D, 0x015D, 1},
+		{0x015F, 0x015F, 1},
+		{0x0161, 0x0161, 1},
+		{0x0163, 0x0163, 1},
+		{0x0165, 0x0165, 1},
+		{0x0167, 0x0167, 1},
+		{0x0169, 0x0169, 1},
+		{0x016B, 0x016B, 1},
+		{0x016D, 0x016D, 1},
+		{0x016F, 0x016F, 1},
+		{0x0171, 0x0171, 1},
+		{0x0173, 0x0173, 1},
+		{0x0175, 0x0175, 1},
+		{0x0177, 0x0177, 1},
+		{0x017A, 0x017A, 1},
+		{0x017C, 0x017C, 1},
+		{0x017E, 0x017E, 1},
+		{0x0180, 0x0180, 1},
+		{0x0183, 0x0183, 1},
+		{0x0185, 0x0185, 1},
+		{0x0188, 0x0188, 1},
+		{0x018C, 0x018D, 1},
+		{0x0192, 0x0192, 1},
+		{0x0195, 0x0195, 1},
+		{0x0199, 0x019B, 1},
+		{0x019E, 0x019E, 1},
+		{0x01A1, 0x01A1, 1},
+		{0x01A3, 0x01A3, 1},
+		{0x01A5, 0x01A5, 1},
+		{0x01A8, 0x01A8, 1},
+		{0x01AA, 0x01AB, 1},
+		{0x01AD, 0x01AD, 1},
+		{0x01B0, 0x01B0, 1},
+		{0x01B4, 0x01B4, 1},
+		{0x01B6, 0x01B6, 1},
+		{0x01B9, 0x01BB, 1},
+		{0x01BD, 0x01C3, 1},
+		{0x01CE, 0x01CE, 1},
+		{0x01D0, 0x01D0, 1},
+		{0x01D2, 0x01D2, 1},
+		{0x01D4, 0x01D4, 1},
+		{0x01D6, 0x01D6, 1},
+		{0x01D8, 0x01D8, 1},
+		{0x01DA, 0x01DA, 1},
+		{0x01DC, 0x01DD, 1},
+		{0x01DF, 0x01DF, 1},
+		{0x01E1, 0x01E1, 1},
+		{0x01E3, 0x01E3, 1},
+		{0x01E5, 0x01E5, 1},
+		{0x01E7, 0x01E7, 1},
+		{0x01E9, 0x01E9, 1},
+		{0x01EB, 0x01EB, 1},
+		{0x01ED, 0x01ED, 1},
+		{0x01EF, 0x01F0, 1},
+		{0x01F5, 0x01F5, 1},
+		{0x01F9, 0x01F9, 1},
+		{0x01FB, 0x01FB, 1},
+		{0x01FD, 0x01FD, 1},
+		{0x01FF, 0x01FF, 1},
+		{0x0201, 0x0201, 1},
+		{0x0203, 0x0203, 1},
+		{0x0205, 0x0205, 1},
+		{0x0207, 0x0207, 1},
+		{0x0209, 0x0209, 1},
+		{0x020B, 0x020B, 1},
+		{0x020D, 0x020D, 1},
+		{0x020F, 0x020F, 1},
+		{0x0211, 0x0211, 1},
+		{0x0213, 0x0213, 1},
+		{0x0215, 0x0215, 1},
+		{0x0217, 0x0217, 1},
+		{0x0219, 0x0219, 1},
+		{0x021B, 0x021B, 1},
+		{0x021D, 0x021D, 1},
+		{0x021F, 0x021F, 1},
+		{0x0221, 0x0221, 1},
+		{0x0223, 0x0223, 1},
+		{0x0225, 0x0225, 1},
+		{0x0227, 0x0227, 1},
+		{0x0229, 0x0229, 1},
+		{0x022B, 0x022B, 1},
+		{0x022D, 0x022D, 1},
+		{0x022F, 0x022F, 1},
+		{0x0231, 0x0231, 1},
+		{0x0233, 0x0239, 1},
+		{0x023C, 0x023C, 1},
+		{0x023F, 0x0240, 1},
+		{0x0242, 0x0242, 1},
+		{0x0247, 0x0247, 1},
+		{0x0249, 0x0249, 1},
+		{0x024B, 0x024B, 1},
+		{0x024D, 0x024D, 1},
+		{0x024F, 0x02AF, 1},
+		{0x02B9, 0x02C1, 1},
+		{0x02C6, 0x02D1, 1},
+		{0x02EC, 0x02EC, 1},
+		{0x02EE, 0x02EE, 1},
+		{0x0300, 0x033F, 1},
+		{0x0342, 0x0342, 1},
+		{0x0346, 0x034E, 1},
+		{0x0350, 0x036F, 1},
+		{0x0371, 0x0371, 1},
+		{0x0373, 0x0373, 1},
+		{0x0377, 0x0377, 1},
+		{0x037B, 0x037D, 1},
+		{0x0390, 0x0390, 1},
+		{0x03AC, 0x03CE, 1},
+		{0x03D7, 0x03D7, 1},
+		{0x03D9, 0x03D9, 1},
+		{0x03DB, 0x03DB, 1},
+		{0x03DD, 0x03DD, 1},
+		{0x03DF, 0x03DF, 1},
+		{0x03E1, 0x03E1, 1},
+		{0x03E3, 0x03E3, 1},
+		{0x03E5, 0x03E5, 1},
+		{0x03E7, 0x03E7, 1},
+		{0x03E9, 0x03E9, 1},
+		{0x03EB, 0x03EB, 1},
+		{0x03ED, 0x03ED, 1},
+		{0x03EF, 0x03EF, 1},
+		{0x03F3, 0x03F3, 1},
+		{0x03F8, 0x03F8, 1},
+		{0x03FB, 0x03FC, 1},
+		{0x0430, 0x045F, 1},
+		{0x0461, 0x0461, 1},
+		{0x0463, 0x0463, 1},
+		{0x0465, 0x0465, 1},
+		{0x0467, 0x0467, 1},
+		{0x0469, 0x0469, 1},
+		{0x046B, 0x046B, 1},
+		{0x046D, 0x046D, 1},
+		{0x046F, 0x046F, 1},
+		{0x0471, 0x0471, 1},
+		{0x0473, 0x0473, 1},
+		{0x0475, 0x0475, 1},
+		{0x0477, 0x0477, 1},
+		{0x0479, 0x0479, 1},
+		{0x047B, 0x047B, 1},
+		{0x047D, 0x047D, 1},
+		{0x047F, 0x047F, 1},
+		{0x0481, 0x0481, 1},
+		{0x0483, 0x0487, 1},
+		{0x048B, 0x048B, 1},
+		{0x048D, 0x048D, 1},
+		{0x048F, 0x048F, 1},
+		{0x0491, 0x0491, 1},
+		{0x0493, 0x0493, 1},
+		{0x0495, 0x0495, 1},
+		{0x0497, 0x0497, 1},
+		{0x0499, 0x0499, 1},
+		{0x049B, 0x049B, 1},
+		{0x049D, 0x049D, 1},
+		{0x049F, 0x049F, 1},
+		{0x04A1, 0x04A1, 1},
+		{0x04A3, 0x04A3, 1},
+		{0x04A5, 0x04A5, 1},
+		{0x04A7, 0x04A7, 1},
+		{0x04A9, 0x04A9, 1},
+		{0x04AB, 0x04AB, 1},
+		{0x04AD, 0x04AD, 1},
+		{0x04AF, 0x04AF, 1},
+		{0x04B1, 0x04B1, 1},
+		{0x04B3, 0x04B3, 1},
+		{0x04B5, 0x04B5, 1},
+		{0x04B7, 0x04B7, 1},
+		{0x04B9, 0x04B9, 1},
+		{0x04BB, 0x04BB, 1},
+		{0x04BD, 0x04BD, 1},
+		{0x04BF, 0x04BF, 1},
+		{0x04C2, 0x04C2, 1},
+		{0x04C4, 0x04C4, 1},
+		{0x04C6, 0x04C6, 1},
+		{0x04C8, 0x04C8, 1},
+		{0x04CA, 0x04CA, 1},
+		{0x04CC, 0x04CC, 1},
+		{0x04CE, 0x04CF, 1},
+		{0x04D1, 0x04D1, 1},
+		{0x04D3, 0x04D3, 1},
+		{0x04D5, 0x04D5, 1},
+		{0x04D7, 0x04D7, 1},
+		{0x04D9, 0x04D9, 1},
+		{0x04DB, 0x04DB, 1},
+		{0x04DD, 0x04DD, 1},
+		{0x04DF, 0x04DF, 1},
+		{0x04E1, 0x04E1, 1},
+		{0x04E3, 0x04E3, 1},
+		{0x04E5, 0x04E5, 1},
+		{0x04E7, 0x04E7, 1},
+		{0x04E9, 0x04E9, 1},
+		{0x04EB, 0x04EB, 1},
+		{0x04ED, 0x04ED, 1},
+		{0x04EF, 0x04EF, 1},
+		{0x04F1, 0x04F1, 1},
+		{0x04F3, 0x04F3, 1},
+		{0x04F5, 0x04F5, 1},
+		{0x04F7, 0x04F7, 1},
+		{0x04F9, 0x04F9, 1},
+		{0x04FB, 0x04FB, 1},
+		{0x04FD, 0x04FD, 1},
+		{0x04FF, 0x04FF, 1},
+		{0x0501, 0x0501, 1},
+		{0x0503, 0x0503, 1},
+		{0x0505, 0x0505, 1},
+		{0x0507, 0x0507, 1},
+		{0x0509, 0x0509, 1},
+		{0x050B, 0x050B, 1},
+		{0x050D, 0x050D, 1},
+		{0x050F, 0x050F, 1},
+		{0x0511, 0x0511, 1},
+		{0x0513, 0x0513, 1},
+		{0x0515, 0x0515, 1},
+		{0x0517, 0x0517, 1},
+		{0x0519, 0x0519, 1},
+		{0x051B, 0x051B, 1},
+		{0x051D, 0x051D, 1},
+		{0x051F, 0x051F, 1},
+		{0x0521, 0x0521, 1},
+		{0x0523, 0x0523, 1},
+		{0x0525, 0x0525, 1},
+		{0x0527, 0x0527, 1},
+		{0x0529, 0x0529, 1},
+		{0x052B, 0x052B, 1},
+		{0x052D, 0x052D, 1},
+		{0x052F, 0x052F, 1},
+		{0x0559, 0x0559, 1},
+		{0x0560, 0x0586, 1},
+		{0x0588, 0x0588, 1},
+		{0x0591, 0x05BD, 1},
+		{0x05BF, 0x05BF, 1},
+		{0x05C1, 0x05C2, 1},
+		{0x05C4, 0x05C5, 1},
+		{0x05C7, 0x05C7, 1},
+		{0x05D0, 0x05EA, 1},
+		{0x05EF, 0x05F4, 1},
+		{0x0610, 0x061A, 1},
+		{0x0620, 0x0669, 1},
+		{0x066E, 0x0674, 1},
+		{0x0679, 0x06D3, 1},
+		{0x06D5, 0x06DC, 1},
+		{0x06DF, 0x06E8, 1},
+		{0x06EA, 0x06FC, 1},
+		{0x06FF, 0x06FF, 1},
+		{0x0710, 0x074A, 1},
+		{0x074D, 0x07B1, 1},
+		{0x07C0, 0x07F5, 1},
+		{0x07FA, 0x07FA, 1},
+		{0x07FD, 0x07FD, 1},
+		{0x0800, 0x082D, 1},
+		{0x0840, 0x085B, 1},
+		{0x0860, 0x086A, 1},
+		{0x0870, 0x0887, 1},
+		{0x0889, 0x088E, 1},
+		{0x0898, 0x08E1, 1},
+		{0x08E3, 0x0957, 1},
+		{0x0960, 0x0963, 1},
+		{0x0966, 0x096F, 1},
+		{0x0971, 0x0983, 1},
+		{0x0985, 0x098C, 1},
+		{0x098F, 0x0990, 1},
+		{0x0993, 0x09A8, 1},
+		{0x09AA, 0x09B0, 1},
+		{0x09B2, 0x09B2, 1},
+		{0x09B6, 0x09B9, 1},
+		{0x09BC, 0x09C4, 1},
+		{0x09C7, 0x09C8, 1},
+		{0x09CB, 0x09CE, 1},
+		{0x09D7, 0x09D7, 1},
+		{0x09E0, 0x09E3, 1},
+		{0x09E6, 0x09F1, 1},
+		{0x09FC, 0x09FC, 1},
+		{0x09FE, 0x09FE, 1},
+		{0x0A01, 0x0A03, 1},
+		{0x0A05, 0x0A0A, 1},
+		{0x0A0F, 0x0A10, 1},
+		{0x0A13, 0x0A28, 1},
+		{0x0A2A, 0x0A30, 1},
+		{0x0A32, 0x0A32, 1},
+		{0x0A35, 0x0A35, 1},
+		{0x0A38, 0x0A39, 1},
+		{0x0A3C, 0x0A3C, 1},
+		{0x0A3E, 0x0A42, 1},
+		{0x0A47, 0x0A48, 1},
+		{0x0A4B, 0x0A4D, 1},
+		{0x0A51, 0x0A51, 1},
+		{0x0A5C, 0x0A5C, 1},
+		{0x0A66, 0x0A75, 1},
+		{0x0A81, 0x0A83, 1},
+		{0x0A85, 0x0A8D, 1},
+		{0x0A8F, 0x0A91, 1},
+		{0x0A93, 0x0AA8, 1},
+		{0x0AAA, 0x0AB0, 1},
+		{0x0AB2, 0x0AB3, 1},
+		{0x0AB5, 0x0AB9, 1},
+		{0x0ABC, 0x0AC5, 1},
+		{0x0AC7, 0x0AC9, 1},
+		{0x0ACB, 0x0ACD, 1},
+		{0x0AD0, 0x0AD0, 1},
+		{0x0AE0, 0x0AE3, 1},
+		{0x0AE6, 0x0AEF, 1},
+		{0x0AF9, 0x0AFF, 1},
+		{0x0B01, 0x0B03, 1},
+		{0x0B05, 0x0B0C, 1},
+		{0x0B0F, 0x0B10, 1},
+		{0x0B13, 0x0B28, 1},
+		{0x0B2A, 0x0B30, 1},
+		{0x0B32, 0x0B33, 1},
+		{0x0B35, 0x0B39, 1},
+		{0x0B3C, 0x0B44, 1},
+		{0x0B47, 0x0B48, 1},
+		{0x0B4B, 0x0B4D, 1},
+		{0x0B55, 0x0B57, 1},
+		{0x0B5F, 0x0B63, 1},
+		{0x0B66, 0x0B6F, 1},
+		{0x0B71, 0x0B71, 1},
+		{0x0B82, 0x0B83, 1},
+		{0x0B85, 0x0B8A, 1},
+		{0x0B8E, 0x0B90, 1},
+		{0x0B92, 0x0B95, 1},
+		{0x0B99, 0x0B9A, 1},
+		{0x0B9C, 0x0B9C, 1},
+		{0x0B9E, 0x0B9F, 1},
+		{0x0BA3, 0x0BA4, 1},
+		{0x0BA8, 0x0BAA, 1},
+		{0x0BAE, 0x0BB9, 1},
+		{0x0BBE, 0x0BC2, 1},
+		{0x0BC6, 0x0BC8, 1},
+		{0x0BCA, 0x0BCD, 1},
+		{0x0BD0, 0x0BD0, 1},
+		{0x0BD7, 0x0BD7, 1},
+		{0x0BE6, 0x0BEF, 1},
+		{0x0C00, 0x0C0C, 1},
+		{0x0C0E, 0x0C10, 1},
+		{0x0C12, 0x0C28, 1},
+		{0x0C2A, 0x0C39, 1},
+		{0x0C3C, 0x0C44, 1},
+		{0x0C46, 0x0C48, 1},
+		{0x0C4A, 0x0C4D, 1},
+		{0x0C55, 0x0C56, 1},
+		{0x0C58, 0x0C5A, 1},
+		{0x0C5D, 0x0C5D, 1},
+		{0x0C60, 0x0C63, 1},
+		{0x0C66, 0x0C6F, 1},
+		{0x0C80, 0x0C83, 1},
+		{0x0C85, 0x0C8C, 1},
+		{0x0C8E, 0x0C90, 1},
+		{0x0C92, 0x0CA8, 1},
+		{0x0CAA, 0x0CB3, 1},
+		{0x0CB5, 0x0CB9, 1},
+		{0x0CBC, 0x0CC4, 1},
+		{0x0CC6, 0x0CC8, 1},
+		{0x0CCA, 0x0CCD, 1},
+		{0x0CD5, 0x0CD6, 1},
+		{0x0CDD, 0x0CDE, 1},
+		{0x0CE0, 0x0CE3, 1},
+		{0x0CE6, 0x0CEF, 1},
+		{0x0CF1, 0x0CF3, 1},
+		{0x0D00, 0x0D0C, 1},
+		{0x0D0E, 0x0D10, 1},
+		{0x0D12, 0x0D44, 1},
+		{0x0D46, 0x0D48, 1},
+		{0x0D4A, 0x0D4E, 1},
+		{0x0D54, 0x0D57, 1},
+		{0x0D5F, 0x0D63, 1},
+		{0x0D66, 0x0D6F, 1},
+		{0x0D7A, 0x0D7F, 1},
+		{0x0D81, 0x0D83, 1},
+		{0x0D85, 0x0D96, 1},
+		{0x0D9A, 0x0DB1, 1},
+		{0x0DB3, 0x0DBB, 1},
+		{0x0DBD, 0x0DBD, 1},
+		{0x0DC0, 0x0DC6, 1},
+		{0x0DCA, 0x0DCA, 1},
+		{0x0DCF, 0x0DD4, 1},
+		{0x0DD6, 0x0DD6, 1},
+		{0x0DD8, 0x0DDF, 1},
+		{0x0DE6, 0x0DEF, 1},
+		{0x0DF2, 0x0DF3, 1},
+		{0x0E01, 0x0E32, 1},
+		{0x0E34, 0x0E3A, 1},
+		{0x0E40, 0x0E4E, 1},
+		{0x0E50, 0x0E59, 1},
+		{0x0E81, 0x0E82, 1},
+		{0x0E84, 0x0E84, 1},
+		{0x0E86, 0x0E8A, 1},
+		{0x0E8C, 0x0EA3, 1},
+		{0x0EA5, 0x0EA5, 1},
+		{0x0EA7, 0x0EB2, 1},
+		{0x0EB4, 0x0EBD, 1},
+		{0x0EC0, 0x0EC4, 1},
+		{0x0EC6, 0x0EC6, 1},
+		{0x0EC8, 0x0ECE, 1},
+		{0x0ED0, 0x0ED9, 1},
+		{0x0EDE, 0x0EDF, 1},
+		{0x0F00, 0x0F00, 1},
+		{0x0F18, 0x0F19, 1},
+		{0x0F20, 0x0F29, 1},
+		{0x0F35, 0x0F35, 1},
+		{0x0F37, 0x0F37, 1},
+		{0x0F39, 0x0F39, 1},
+		{0x0F3E, 0x0F42, 1},
+		{0x0F44, 0x0F47, 1},
+		{0x0F49, 0x0F4C, 1},
+		{0x0F4E, 0x0F51, 1},
+		{0x0F53, 0x0F56, 1},
+		{0x0F58, 0x0F5B, 1},
+		{0x0F5D, 0x0F68, 1},
+		{0x0F6A, 0x0F6C, 1},
+		{0x0F71, 0x0F72, 1},
+		{0x0F74, 0x0F74, 1},
+		{0x0F7A, 0x0F80, 1},
+		{0x0F82, 0x0F84, 1},
+		{0x0F86, 0x0F92, 1},
+		{0x0F94, 0x0F97, 1},
+		{0x0F99, 0x0F9C, 1},
+		{0x0F9E, 0x0FA1, 1},
+		{0x0FA3, 0x0FA6, 1},
+		{0x0FA8, 0x0FAB, 1},
+		{0x0FAD, 0x0FB8, 1},
+		{0x0FBA, 0x0FBC, 1},
+		{0x0FC6, 0x0FC6, 1},
+		{0x1000, 0x1049, 1},
+		{0x1050, 0x109D, 1},
+		{0x10D0, 0x10FA, 1},
+		{0x10FD, 0x115E, 1},
+		{0x1161, 0x1248, 1},
+		{0x124A, 0x124D, 1},
+		{0x1250, 0x1256, 1},
+		{0x1258, 0x1258, 1},
+		{0x125A, 0x125D, 1},
+		{0x1260, 0x1288, 1},
+		{0x128A, 0x128D, 1},
+		{0x1290, 0x12B0, 1},
+		{0x12B2, 0x12B5, 1},
+		{0x12B8, 0x12BE, 1},
+		{0x12C0, 0x12C0, 1},
+		{0x12C2, 0x12C5, 1},
+		{0x12C8, 0x12D6, 1},
+		{0x12D8, 0x1310, 1},
+		{0x1312, 0x1315, 1},
+		{0x1318, 0x135A, 1},
+		{0x135D, 0x135F, 1},
+		{0x1380, 0x138F, 1},
+		{0x13A0, 0x13F5, 1},
+		{0x1401, 0x166C, 1},
+		{0x166F, 0x167F, 1},
+		{0x1681, 0x169A, 1},
+		{0x16A0, 0x16EA, 1},
+		{0x16F1, 0x16F8, 1},
+		{0x1700, 0x1715, 1},
+		{0x171F, 0x1734, 1},
+		{0x1740, 0x1753, 1},
+		{0x1760, 0x176C, 1},
+		{0x176E, 0x1770, 1},
+		{0x1772, 0x1773, 1},
+		{0x1780, 0x17B3, 1},
+		{0x17B6, 0x17D3, 1},
+		{0x17D7, 0x17D7, 1},
+		{0x17DC, 0x17DD, 1},
+		{0x17E0, 0x17E9, 1},
+		{0x1810, 0x1819, 1},
+		{0x1820, 0x1878, 1},
+		{0x1880, 0x18AA, 1},
+		{0x18B0, 0x18F5, 1},
+		{0x1900, 0x191E, 1},
+		{0x1920, 0x192B, 1},
+		{0x1930, 0x193B, 1},
+		{0x1946, 0x196D, 1},
+		{0x1970, 0x1974, 1},
+		{0x1980, 0x19AB, 1},
+		{0x19B0, 0x19C9, 1},
+		{0x19D0, 0x19D9, 1},
+		{0x1A00, 0x1A1B, 1},
+		{0x1A20, 0x1A5E, 1},
+		{0x1A60, 0x1A7C, 1},
+		{0x1A7F, 0x1A89, 1},
+		{0x1A90, 0x1A99, 1},
+		{0x1AA7, 0x1AA7, 1},
+		{0x1AB0, 0x1ABD, 1},
+		{0x1ABF, 0x1ACE, 1},
+		{0x1B00, 0x1B4C, 1},
+		{0x1B50, 0x1B59, 1},
+		{0x1B6B, 0x1B73, 1},
+		{0x1B80, 0x1BF3, 1},
+		{0x1C00, 0x1C37, 1},
+		{0x1C40, 0x1C49, 1},
+		{0x1C4D, 0x1C7D, 1},
+		{0x1CD0, 0x1CD2, 1},
+		{0x1CD4, 0x1CFA, 1},
+		{0x1D00, 0x1D2B, 1},
+		{0x1D2F, 0x1D2F, 1},
+		{0x1D3B, 0x1D3B, 1},
+		{0x1D4E, 0x1D4E, 1},
+		{0x1D6B, 0x1D77, 1},
+		{0x1D79, 0x1D9A, 1},
+		{0x1DC0, 0x1DFF, 1},
+		{0x1E01, 0x1E01, 1},
+		{0x1E03, 0x1E03, 1},
+		{0x1E05, 0x1E05, 1},
+		{0x1E07, 0x1E07, 1},
+		{0x1E09, 0x1E09, 1},
+		{0x1E0B, 0x1E0B, 1},
+		{0x1E0D, 0x1E0D, 1},
+		{0x1E0F, 0x1E0F, 1},
+		{0x1E11, 0x1E11, 1},
+		{0x1E13, 0x1E13, 1},
+		{0x1E15, 0x1E15, 1},
+		{0x1E17, 0x1E17, 1},
+		{0x1E19, 0x1E19, 1},
+		{0x1E1B, 0x1E1B, 1},
+		{0x1E1D, 0x1E1D, 1},
+		{0x1E1F, 0x1E1F, 1},
+		{0x1E21, 0x1E21, 1},
+		{0x1E23, 0x1E23, 1},
+		{0x1E25, 0x1E25, 1},
+		{0x1E27, 0x1E27, 1},
+		{0x1E29, 0x1E29, 1},
+		{0x1E2B, 0x1E2B, 1},
+		{0x1E2D, 0x1E2D, 1},
+		{0x1E2F, 0x1E2F, 1},
+		{0x1E31, 0x1E31, 1},
+		{0x1E33, 0x1E33, 1},
+		{0x1E35, 0x1E35, 1},
+		{0x1E37, 0x1E37, 1},
+		{0x1E39, 0x1E39, 1},
+		{0x1E3B, 0x1E3B, 1},
+		{0x1E3D, 0x1E3D, 1},
+		{0x1E3F, 0x1E3F, 1},
+		{0x1E41, 0x1E41, 1},
+		{0x1E43, 0x1E43, 1},
+		{0x1E45, 0x1E45, 1},
+		{0x1E47, 0x1E47, 1},
+		{0x1E49, 0x1E49, 1},
+		{0x1E4B, 0x1E4B, 1},
+		{0x1E4D, 0x1E4D, 1},
+		{0x1E4F, 0x1E4F, 1},
+		{0x1E51, 0x1E51, 1},
+		{0x1E53, 0x1E53, 1},
+		{0x1E55, 0x1E55, 1},
+		{0x1E57, 0x1E57, 1},
+		{0x1E59, 0x1E59, 1},
+		{0x1E5B, 0x1E5B, 1},
+		{0x1E5D, 0x1E5D, 1},
+		{0x1E5F, 0x1E5F, 1},
+		{0x1E61, 0x1E61, 1},
+		{0x1E63, 0x1E63, 1},
+		{0x1E65, 0x1E65, 1},
+		{0x1E67, 0x1E67, 1},
+		{0x1E69, 0x1E69, 1},
+		{0x1E6B, 0x1E6B, 1},
+		{0x1E6D, 0x1E6D, 1},
+		{0x1E6F, 0x1E6F, 1},
+		{0x1E71, 0x1E71, 1},
+		{0x1E73, 0x1E73, 1},
+		{0x1E75, 0x1E75, 1},
+		{0x1E77, 0x1E77, 1},
+		{0x1E79, 0x1E79, 1},
+		{0x1E7B, 0x1E7B, 1},
+		{0x1E7D, 0x1E7D, 1},
+		{0x1E7F, 0x1E7F, 1},
+		{0x1E81, 0x1E81, 1},
+		{0x1E83, 0x1E83, 1},
+		{0x1E85, 0x1E85, 1},
+		{0x1E87, 0x1E87, 1},
+		{0x1E89, 0x1E89, 1},
+		{0x1E8B, 0x1E8B, 1},
+		{0x1E8D, 0x1E8D, 1},
+		{0x1E8F, 0x1E8F, 1},
+		{0x1E91, 0x1E91, 1},
+		{0x1E93, 0x1E93, 1},
+		{0x1E95, 0x1E99, 1},
+		{0x1E9C, 0x1E9D, 1},
+		{0x1E9F, 0x1E9F, 1},
+		{0x1EA1, 0x1EA1, 1},
+		{0x1EA3, 0x1EA3, 1},
+		{0x1EA5, 0x1EA5, 1},
+		{0x1EA7, 0x1EA7, 1},
+		{0x1EA9, 0x1EA9, 1},
+		{0x1EAB, 0x1EAB, 1},
+		{0x1EAD, 0x1EAD, 1},
+		{0x1EAF, 0x1EAF, 1},
+		{0x1EB1, 0x1EB1, 1},
+		{0x1EB3, 0x1EB3, 1},
+		{0x1EB5, 0x1EB5, 1},
+		{0x1EB7, 0x1EB7, 1},
+		{0x1EB9, 0x1EB9, 1},
+		{0x1EBB, 0x1EBB, 1},
+		{0x1EBD, 0x1EBD, 1},
+		{0x1EBF, 0x1EBF, 1},
+		{0x1EC1, 0x1EC1, 1},
+		{0x1EC3, 0x1EC3, 1},
+		{0x1EC5, 0x1EC5, 1},
+		{0x1EC7, 0x1EC7, 1},
+		{0x1EC9, 0x1EC9, 1},
+		{0x1ECB, 0x1ECB, 1},
+		{0x1ECD, 0x1ECD, 1},
+		{0x1ECF, 0x1ECF, 1},
+		{0x1ED1, 0x1ED1, 1},
+		{0x1ED3, 0x1ED3, 1},
+		{0x1ED5, 0x1ED5, 1},
+		{0x1ED7, 0x1ED7, 1},
+		{0x1ED9, 0x1ED9, 1},
+		{0x1EDB, 0x1EDB, 1},
+		{0x1EDD, 0x1EDD, 1},
+		{0x1EDF, 0x1EDF, 1},
+		{0x1EE1, 0x1EE1, 1},
+		{0x1EE3, 0x1EE3, 1},
+		{0x1EE5, 0x1EE5, 1},
+		{0x1EE7, 0x1EE7, 1},
+		{0x1EE9, 0x1EE9, 1},
+		{0x1EEB, 0x1EEB, 1},
+		{0x1EED, 0x1EED, 1},
+		{0x1EEF, 0x1EEF, 1},
+		{0x1EF1, 0x1EF1, 1},
+		{0x1EF3, 0x1EF3, 1},
+		{0x1EF5, 0x1EF5, 1},
+		{0x1EF7, 0x1EF7, 1},
+		{0x1EF9, 0x1EF9, 1},
+		{0x1EFB, 0x1EFB, 1},
+		{0x1EFD, 0x1EFD, 1},
+		{0x1EFF, 0x1F07, 1},
+		{0x1F10, 0x1F15, 1},
+		{0x1F20, 0x1F27, 1},
+		{0x1F30, 0x1F37, 1},
+		{0x1F40, 0x1F45, 1},
+		{0x1F50, 0x1F57, 1},
+		{0x1F60, 0x1F67, 1},
+		{0x1F70, 0x1F70, 1},
+		{0x1F72, 0x1F72, 1},
+		{0x1F74, 0x1F74, 1},
+		{0x1F76, 0x1F76, 1},
+		{0x1F78, 0x1F78, 1},
+		{0x1F7A, 0x1F7A, 1},
+		{0x1F7C, 0x1F7C, 1},
+		{0x1FB0, 0x1FB1, 1},
+		{0x1FB6, 0x1FB6, 1},
+		{0x1FC6, 0x1FC6, 1},
+		{0x1FD0, 0x1FD2, 1},
+		{0x1FD6, 0x1FD7, 1},
+		{0x1FE0, 0x1FE2, 1},
+		{0x1FE4, 0x1FE7, 1},
+		{0x1FF6, 0x1FF6, 1},
+		{0x2019, 0x2019, 1},
+		{0x2027, 0x2027, 1},
+		{0x2044, 0x2044, 1},
+		{0x20D0, 0x20DC, 1},
+		{0x20E1, 0x20E1, 1},
+		{0x20E5, 0x20F0, 1},
+		{0x214E, 0x214E, 1},
+		{0x2184, 0x2184, 1},
+		{0x2C30, 0x2C5F, 1},
+		{0x2C61, 0x2C61, 1},
+		{0x2C65, 0x2C66, 1},
+		{0x2C68, 0x2C68, 1},
+		{0x2C6A, 0x2C6A, 1},
+		{0x2C6C, 0x2C6C, 1},
+		{0x2C71, 0x2C71, 1},
+		{0x2C73, 0x2C74, 1},
+		{0x2C76, 0x2C7B, 1},
+		{0x2C81, 0x2C81, 1},
+		{0x2C83, 0x2C83, 1},
+		{0x2C85, 0x2C85, 1},
+		{0x2C87, 0x2C87, 1},
+		{0x2C89, 0x2C89, 1},
+		{0x2C8B, 0x2C8B, 1},
+		{0x2C8D, 0x2C8D, 1},
+		{0x2C8F, 0x2C8F, 1},
+		{0x2C91, 0x2C91, 1},
+		{0x2C93, 0x2C93, 1},
+		{0x2C95, 0x2C95, 1},
+		{0x2C97, 0x2C97, 1},
+		{0x2C99, 0x2C99, 1},
+		{0x2C9B, 0x2C9B, 1},
+		{0x2C9D, 0x2C9D, 1},
+		{0x2C9F, 0x2C9F, 1},
+		{0x2CA1, 0x2CA1, 1},
+		{0x2CA3, 0x2CA3, 1},
+		{0x2CA5, 0x2CA5, 1},
+		{0x2CA7, 0x2CA7, 1},
+		{0x2CA9, 0x2CA9, 1},
+		{0x2CAB, 0x2CAB, 1},
+		{0x2CAD, 0x2CAD, 1},
+		{0x2CAF, 0x2CAF, 1},
+		{0x2CB1, 0x2CB1, 1},
+		{0x2CB3, 0x2CB3, 1},
+		{0x2CB5, 0x2CB5, 1},
+		{0x2CB7, 0x2CB7, 1},
+		{0x2CB9, 0x2CB9, 1},
+		{0x2CBB, 0x2CBB, 1},
+		{0x2CBD, 0x2CBD, 1},
+		{0x2CBF, 0x2CBF, 1},
+		{0x2CC1, 0x2CC1, 1},
+		{0x2CC3, 0x2CC3, 1},
+		{0x2CC5, 0x2CC5, 1},
+		{0x2CC7, 0x2CC7, 1},
+		{0x2CC9, 0x2CC9, 1},
+		{0x2CCB, 0x2CCB, 1},
+		{0x2CCD, 0x2CCD, 1},
+		{0x2CCF, 0x2CCF, 1},
+		{0x2CD1, 0x2CD1, 1},
+		{0x2CD3, 0x2CD3, 1},
+		{0x2CD5, 0x2CD5, 1},
+		{0x2CD7, 0x2CD7, 1},
+		{0x2CD9, 0x2CD9, 1},
+		{0x2CDB, 0x2CDB, 1},
+		{0x2CDD, 0x2CDD, 1},
+		{0x2CDF, 0x2CDF, 1},
+		{0x2CE1, 0x2CE1, 1},
+		{0x2CE3, 0x2CE4, 1},
+		{0x2CEC, 0x2CEC, 1},
+		{0x2CEE, 0x2CF1, 1},
+		{0x2CF3, 0x2CF3, 1},
+		{0x2D00, 0x2D25, 1},
+		{0x2D27, 0x2D27, 1},
+		{0x2D2D, 0x2D2D, 1},
+		{0x2D30, 0x2D67, 1},
+		{0x2D7F, 0x2D96, 1},
+		{0x2DA0, 0x2DA6, 1},
+		{0x2DA8, 0x2DAE, 1},
+		{0x2DB0, 0x2DB6, 1},
+		{0x2DB8, 0x2DBE, 1},
+		{0x2DC0, 0x2DC6, 1},
+		{0x2DC8, 0x2DCE, 1},
+		{0x2DD0, 0x2DD6, 1},
+		{0x2DD8, 0x2DDE, 1},
+		{0x2DE0, 0x2DFF, 1},
+		{0x2E2F, 0x2E2F, 1},
+		{0x3005, 0x3006, 1},
+		{0x302A, 0x302F, 1},
+		{0x3031, 0x3035, 1},
+		{0x303B, 0x303C, 1},
+		{0x3041, 0x3096, 1},
+		{0x3099, 0x309A, 1},
+		{0x309D, 0x309E, 1},
+		{0x30A1, 0x30FE, 1},
+		{0x3105, 0x312F, 1},
+		{0x31A0, 0x31BF, 1},
+		{0x31F0, 0x31FF, 1},
+		{0x3400, 0x4DBF, 1},
+		{0x4E00, 0xA48C, 1},
+		{0xA4D0, 0xA4FD, 1},
+		{0xA500, 0xA60C, 1},
+		{0xA610, 0xA62B, 1},
+		{0xA641, 0xA641, 1},
+		{0xA643, 0xA643, 1},
+		{0xA645, 0xA645, 1},
+		{0xA647, 0xA647, 1},
+		{0xA649, 0xA649, 1},
+		{0xA64B, 0xA64B, 1},
+		{0xA64D, 0xA64D, 1},
+		{0xA64F, 0xA64F, 1},
+		{0xA651, 0xA651, 1},
+		{0xA653, 0xA653, 1},
+		{0xA655, 0xA655, 1},
+		{0xA657, 0xA657, 1},
+		{0xA659, 0xA659, 1},
+		{0xA65B, 0xA65B, 1},
+		{0xA65D, 0xA65D, 1},
+		{0xA65F, 0xA65F, 1},
+		{0xA661, 0xA661, 1},
+		{0xA663, 0xA663, 1},
+		{0xA665, 0xA665, 1},
+		{0xA667, 0xA667, 1},
+		{0xA669, 0xA669, 1},
+		{0xA66B, 0xA66B, 1},
+		{0xA66D, 0xA66F, 1},
+		{0xA674, 0xA67D, 1},
+		{0xA67F, 0xA67F, 1},
+		{0xA681, 0xA681, 1},
+		{0xA683, 0xA683, 1},
+		{0xA685, 0xA685, 1},
+		{0xA687, 0xA687, 1},
+		{0xA689, 0xA689, 1},
+		{0xA68B, 0xA68B, 1},
+		{0xA68D, 0xA68D, 1},
+		{0xA68F, 0xA68F, 1},
+		{0xA691, 0xA691, 1},
+		{0xA693, 0xA693, 1},
+		{0xA695, 0xA695, 1},
+		{0xA697, 0xA697, 1},
+		{0xA699, 0xA699, 1},
+		{0xA69B, 0xA69B, 1},
+		{0xA69E, 0xA6E5, 1},
+		{0xA6F0, 0xA6F1, 1},
+		{0xA717, 0xA71F, 1},
+		{0xA723, 0xA723, 1},
+		{0xA725, 0xA725, 1},
+		{0xA727, 0xA727, 1},
+		{0xA729, 0xA729, 1},
+		{0xA72B, 0xA72B, 1},
+		{0xA72D, 0xA72D, 1},
+		{0xA72F, 0xA731, 1},
+		{0xA733, 0xA733, 1},
+		{0xA735, 0xA735, 1},
+		{0xA737, 0xA737, 1},
+		{0xA739, 0xA739, 1},
+		{0xA73B, 0xA73B, 1},
+		{0xA73D, 0xA73D, 1},
+		{0xA73F, 0xA73F, 1},
+		{0xA741, 0xA741, 1},
+		{0xA743, 0xA743, 1},
+		{0xA745, 0xA745, 1},
+		{0xA747, 0xA747, 1},
+		{0xA749, 0xA749, 1},
+		{0xA74B, 0xA74B, 1},
+		{0xA74D, 0xA74D, 1},
+		{0xA74F, 0xA74F, 1},
+		{0xA751, 0xA751, 1},
+		{0xA753, 0xA753, 1},
+		{0xA755, 0xA755, 1},
+		{0xA757, 0xA757, 1},
+		{0xA759, 0xA759, 1},
+		{0xA75B, 0xA75B, 1},
+		{0xA75D, 0xA75D, 1},
+		{0xA75F, 0xA75F, 1},
+		{0xA761, 0xA761, 1},
+		{0xA763, 0xA763, 1},
+		{0xA765, 0xA765, 1},
+		{0xA767, 0xA767, 1},
+		{0xA769, 0xA769, 1},
+		{0xA76B, 0xA76B, 1},
+		{0xA76D, 0xA76D, 1},
+		{0xA76F, 0xA76F, 1},
+		{0xA771, 0xA778, 1},
+		{0xA77A, 0xA77A, 1},
+		{0xA77C, 0xA77C, 1},
+		{0xA77F, 0xA77F, 1},
+		{0xA781, 0xA781, 1},
+		{0xA783, 0xA783, 1},
+		{0xA785, 0xA785, 1},
+		{0xA787, 0xA788, 1},
+		{0xA78C, 0xA78C, 1},
+		{0xA78E, 0xA78F, 1},
+		{0xA791, 0xA791, 1},
+		{0xA793, 0xA795, 1},
+		{0xA797, 0xA797, 1},
+		{0xA799, 0xA799, 1},
+		{0xA79B, 0xA79B, 1},
+		{0xA79D, 0xA79D, 1},
+		{0xA79F, 0xA79F, 1},
+		{0xA7A1, 0xA7A1, 1},
+		{0xA7A3, 0xA7A3, 1},
+		{0xA7A5, 0xA7A5, 1},
+		{0xA7A7, 0xA7A7, 1},
+		{0xA7A9, 0xA7A9, 1},
+		{0xA7AF, 0xA7AF, 1},
+		{0xA7B5, 0xA7B5, 1},
+		{0xA7B7, 0xA7B7, 1},
+		{0xA7B9, 0xA7B9, 1},
+		{0xA7BB, 0xA7BB, 1},
+		{0xA7BD, 0xA7BD, 1},
+		{0xA7BF, 0xA7BF, 1},
+		{0xA7C1, 0xA7C1, 1},
+		{0xA7C3, 0xA7C3, 1},
+		{0xA7C8, 0xA7C8, 1},
+		{0xA7CA, 0xA7CA, 1},
+		{0xA7D1, 0xA7D1, 1},
+		{0xA7D3, 0xA7D3, 1},
+		{0xA7D5, 0xA7D5, 1},
+		{0xA7D7, 0xA7D7, 1},
+		{0xA7D9, 0xA7D9, 1},
+		{0xA7F6, 0xA7F7, 1},
+		{0xA7FA, 0xA827, 1},
+		{0xA82C, 0xA82C, 1},
+		{0xA840, 0xA873, 1},
+		{0xA880, 0xA8C5, 1},
+		{0xA8D0, 0xA8D9, 1},
+		{0xA8E0, 0xA8F7, 1},
+		{0xA8FB, 0xA8FB, 1},
+		{0xA8FD, 0xA92D, 1},
+		{0xA930, 0xA953, 1},
+		{0xA960, 0xA97C, 1},
+		{0xA980, 0xA9C0, 1},
+		{0xA9CF, 0xA9D9, 1},
+		{0xA9E0, 0xA9FE, 1},
+		{0xAA00, 0xAA36, 1},
+		{0xAA40, 0xAA4D, 1},
+		{0xAA50, 0xAA59, 1},
+		{0xAA60, 0xAA76, 1},
+		{0xAA7A, 0xAAC2, 1},
+		{0xAADB, 0xAADD, 1},
+		{0xAAE0, 0xAAEF, 1},
+		{0xAAF2, 0xAAF6, 1},
+		{0xAB01, 0xAB06, 1},
+		{0xAB09, 0xAB0E, 1},
+		{0xAB11, 0xAB16, 1},
+		{0xAB20, 0xAB26, 1},
+		{0xAB28, 0xAB2E, 1},
+		{0xAB30, 0xAB5A, 1},
+		{0xAB60, 0xAB68, 1},
+		{0xABC0, 0xABEA, 1},
+		{0xABEC, 0xABED, 1},
+		{0xABF0, 0xABF9, 1},
+		{0xAC00, 0xD7A3, 1},
+		{0xD7B0, 0xD7C6, 1},
+		{0xD7CB, 0xD7FB, 1},
+		{0xFA0E, 0xFA0F, 1},
+		{0xFA11, 0xFA11, 1},
+		{0xFA13, 0xFA14, 1},
+		{0xFA1F, 0xFA1F, 1},
+		{0xFA21, 0xFA21, 1},
+		{0xFA23, 0xFA24, 1},
+		{0xFA27, 0xFA29, 1},
+		{0xFB1E, 0xFB1E, 1},
+		{0xFE20, 0xFE2F, 1},
+		{0xFE73, 0xFE73, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10000, 0x1000B, 1},
+		{0x1000D, 0x10026, 1},
+		{0x10028, 0x1003A, 1},
+		{0x1003C, 0x1003D, 1},
+		{0x1003F, 0x1004D, 1},
+		{0x10050, 0x1005D, 1},
+		{0x10080, 0x100FA, 1},
+		{0x101FD, 0x101FD, 1},
+		{0x10280, 0x1029C, 1},
+		{0x102A0, 0x102D0, 1},
+		{0x102E0, 0x102E0, 1},
+		{0x10300, 0x1031F, 1},
+		{0x1032D, 0x10340, 1},
+		{0x10342, 0x10349, 1},
+		{0x10350, 0x1037A, 1},
+		{0x10380, 0x1039D, 1},
+		{0x103A0, 0x103C3, 1},
+		{0x103C8, 0x103CF, 1},
+		{0x10428, 0x1049D, 1},
+		{0x104A0, 0x104A9, 1},
+		{0x104D8, 0x104FB, 1},
+		{0x10500, 0x10527, 1},
+		{0x10530, 0x10563, 1},
+		{0x10597, 0x105A1, 1},
+		{0x105A3, 0x105B1, 1},
+		{0x105B3, 0x105B9, 1},
+		{0x105BB, 0x105BC, 1},
+		{0x10600, 0x10736, 1},
+		{0x10740, 0x10755, 1},
+		{0x10760, 0x10767, 1},
+		{0x10780, 0x10780, 1},
+		{0x10800, 0x10805, 1},
+		{0x10808, 0x10808, 1},
+		{0x1080A, 0x10835, 1},
+		{0x10837, 0x10838, 1},
+		{0x1083C, 0x1083C, 1},
+		{0x1083F, 0x10855, 1},
+		{0x10860, 0x10876, 1},
+		{0x10880, 0x1089E, 1},
+		{0x108E0, 0x108F2, 1},
+		{0x108F4, 0x108F5, 1},
+		{0x10900, 0x10915, 1},
+		{0x10920, 0x10939, 1},
+		{0x10980, 0x109B7, 1},
+		{0x109BE, 0x109BF, 1},
+		{0x10A00, 0x10A03, 1},
+		{0x10A05, 0x10A06, 1},
+		{0x10A0C, 0x10A13, 1},
+		{0x10A15, 0x10A17, 1},
+		{0x10A19, 0x10A35, 1},
+		{0x10A38, 0x10A3A, 1},
+		{0x10A3F, 0x10A3F, 1},
+		{0x10A60, 0x10A7C, 1},
+		{0x10A80, 0x10A9C, 1},
+		{0x10AC0, 0x10AC7, 1},
+		{0x10AC9, 0x10AE6, 1},
+		{0x10B00, 0x10B35, 1},
+		{0x10B40, 0x10B55, 1},
+		{0x10B60, 0x10B72, 1},
+		{0x10B80, 0x10B91, 1},
+		{0x10C00, 0x10C48, 1},
+		{0x10CC0, 0x10CF2, 1},
+		{0x10D00, 0x10D27, 1},
+		{0x10D30, 0x10D39, 1},
+		{0x10E80, 0x10EA9, 1},
+		{0x10EAB, 0x10EAC, 1},
+		{0x10EB0, 0x10EB1, 1},
+		{0x10EFD, 0x10F1C, 1},
+		{0x10F27, 0x10F27, 1},
+		{0x10F30, 0x10F50, 1},
+		{0x10F70, 0x10F85, 1},
+		{0x10FB0, 0x10FC4, 1},
+		{0x10FE0, 0x10FF6, 1},
+		{0x11000, 0x11046, 1},
+		{0x11066, 0x11075, 1},
+		{0x1107F, 0x110BA, 1},
+		{0x110C2, 0x110C2, 1},
+		{0x110D0, 0x110E8, 1},
+		{0x110F0, 0x110F9, 1},
+		{0x11100, 0x11134, 1},
+		{0x11136, 0x1113F, 1},
+		{0x11144, 0x11147, 1},
+		{0x11150, 0x11173, 1},
+		{0x11176, 0x11176, 1},
+		{0x11180, 0x111C4, 1},
+		{0x111C9, 0x111CC, 1},
+		{0x111CE, 0x111DA, 1},
+		{0x111DC, 0x111DC, 1},
+		{0x11200, 0x11211, 1},
+		{0x11213, 0x11237, 1},
+		{0x1123E, 0x11241, 1},
+		{0x11280, 0x11286, 1},
+		{0x11288, 0x11288, 1},
+		{0x1128A, 0x1128D, 1},
+		{0x1128F, 0x1129D, 1},
+		{0x1129F, 0x112A8, 1},
+		{0x112B0, 0x112EA, 1},
+		{0x112F0, 0x112F9, 1},
+		{0x11300, 0x11303, 1},
+		{0x11305, 0x1130C, 1},
+		{0x1130F, 0x11310, 1},
+		{0x11313, 0x11328, 1},
+		{0x1132A, 0x11330, 1},
+		{0x11332, 0x11333, 1},
+		{0x11335, 0x11339, 1},
+		{0x1133B, 0x11344, 1},
+		{0x11347, 0x11348, 1},
+		{0x1134B, 0x1134D, 1},
+		{0x11350, 0x11350, 1},
+		{0x11357, 0x11357, 1},
+		{0x1135D, 0x11363, 1},
+		{0x11366, 0x1136C, 1},
+		{0x11370, 0x11374, 1},
+		{0x11400, 0x1144A, 1},
+		{0x11450, 0x11459, 1},
+		{0x1145E, 0x11461, 1},
+		{0x11480, 0x114C5, 1},
+		{0x114C7, 0x114C7, 1},
+		{0x114D0, 0x114D9, 1},
+		{0x11580, 0x115B5, 1},
+		{0x115B8, 0x115C0, 1},
+		{0x115D8, 0x115DD, 1},
+		{0x11600, 0x11640, 1},
+		{0x11644, 0x11644, 1},
+		{0x11650, 0x11659, 1},
+		{0x11680, 0x116B8, 1},
+		{0x116C0, 0x116C9, 1},
+		{0x11700, 0x1171A, 1},
+		{0x1171D, 0x1172B, 1},
+		{0x11730, 0x11739, 1},
+		{0x11740, 0x11746, 1},
+		{0x11800, 0x1183A, 1},
+		{0x118C0, 0x118E9, 1},
+		{0x118FF, 0x11906, 1},
+		{0x11909, 0x11909, 1},
+		{0x1190C, 0x11913, 1},
+		{0x11915, 0x11916, 1},
+		{0x11918, 0x11935, 1},
+		{0x11937, 0x11938, 1},
+		{0x1193B, 0x11943, 1},
+		{0x11950, 0x11959, 1},
+		{0x119A0, 0x119A7, 1},
+		{0x119AA, 0x119D7, 1},
+		{0x119DA, 0x119E1, 1},
+		{0x119E3, 0x119E4, 1},
+		{0x11A00, 0x11A3E, 1},
+		{0x11A47, 0x11A47, 1},
+		{0x11A50, 0x11A99, 1},
+		{0x11A9D, 0x11A9D, 1},
+		{0x11AB0, 0x11AF8, 1},
+		{0x11C00, 0x11C08, 1},
+		{0x11C0A, 0x11C36, 1},
+		{0x11C38, 0x11C40, 1},
+		{0x11C50, 0x11C59, 1},
+		{0x11C72, 0x11C8F, 1},
+		{0x11C92, 0x11CA7, 1},
+		{0x11CA9, 0x11CB6, 1},
+		{0x11D00, 0x11D06, 1},
+		{0x11D08, 0x11D09, 1},
+		{0x11D0B, 0x11D36, 1},
+		{0x11D3A, 0x11D3A, 1},
+		{0x11D3C, 0x11D3D, 1},
+		{0x11D3F, 0x11D47, 1},
+		{0x11D50, 0x11D59, 1},
+		{0x11D60, 0x11D65, 1},
+		{0x11D67, 0x11D68, 1},
+		{0x11D6A, 0x11D8E, 1},
+		{0x11D90, 0x11D91, 1},
+		{0x11D93, 0x11D98, 1},
+		{0x11DA0, 0x11DA9, 1},
+		{0x11EE0, 0x11EF6, 1},
+		{0x11F00, 0x11F10, 1},
+		{0x11F12, 0x11F3A, 1},
+		{0x11F3E, 0x11F42, 1},
+		{0x11F50, 0x11F59, 1},
+		{0x11FB0, 0x11FB0, 1},
+		{0x12000, 0x12399, 1},
+		{0x12480, 0x12543, 1},
+		{0x12F90, 0x12FF0, 1},
+		{0x13000, 0x1342F, 1},
+		{0x13440, 0x13455, 1},
+		{0x14400, 0x14646, 1},
+		{0x16800, 0x16A38, 1},
+		{0x16A40, 0x16A5E, 1},
+		{0x16A60, 0x16A69, 1},
+		{0x16A70, 0x16ABE, 1},
+		{0x16AC0, 0x16AC9, 1},
+		{0x16AD0, 0x16AED, 1},
+		{0x16AF0, 0x16AF4, 1},
+		{0x16B00, 0x16B36, 1},
+		{0x16B40, 0x16B43, 1},
+		{0x16B50, 0x16B59, 1},
+		{0x16B63, 0x16B77, 1},
+		{0x16B7D, 0x16B8F, 1},
+		{0x16E60, 0x16E7F, 1},
+		{0x16F00, 0x16F4A, 1},
+		{0x16F4F, 0x16F87, 1},
+		{0x16F8F, 0x16F9F, 1},
+		{0x16FE0, 0x16FE1, 1},
+		{0x16FE3, 0x16FE4, 1},
+		{0x16FF0, 0x16FF1, 1},
+		{0x17000, 0x187F7, 1},
+		{0x18800, 0x18CD5, 1},
+		{0x18D00, 0x18D08, 1},
+		{0x1AFF0, 0x1AFF3, 1},
+		{0x1AFF5, 0x1AFFB, 1},
+		{0x1AFFD, 0x1AFFE, 1},
+		{0x1B000, 0x1B122, 1},
+		{0x1B132, 0x1B132, 1},
+		{0x1B150, 0x1B152, 1},
+		{0x1B155, 0x1B155, 1},
+		{0x1B164, 0x1B167, 1},
+		{0x1B170, 0x1B2FB, 1},
+		{0x1BC00, 0x1BC6A, 1},
+		{0x1BC70, 0x1BC7C, 1},
+		{0x1BC80, 0x1BC88, 1},
+		{0x1BC90, 0x1BC99, 1},
+		{0x1BC9D, 0x1BC9E, 1},
+		{0x1CF00, 0x1CF2D, 1},
+		{0x1CF30, 0x1CF46, 1},
+		{0x1D165, 0x1D169, 1},
+		{0x1D16D, 0x1D172, 1},
+		{0x1D17B, 0x1D182, 1},
+		{0x1D185, 0x1D18B, 1},
+		{0x1D1AA, 0x1D1AD, 1},
+		{0x1D242, 0x1D244, 1},
+		{0x1DA00, 0x1DA36, 1},
+		{0x1DA3B, 0x1DA6C, 1},
+		{0x1DA75, 0x1DA75, 1},
+		{0x1DA84, 0x1DA84, 1},
+		{0x1DA9B, 0x1DA9F, 1},
+		{0x1DAA1, 0x1DAAF, 1},
+		{0x1DF00, 0x1DF1E, 1},
+		{0x1DF25, 0x1DF2A, 1},
+		{0x1E000, 0x1E006, 1},
+		{0x1E008, 0x1E018, 1},
+		{0x1E01B, 0x1E021, 1},
+		{0x1E023, 0x1E024, 1},
+		{0x1E026, 0x1E02A, 1},
+		{0x1E08F, 0x1E08F, 1},
+		{0x1E100, 0x1E12C, 1},
+		{0x1E130, 0x1E13D, 1},
+		{0x1E140, 0x1E149, 1},
+		{0x1E14E, 0x1E14E, 1},
+		{0x1E290, 0x1E2AE, 1},
+		{0x1E2C0, 0x1E2F9, 1},
+		{0x1E4D0, 0x1E4F9, 1},
+		{0x1E7E0, 0x1E7E6, 1},
+		{0x1E7E8, 0x1E7EB, 1},
+		{0x1E7ED, 0x1E7EE, 1},
+		{0x1E7F0, 0x1E7FE, 1},
+		{0x1E800, 0x1E8C4, 1},
+		{0x1E8D0, 0x1E8D6, 1},
+		{0x1E922, 0x1E94B, 1},
+		{0x1E950, 0x1E959, 1},
+		{0x20000, 0x2A6DF, 1},
+		{0x2A700, 0x2B739, 1},
+		{0x2B740, 0x2B81D, 1},
+		{0x2B820, 0x2CEA1, 1},
+		{0x2CEB0, 0x2EBE0, 1},
+		{0x30000, 0x3134A, 1},
+		{0x31350, 0x323AF, 1},
+	},
+	LatinOffset: 8,
+}
+
+var normalizationTable1 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00AD, 0x00AD, 1},
+		{0x034F, 0x034F, 1},
+		{0x180B, 0x180D, 1},
+		{0x180F, 0x180F, 1},
+		{0x200B, 0x200B, 1},
+		{0x2060, 0x2060, 1},
+		{0x2064, 0x2064, 1},
+		{0xFE00, 0xFE0F, 1},
+		{0xFEFF, 0xFEFF, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1BCA0, 0x1BCA3, 1},
+		{0xE0100, 0xE01EF, 1},
+	},
+	LatinOffset: 1,
+}
+
+var normalizationTable2 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0300, 0x033F, 1},
+		{0x0342, 0x0342, 1},
+		{0x0346, 0x034E, 1},
+		{0x0350, 0x036F, 1},
+		{0x0483, 0x0487, 1},
+		{0x0591, 0x05BD, 1},
+		{0x05BF, 0x05BF, 1},
+		{0x05C1, 0x05C2, 1},
+		{0x05C4, 0x05C5, 1},
+		{0x05C7, 0x05C7, 1},
+		{0x0610, 0x061A, 1},
+		{0x064B, 0x065F, 1},
+		{0x0670, 0x0670, 1},
+		{0x06D6, 0x06DC, 1},
+		{0x06DF, 0x06E4, 1},
+		{0x06E7, 0x06E8, 1},
+		{0x06EA, 0x06ED, 1},
+		{0x0711, 0x0711, 1},
+		{0x0730, 0x074A, 1},
+		{0x07A6, 0x07B0, 1},
+		{0x07EB, 0x07F3, 1},
+		{0x07FD, 0x07FD, 1},
+		{0x0816, 0x0819, 1},
+		{0x081B, 0x0823, 1},
+		{0x0825, 0x0827, 1},
+		{0x0829, 0x082D, 1},
+		{0x0859, 0x085B, 1},
+		{0x0898, 0x089F, 1},
+		{0x08CA, 0x08E1, 1},
+		{0x08E3, 0x0903, 1},
+		{0x093A, 0x093C, 1},
+		{0x093E, 0x094F, 1},
+		{0x0951, 0x0957, 1},
+		{0x0962, 0x0963, 1},
+		{0x0981, 0x0983, 1},
+		{0x09BC, 0x09BC, 1},
+		{0x09BE, 0x09C4, 1},
+		{0x09C7, 0x09C8, 1},
+		{0x09CB, 0x09CD, 1},
+		{0x09D7, 0x09D7, 1},
+		{0x09E2, 0x09E3, 1},
+		{0x09FE, 0x09FE, 1},
+		{0x0A01, 0x0A03, 1},
+		{0x0A3C, 0x0A3C, 1},
+		{0x0A3E, 0x0A42, 1},
+		{0x0A47, 0x0A48, 1},
+		{0x0A4B, 0x0A4D, 1},
+		{0x0A51, 0x0A51, 1},
+		{0x0A70, 0x0A71, 1},
+		{0x0A75, 0x0A75, 1},
+		{0x0A81, 0x0A83, 1},
+		{0x0ABC, 0x0ABC, 1},
+		{0x0ABE, 0x0AC5, 1},
+		{0x0AC7, 0x0AC9, 1},
+		{0x0ACB, 0x0ACD, 1},
+		{0x0AE2, 0x0AE3, 1},
+		{0x0AFA, 0x0AFF, 1},
+		{0x0B01, 0x0B03, 1},
+		{0x0B3C, 0x0B3C, 1},
+		{0x0B3E, 0x0B44, 1},
+		{0x0B47, 0x0B48, 1},
+		{0x0B4B, 0x0B4D, 1},
+		{0x0B55, 0x0B57, 1},
+		{0x0B62, 0x0B63, 1},
+		{0x0B82, 0x0B82, 1},
+		{0x0BBE, 0x0BC2, 1},
+		{0x0BC6, 0x0BC8, 1},
+		{0x0BCA, 0x0BCD, 1},
+		{0x0BD7, 0x0BD7, 1},
+		{0x0C00, 0x0C04, 1},
+		{0x0C3C, 0x0C3C, 1},
+		{0x0C3E, 0x0C44, 1},
+		{0x0C46, 0x0C48, 1},
+		{0x0C4A, 0x0C4D, 1},
+		{0x0C55, 0x0C56, 1},
+		{0x0C62, 0x0C63, 1},
+		{0x0C81, 0x0C83, 1},
+		{0x0CBC, 0x0CBC, 1},
+		{0x0CBE, 0x0CC4, 1},
+		{0x0CC6, 0x0CC8, 1},
+		{0x0CCA, 0x0CCD, 1},
+		{0x0CD5, 0x0CD6, 1},
+		{0x0CE2, 0x0CE3, 1},
+		{0x0CF3, 0x0CF3, 1},
+		{0x0D00, 0x0D03, 1},
+		{0x0D3B, 0x0D3C, 1},
+		{0x0D3E, 0x0D44, 1},
+		{0x0D46, 0x0D48, 1},
+		{0x0D4A, 0x0D4D, 1},
+		{0x0D57, 0x0D57, 1},
+		{0x0D62, 0x0D63, 1},
+		{0x0D81, 0x0D83, 1},
+		{0x0DCA, 0x0DCA, 1},
+		{0x0DCF, 0x0DD4, 1},
+		{0x0DD6, 0x0DD6, 1},
+		{0x0DD8, 0x0DDF, 1},
+		{0x0DF2, 0x0DF3, 1},
+		{0x0E31, 0x0E31, 1},
+		{0x0E34, 0x0E3A, 1},
+		{0x0E47, 0x0E4E, 1},
+		{0x0EB1, 0x0EB1, 1},
+		{0x0EB4, 0x0EBC, 1},
+		{0x0EC8, 0x0ECE, 1},
+		{0x0F18, 0x0F19, 1},
+		{0x0F35, 0x0F35, 1},
+		{0x0F37, 0x0F37, 1},
+		{0x0F39, 0x0F39, 1},
+		{0x0F3E, 0x0F3F, 1},
+		{0x0F71, 0x0F72, 1},
+		{0x0F74, 0x0F74, 1},
+		{0x0F7A, 0x0F80, 1},
+		{0x0F82, 0x0F84, 1},
+		{0x0F86, 0x0F87, 1},
+		{0x0F8D, 0x0F92, 1},
+		{0x0F94, 0x0F97, 1},
+		{0x0F99, 0x0F9C, 1},
+		{0x0F9E, 0x0FA1, 1},
+		{0x0FA3, 0x0FA6, 1},
+		{0x0FA8, 0x0FAB, 1},
+		{0x0FAD, 0x0FB8, 1},
+		{0x0FBA, 0x0FBC, 1},
+		{0x0FC6, 0x0FC6, 1},
+		{0x102B, 0x103E, 1},
+		{0x1056, 0x1059, 1},
+		{0x105E, 0x1060, 1},
+		{0x1062, 0x1064, 1},
+		{0x1067, 0x106D, 1},
+		{0x1071, 0x1074, 1},
+		{0x1082, 0x108D, 1},
+		{0x108F, 0x108F, 1},
+		{0x109A, 0x109D, 1},
+		{0x135D, 0x135F, 1},
+		{0x1712, 0x1715, 1},
+		{0x1732, 0x1734, 1},
+		{0x1752, 0x1753, 1},
+		{0x1772, 0x1773, 1},
+		{0x17B6, 0x17D3, 1},
+		{0x17DD, 0x17DD, 1},
+		{0x1885, 0x1886, 1},
+		{0x18A9, 0x18A9, 1},
+		{0x1920, 0x192B, 1},
+		{0x1930, 0x193B, 1},
+		{0x1A17, 0x1A1B, 1},
+		{0x1A55, 0x1A5E, 1},
+		{0x1A60, 0x1A7C, 1},
+		{0x1A7F, 0x1A7F, 1},
+		{0x1AB0, 0x1ABD, 1},
+		{0x1ABF, 0x1ACE, 1},
+		{0x1B00, 0x1B04, 1},
+		{0x1B34, 0x1B44, 1},
+		{0x1B6B, 0x1B73, 1},
+		{0x1B80, 0x1B82, 1},
+		{0x1BA1, 0x1BAD, 1},
+		{0x1BE6, 0x1BF3, 1},
+		{0x1C24, 0x1C37, 1},
+		{0x1CD0, 0x1CD2, 1},
+		{0x1CD4, 0x1CE8, 1},
+		{0x1CED, 0x1CED, 1},
+		{0x1CF4, 0x1CF4, 1},
+		{0x1CF7, 0x1CF9, 1},
+		{0x1DC0, 0x1DFF, 1},
+		{0x20D0, 0x20DC, 1},
+		{0x20E1, 0x20E1, 1},
+		{0x20E5, 0x20F0, 1},
+		{0x2CEF, 0x2CF1, 1},
+		{0x2D7F, 0x2D7F, 1},
+		{0x2DE0, 0x2DFF, 1},
+		{0x302A, 0x302F, 1},
+		{0x3099, 0x309A, 1},
+		{0xA66F, 0xA66F, 1},
+		{0xA674, 0xA67D, 1},
+		{0xA69E, 0xA69F, 1},
+		{0xA6F0, 0xA6F1, 1},
+		{0xA802, 0xA802, 1},
+		{0xA806, 0xA806, 1},
+		{0xA80B, 0xA80B, 1},
+		{0xA823, 0xA827, 1},
+		{0xA82C, 0xA82C, 1},
+		{0xA880, 0xA881, 1},
+		{0xA8B4, 0xA8C5, 1},
+		{0xA8E0, 0xA8F1, 1},
+		{0xA8FF, 0xA8FF, 1},
+		{0xA926, 0xA92D, 1},
+		{0xA947, 0xA953, 1},
+		{0xA980, 0xA983, 1},
+		{0xA9B3, 0xA9C0, 1},
+		{0xA9E5, 0xA9E5, 1},
+		{0xAA29, 0xAA36, 1},
+		{0xAA43, 0xAA43, 1},
+		{0xAA4C, 0xAA4D, 1},
+		{0xAA7B, 0xAA7D, 1},
+		{0xAAB0, 0xAAB0, 1},
+		{0xAAB2, 0xAAB4, 1},
+		{0xAAB7, 0xAAB8, 1},
+		{0xAABE, 0xAABF, 1},
+		{0xAAC1, 0xAAC1, 1},
+		{0xAAEB, 0xAAEF, 1},
+		{0xAAF5, 0xAAF6, 1},
+		{0xABE3, 0xABEA, 1},
+		{0xABEC, 0xABED, 1},
+		{0xFB1E, 0xFB1E, 1},
+		{0xFE20, 0xFE2F, 1},
+	},
+	R32: []unicode.Range32{
+		{0x101FD, 0x101FD, 1},
+		{0x102E0, 0x102E0, 1},
+		{0x10376, 0x1037A, 1},
+		{0x10A01, 0x10A03, 1},
+		{0x10A05, 0x10A06, 1},
+		{0x10A0C, 0x10A0F, 1},
+		{0x10A38, 0x10A3A, 1},
+		{0x10A3F, 0x10A3F, 1},
+		{0x10AE5, 0x10AE6, 1},
+		{0x10D24, 0x10D27, 1},
+		{0x10EAB, 0x10EAC, 1},
+		{0x10EFD, 0x10EFF, 1},
+		{0x10F46, 0x10F50, 1},
+		{0x10F82, 0x10F85, 1},
+		{0x11000, 0x11002, 1},
+		{0x11038, 0x11046, 1},
+		{0x11070, 0x11070, 1},
+		{0x11073, 0x11074, 1},
+		{0x1107F, 0x11082, 1},
+		{0x110B0, 0x110BA, 1},
+		{0x110C2, 0x110C2, 1},
+		{0x11100, 0x11102, 1},
+		{0x11127, 0x11134, 1},
+		{0x11145, 0x11146, 1},
+		{0x11173, 0x11173, 1},
+		{0x11180, 0x11182, 1},
+		{0x111B3, 0x111C0, 1},
+		{0x111C9, 0x111CC, 1},
+		{0x111CE, 0x111CF, 1},
+		{0x1122C, 0x11237, 1},
+		{0x1123E, 0x1123E, 1},
+		{0x11241, 0x11241, 1},
+		{0x112DF, 0x112EA, 1},
+		{0x11300, 0x11303, 1},
+		{0x1133B, 0x1133C, 1},
+		{0x1133E, 0x11344, 1},
+		{0x11347, 0x11348, 1},
+		{0x1134B, 0x1134D, 1},
+		{0x11357, 0x11357, 1},
+		{0x11362, 0x11363, 1},
+		{0x11366, 0x1136C, 1},
+		{0x11370, 0x11374, 1},
+		{0x11435, 0x11446, 1},
+		{0x1145E, 0x1145E, 1},
+		{0x114B0, 0x114C3, 1},
+		{0x115AF, 0x115B5, 1},
+		{0x115B8, 0x115C0, 1},
+		{0x115DC, 0x115DD, 1},
+		{0x11630, 0x11640, 1},
+		{0x116AB, 0x116B7, 1},
+		{0x1171D, 0x1172B, 1},
+		{0x1182C, 0x1183A, 1},
+		{0x11930, 0x11935, 1},
+		{0x11937, 0x11938, 1},
+		{0x1193B, 0x1193E, 1},
+		{0x11940, 0x11940, 1},
+		{0x11942, 0x11943, 1},
+		{0x119D1, 0x119D7, 1},
+		{0x119DA, 0x119E0, 1},
+		{0x119E4, 0x119E4, 1},
+		{0x11A01, 0x11A0A, 1},
+		{0x11A33, 0x11A39, 1},
+		{0x11A3B, 0x11A3E, 1},
+		{0x11A47, 0x11A47, 1},
+		{0x11A51, 0x11A5B, 1},
+		{0x11A8A, 0x11A99, 1},
+		{0x11C2F, 0x11C36, 1},
+		{0x11C38, 0x11C3F, 1},
+		{0x11C92, 0x11CA7, 1},
+		{0x11CA9, 0x11CB6, 1},
+		{0x11D31, 0x11D36, 1},
+		{0x11D3A, 0x11D3A, 1},
+		{0x11D3C, 0x11D3D, 1},
+		{0x11D3F, 0x11D45, 1},
+		{0x11D47, 0x11D47, 1},
+		{0x11D8A, 0x11D8E, 1},
+		{0x11D90, 0x11D91, 1},
+		{0x11D93, 0x11D97, 1},
+		{0x11EF3, 0x11EF6, 1},
+		{0x11F00, 0x11F01, 1},
+		{0x11F03, 0x11F03, 1},
+		{0x11F34, 0x11F3A, 1},
+		{0x11F3E, 0x11F42, 1},
+		{0x13440, 0x13440, 1},
+		{0x13447, 0x13455, 1},
+		{0x16AF0, 0x16AF4, 1},
+		{0x16B30, 0x16B36, 1},
+		{0x16F4F, 0x16F4F, 1},
+		{0x16F51, 0x16F87, 1},
+		{0x16F8F, 0x16F92, 1},
+		{0x16FE4, 0x16FE4, 1},
+		{0x16FF0, 0x16FF1, 1},
+		{0x1BC9D, 0x1BC9E, 1},
+		{0x1CF00, 0x1CF2D, 1},
+		{0x1CF30, 0x1CF46, 1},
+		{0x1D165, 0x1D169, 1},
+		{0x1D16D, 0x1D172, 1},
+		{0x1D17B, 0x1D182, 1},
+		{0x1D185, 0x1D18B, 1},
+		{0x1D1AA, 0x1D1AD, 1},
+		{0x1D242, 0x1D244, 1},
+		{0x1DA00, 0x1DA36, 1},
+		{0x1DA3B, 0x1DA6C, 1},
+		{0x1DA75, 0x1DA75, 1},
+		{0x1DA84, 0x1DA84, 1},
+		{0x1DA9B, 0x1DA9F, 1},
+		{0x1DAA1, 0x1DAAF, 1},
+		{0x1E000, 0x1E006, 1},
+		{0x1E008, 0x1E018, 1},
+		{0x1E01B, 0x1E021, 1},
+		{0x1E023, 0x1E024, 1},
+		{0x1E026, 0x1E02A, 1},
+		{0x1E08F, 0x1E08F, 1},
+		{0x1E130, 0x1E136, 1},
+		{0x1E2AE, 0x1E2AE, 1},
+		{0x1E2EC, 0x1E2EF, 1},
+		{0x1E4EC, 0x1E4EF, 1},
+		{0x1E8D0, 0x1E8D6, 1},
+		{0x1E944, 0x1E94A, 1},
+	},
+}
+
+var normalizationTable3 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0300, 0x033F, 1},
+		{0x0342, 0x0342, 1},
+		{0x0346, 0x034E, 1},
+		{0x0350, 0x036F, 1},
+		{0x0483, 0x0487, 1},
+		{0x0591, 0x05BD, 1},
+		{0x05BF, 0x05BF, 1},
+		{0x05C1, 0x05C2, 1},
+		{0x05C4, 0x05C5, 1},
+		{0x05C7, 0x05C7, 1},
+		{0x0610, 0x061A, 1},
+		{0x064B, 0x065F, 1},
+		{0x0670, 0x0670, 1},
+		{0x06D6, 0x06DC, 1},
+		{0x06DF, 0x06E4, 1},
+		{0x06E7, 0x06E8, 1},
+		{0x06EA, 0x06ED, 1},
+		{0x0711, 0x0711, 1},
+		{0x0730, 0x074A, 1},
+		{0x07A6, 0x07B0, 1},
+		{0x07EB, 0x07F3, 1},
+		{0x07FD, 0x07FD, 1},
+		{0x0816, 0x0819, 1},
+		{0x081B, 0x0823, 1},
+		{0x0825, 0x0827, 1},
+		{0x0829, 0x082D, 1},
+		{0x0859, 0x085B, 1},
+		{0x0898, 0x089F, 1},
+		{0x08CA, 0x08E1, 1},
+		{0x08E3, 0x0902, 1},
+		{0x093A, 0x093A, 1},
+		{0x093C, 0x093C, 1},
+		{0x0941, 0x0948, 1},
+		{0x094D, 0x094D, 1},
+		{0x0951, 0x0957, 1},
+		{0x0962, 0x0963, 1},
+		{0x0981, 0x0981, 1},
+		{0x09BC, 0x09BC, 1},
+		{0x09C1, 0x09C4, 1},
+		{0x09CD, 0x09CD, 1},
+		{0x09E2, 0x09E3, 1},
+		{0x09FE, 0x09FE, 1},
+		{0x0A01, 0x0A02, 1},
+		{0x0A3C, 0x0A3C, 1},
+		{0x0A41, 0x0A42, 1},
+		{0x0A47, 0x0A48, 1},
+		{0x0A4B, 0x0A4D, 1},
+		{0x0A51, 0x0A51, 1},
+		{0x0A70, 0x0A71, 1},
+		{0x0A75, 0x0A75, 1},
+		{0x0A81, 0x0A82, 1},
+		{0x0ABC, 0x0ABC, 1},
+		{0x0AC1, 0x0AC5, 1},
+		{0x0AC7, 0x0AC8, 1},
+		{0x0ACD, 0x0ACD, 1},
+		{0x0AE2, 0x0AE3, 1},
+		{0x0AFA, 0x0AFF, 1},
+		{0x0B01, 0x0B01, 1},
+		{0x0B3C, 0x0B3C, 1},
+		{0x0B3F, 0x0B3F, 1},
+		{0x0B41, 0x0B44, 1},
+		{0x0B4D, 0x0B4D, 1},
+		{0x0B55, 0x0B56, 1},
+		{0x0B62, 0x0B63, 1},
+		{0x0B82, 0x0B82, 1},
+		{0x0BC0, 0x0BC0, 1},
+		{0x0BCD, 0x0BCD, 1},
+		{0x0C00, 0x0C00, 1},
+		{0x0C04, 0x0C04, 1},
+		{0x0C3C, 0x0C3C, 1},
+		{0x0C3E, 0x0C40, 1},
+		{0x0C46, 0x0C48, 1},
+		{0x0C4A, 0x0C4D, 1},
+		{0x0C55, 0x0C56, 1},
+		{0x0C62, 0x0C63, 1},
+		{0x0C81, 0x0C81, 1},
+		{0x0CBC, 0x0CBC, 1},
+		{0x0CBF, 0x0CBF, 1},
+		{0x0CC6, 0x0CC6, 1},
+		{0x0CCC, 0x0CCD, 1},
+		{0x0CE2, 0x0CE3, 1},
+		{0x0D00, 0x0D01, 1},
+		{0x0D3B, 0x0D3C, 1},
+		{0x0D41, 0x0D44, 1},
+		{0x0D4D, 0x0D4D, 1},
+		{0x0D62, 0x0D63, 1},
+		{0x0D81, 0x0D81, 1},
+		{0x0DCA, 0x0DCA, 1},
+		{0x0DD2, 0x0DD4, 1},
+		{0x0DD6, 0x0DD6, 1},
+		{0x0E31, 0x0E31, 1},
+		{0x0E34, 0x0E3A, 1},
+		{0x0E47, 0x0E4E, 1},
+		{0x0EB1, 0x0EB1, 1},
+		{0x0EB4, 0x0EBC, 1},
+		{0x0EC8, 0x0ECE, 1},
+		{0x0F18, 0x0F19, 1},
+		{0x0F35, 0x0F35, 1},
+		{0x0F37, 0x0F37, 1},
+		{0x0F39, 0x0F39, 1},
+		{0x0F71, 0x0F72, 1},
+		{0x0F74, 0x0F74, 1},
+		{0x0F7A, 0x0F7E, 1},
+		{0x0F80, 0x0F80, 1},
+		{0x0F82, 0x0F84, 1},
+		{0x0F86, 0x0F87, 1},
+		{0x0F8D, 0x0F92, 1},
+		{0x0F94, 0x0F97, 1},
+		{0x0F99, 0x0F9C, 1},
+		{0x0F9E, 0x0FA1, 1},
+		{0x0FA3, 0x0FA6, 1},
+		{0x0FA8, 0x0FAB, 1},
+		{0x0FAD, 0x0FB8, 1},
+		{0x0FBA, 0x0FBC, 1},
+		{0x0FC6, 0x0FC6, 1},
+		{0x102D, 0x1030, 1},
+		{0x1032, 0x1037, 1},
+		{0x1039, 0x103A, 1},
+		{0x103D, 0x103E, 1},
+		{0x1058, 0x1059, 1},
+		{0x105E, 0x1060, 1},
+		{0x1071, 0x1074, 1},
+		{0x1082, 0x1082, 1},
+		{0x1085, 0x1086, 1},
+		{0x108D, 0x108D, 1},
+		{0x109D, 0x109D, 1},
+		{0x135D, 0x135F, 1},
+		{0x1712, 0x1714, 1},
+		{0x1732, 0x1733, 1},
+		{0x1752, 0x1753, 1},
+		{0x1772, 0x1773, 1},
+		{0x17B7, 0x17BD, 1},
+		{0x17C6, 0x17C6, 1},
+		{0x17C9, 0x17D3, 1},
+		{0x17DD, 0x17DD, 1},
+		{0x1885, 0x1886, 1},
+		{0x18A9, 0x18A9, 1},
+		{0x1920, 0x1922, 1},
+		{0x1927, 0x1928, 1},
+		{0x1932, 0x1932, 1},
+		{0x1939, 0x193B, 1},
+		{0x1A17, 0x1A18, 1},
+		{0x1A1B, 0x1A1B, 1},
+		{0x1A56, 0x1A56, 1},
+		{0x1A58, 0x1A5E, 1},
+		{0x1A60, 0x1A60, 1},
+		{0x1A62, 0x1A62, 1},
+		{0x1A65, 0x1A6C, 1},
+		{0x1A73, 0x1A7C, 1},
+		{0x1A7F, 0x1A7F, 1},
+		{0x1AB0, 0x1ABD, 1},
+		{0x1ABF, 0x1ACE, 1},
+		{0x1B00, 0x1B03, 1},
+		{0x1B34, 0x1B34, 1},
+		{0x1B36, 0x1B3A, 1},
+		{0x1B3C, 0x1B3C, 1},
+		{0x1B42, 0x1B42, 1},
+		{0x1B6B, 0x1B73, 1},
+		{0x1B80, 0x1B81, 1},
+		{0x1BA2, 0x1BA5, 1},
+		{0x1BA8, 0x1BA9, 1},
+		{0x1BAB, 0x1BAD, 1},
+		{0x1BE6, 0x1BE6, 1},
+		{0x1BE8, 0x1BE9, 1},
+		{0x1BED, 0x1BED, 1},
+		{0x1BEF, 0x1BF1, 1},
+		{0x1C2C, 0x1C33, 1},
+		{0x1C36, 0x1C37, 1},
+		{0x1CD0, 0x1CD2, 1},
+		{0x1CD4, 0x1CE0, 1},
+		{0x1CE2, 0x1CE8, 1},
+		{0x1CED, 0x1CED, 1},
+		{0x1CF4, 0x1CF4, 1},
+		{0x1CF8, 0x1CF9, 1},
+		{0x1DC0, 0x1DFF, 1},
+		{0x20D0, 0x20DC, 1},
+		{0x20E1, 0x20E1, 1},
+		{0x20E5, 0x20F0, 1},
+		{0x2CEF, 0x2CF1, 1},
+		{0x2D7F, 0x2D7F, 1},
+		{0x2DE0, 0x2DFF, 1},
+		{0x302A, 0x302D, 1},
+		{0x3099, 0x309A, 1},
+		{0xA66F, 0xA66F, 1},
+		{0xA674, 0xA67D, 1},
+		{0xA69E, 0xA69F, 1},
+		{0xA6F0, 0xA6F1, 1},
+		{0xA802, 0xA802, 1},
+		{0xA806, 0xA806, 1},
+		{0xA80B, 0xA80B, 1},
+		{0xA825, 0xA826, 1},
+		{0xA82C, 0xA82C, 1},
+		{0xA8C4, 0xA8C5, 1},
+		{0xA8E0, 0xA8F1, 1},
+		{0xA8FF, 0xA8FF, 1},
+		{0xA926, 0xA92D, 1},
+		{0xA947, 0xA951, 1},
+		{0xA980, 0xA982, 1},
+		{0xA9B3, 0xA9B3, 1},
+		{0xA9B6, 0xA9B9, 1},
+		{0xA9BC, 0xA9BD, 1},
+		{0xA9E5, 0xA9E5, 1},
+		{0xAA29, 0xAA2E, 1},
+		{0xAA31, 0xAA32, 1},
+		{0xAA35, 0xAA36, 1},
+		{0xAA43, 0xAA43, 1},
+		{0xAA4C, 0xAA4C, 1},
+		{0xAA7C, 0xAA7C, 1},
+		{0xAAB0, 0xAAB0, 1},
+		{0xAAB2, 0xAAB4, 1},
+		{0xAAB7, 0xAAB8, 1},
+		{0xAABE, 0xAABF, 1},
+		{0xAAC1, 0xAAC1, 1},
+		{0xAAEC, 0xAAED, 1},
+		{0xAAF6, 0xAAF6, 1},
+		{0xABE5, 0xABE5, 1},
+		{0xABE8, 0xABE8, 1},
+		{0xABED, 0xABED, 1},
+		{0xFB1E, 0xFB1E, 1},
+		{0xFE20, 0xFE2F, 1},
+	},
+	R32: []unicode.Range32{
+		{0x101FD, 0x101FD, 1},
+		{0x102E0, 0x102E0, 1},
+		{0x10376, 0x1037A, 1},
+		{0x10A01, 0x10A03, 1},
+		{0x10A05, 0x10A06, 1},
+		{0x10A0C, 0x10A0F, 1},
+		{0x10A38, 0x10A3A, 1},
+		{0x10A3F, 0x10A3F, 1},
+		{0x10AE5, 0x10AE6, 1},
+		{0x10D24, 0x10D27, 1},
+		{0x10EAB, 0x10EAC, 1},
+		{0x10EFD, 0x10EFF, 1},
+		{0x10F46, 0x10F50, 1},
+		{0x10F82, 0x10F85, 1},
+		{0x11001, 0x11001, 1},
+		{0x11038, 0x11046, 1},
+		{0x11070, 0x11070, 1},
+		{0x11073, 0x11074, 1},
+		{0x1107F, 0x11081, 1},
+		{0x110B3, 0x110B6, 1},
+		{0x110B9, 0x110BA, 1},
+		{0x110C2, 0x110C2, 1},
+		{0x11100, 0x11102, 1},
+		{0x11127, 0x1112B, 1},
+		{0x1112D, 0x11134, 1},
+		{0x11173, 0x11173, 1},
+		{0x11180, 0x11181, 1},
+		{0x111B6, 0x111BE, 1},
+		{0x111C9, 0x111CC, 1},
+		{0x111CF, 0x111CF, 1},
+		{0x1122F, 0x11231, 1},
+		{0x11234, 0x11234, 1},
+		{0x11236, 0x11237, 1},
+		{0x1123E, 0x1123E, 1},
+		{0x11241, 0x11241, 1},
+		{0x112DF, 0x112DF, 1},
+		{0x112E3, 0x112EA, 1},
+		{0x11300, 0x11301, 1},
+		{0x1133B, 0x1133C, 1},
+		{0x11340, 0x11340, 1},
+		{0x11366, 0x1136C, 1},
+		{0x11370, 0x11374, 1},
+		{0x11438, 0x1143F, 1},
+		{0x11442, 0x11444, 1},
+		{0x11446, 0x11446, 1},
+		{0x1145E, 0x1145E, 1},
+		{0x114B3, 0x114B8, 1},
+		{0x114BA, 0x114BA, 1},
+		{0x114BF, 0x114C0, 1},
+		{0x114C2, 0x114C3, 1},
+		{0x115B2, 0x115B5, 1},
+		{0x115BC, 0x115BD, 1},
+		{0x115BF, 0x115C0, 1},
+		{0x115DC, 0x115DD, 1},
+		{0x11633, 0x1163A, 1},
+		{0x1163D, 0x1163D, 1},
+		{0x1163F, 0x11640, 1},
+		{0x116AB, 0x116AB, 1},
+		{0x116AD, 0x116AD, 1},
+		{0x116B0, 0x116B5, 1},
+		{0x116B7, 0x116B7, 1},
+		{0x1171D, 0x1171D, 1},
+		{0x1171F, 0x1171F, 1},
+		{0x11722, 0x11725, 1},
+		{0x11727, 0x1172B, 1},
+		{0x1182F, 0x11837, 1},
+		{0x11839, 0x1183A, 1},
+		{0x1193B, 0x1193C, 1},
+		{0x1193E, 0x1193E, 1},
+		{0x11943, 0x11943, 1},
+		{0x119D4, 0x119D7, 1},
+		{0x119DA, 0x119DB, 1},
+		{0x119E0, 0x119E0, 1},
+		{0x11A01, 0x11A0A, 1},
+		{0x11A33, 0x11A38, 1},
+		{0x11A3B, 0x11A3E, 1},
+		{0x11A47, 0x11A47, 1},
+		{0x11A51, 0x11A56, 1},
+		{0x11A59, 0x11A5B, 1},
+		{0x11A8A, 0x11A96, 1},
+		{0x11A98, 0x11A99, 1},
+		{0x11C30, 0x11C36, 1},
+		{0x11C38, 0x11C3D, 1},
+		{0x11C3F, 0x11C3F, 1},
+		{0x11C92, 0x11CA7, 1},
+		{0x11CAA, 0x11CB0, 1},
+		{0x11CB2, 0x11CB3, 1},
+		{0x11CB5, 0x11CB6, 1},
+		{0x11D31, 0x11D36, 1},
+		{0x11D3A, 0x11D3A, 1},
+		{0x11D3C, 0x11D3D, 1},
+		{0x11D3F, 0x11D45, 1},
+		{0x11D47, 0x11D47, 1},
+		{0x11D90, 0x11D91, 1},
+		{0x11D95, 0x11D95, 1},
+		{0x11D97, 0x11D97, 1},
+		{0x11EF3, 0x11EF4, 1},
+		{0x11F00, 0x11F01, 1},
+		{0x11F36, 0x11F3A, 1},
+		{0x11F40, 0x11F40, 1},
+		{0x11F42, 0x11F42, 1},
+		{0x13440, 0x13440, 1},
+		{0x13447, 0x13455, 1},
+		{0x16AF0, 0x16AF4, 1},
+		{0x16B30, 0x16B36, 1},
+		{0x16F4F, 0x16F4F, 1},
+		{0x16F8F, 0x16F92, 1},
+		{0x16FE4, 0x16FE4, 1},
+		{0x1BC9D, 0x1BC9E, 1},
+		{0x1CF00, 0x1CF2D, 1},
+		{0x1CF30, 0x1CF46, 1},
+		{0x1D167, 0x1D169, 1},
+		{0x1D17B, 0x1D182, 1},
+		{0x1D185, 0x1D18B, 1},
+		{0x1D1AA, 0x1D1AD, 1},
+		{0x1D242, 0x1D244, 1},
+		{0x1DA00, 0x1DA36, 1},
+		{0x1DA3B, 0x1DA6C, 1},
+		{0x1DA75, 0x1DA75, 1},
+		{0x1DA84, 0x1DA84, 1},
+		{0x1DA9B, 0x1DA9F, 1},
+		{0x1DAA1, 0x1DAAF, 1},
+		{0x1E000, 0x1E006, 1},
+		{0x1E008, 0x1E018, 1},
+		{0x1E01B, 0x1E021, 1},
+		{0x1E023, 0x1E024, 1},
+		{0x1E026, 0x1E02A, 1},
+		{0x1E08F, 0x1E08F, 1},
+		{0x1E130, 0x1E136, 1},
+		{0x1E2AE, 0x1E2AE, 1},
+		{0x1E2EC, 0x1E2EF, 1},
+		{0x1E4EC, 0x1E4EF, 1},
+		{0x1E8D0, 0x1E8D6, 1},
+		{0x1E944, 0x1E94A, 1},
+	},
+}
+
+var normalizationTable4 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0061, 0x007A, 1},
+		{0x00DF, 0x00F6, 1},
+		{0x00F8, 0x00FF, 1},
+		{0x0101, 0x0101, 1},
+		{0x0103, 0x0103, 1},
+		{0x0105, 0x0105, 1},
+		{0x0107, 0x0107, 1},
+		{0x0109, 0x0109, 1},
+		{0x010B, 0x010B, 1},
+		{0x010D, 0x010D, 1},
+		{0x010F, 0x010F, 1},
+		{0x0111, 0x0111, 1},
+		{0x0113, 0x0113, 1},
+		{0x0115, 0x0115, 1},
+		{0x0117, 0x0117, 1},
+		{0x0119, 0x0119, 1},
+		{0x011B, 0x011B, 1},
+		{0x011D, 0x011D, 1},
+		{0x011F, 0x011F, 1},
+		{0x0121, 0x0121, 1},
+		{0x0123, 0x0123, 1},
+		{0x0125, 0x0125, 1},
+		{0x0127, 0x0127, 1},
+		{0x0129, 0x0129, 1},
+		{0x012B, 0x012B, 1},
+		{0x012D, 0x012D, 1},
+		{0x012F, 0x012F, 1},
+		{0x0131, 0x0131, 1},
+		{0x0135, 0x0135, 1},
+		{0x0137, 0x0138, 1},
+		{0x013A, 0x013A, 1},
+		{0x013C, 0x013C, 1},
+		{0x013E, 0x013E, 1},
+		{0x0142, 0x0142, 1},
+		{0x0144, 0x0144, 1},
+		{0x0146, 0x0146, 1},
+		{0x0148, 0x0148, 1},
+		{0x014B, 0x014B, 1},
+		{0x014D, 0x014D, 1},
+		{0x014F, 0x014F, 1},
+		{0x0151, 0x0151, 1},
+		{0x0153, 0x0153, 1},
+		{0x0155, 0x0155, 1},
+		{0x0157, 0x0157, 1},
+		{0x0159, 0x0159, 1},
+		{0x015B, 0x015B, 1},
+		{0x015D, 0x015D, 1},
+		{0x015F, 0x015F, 1},
+		{0x0161, 0x0161, 1},
+		{0x0163, 0x0163, 1},
+		{0x0165, 0x0165, 1},
+		{0x0167, 0x0167, 1},
+		{0x0169, 0x0169, 1},
+		{0x016B, 0x016B, 1},
+		{0x016D, 0x016D, 1},
+		{0x016F, 0x016F, 1},
+		{0x0171, 0x0171, 1},
+		{0x0173, 0x0173, 1},
+		{0x0175, 0x0175, 1},
+		{0x0177, 0x0177, 1},
+		{0x017A, 0x017A, 1},
+		{0x017C, 0x017C, 1},
+		{0x017E, 0x017E, 1},
+		{0x0180, 0x0180, 1},
+		{0x0183, 0x0183, 1},
+		{0x0185, 0x0185, 1},
+		{0x0188, 0x0188, 1},
+		{0x018C, 0x018D, 1},
+		{0x0192, 0x0192, 1},
+		{0x0195, 0x0195, 1},
+		{0x0199, 0x019B, 1},
+		{0x019E, 0x019E, 1},
+		{0x01A1, 0x01A1, 1},
+		{0x01A3, 0x01A3, 1},
+		{0x01A5, 0x01A5, 1},
+		{0x01A8, 0x01A8, 1},
+		{0x01AA, 0x01AB, 1},
+		{0x01AD, 0x01AD, 1},
+		{0x01B0, 0x01B0, 1},
+		{0x01B4, 0x01B4, 1},
+		{0x01B6, 0x01B6, 1},
+		{0x01B9, 0x01BB, 1},
+		{0x01BD, 0x01C3, 1},
+		{0x01CE, 0x01CE, 1},
+		{0x01D0, 0x01D0, 1},
+		{0x01D2, 0x01D2, 1},
+		{0x01D4, 0x01D4, 1},
+		{0x01D6, 0x01D6, 1},
+		{0x01D8, 0x01D8, 1},
+		{0x01DA, 0x01DA, 1},
+		{0x01DC, 0x01DD, 1},
+		{0x01DF, 0x01DF, 1},
+		{0x01E1, 0x01E1, 1},
+		{0x01E3, 0x01E3, 1},
+		{0x01E5, 0x01E5, 1},
+		{0x01E7, 0x01E7, 1},
+		{0x01E9, 0x01E9, 1},
+		{0x01EB, 0x01EB, 1},
+		{0x01ED, 0x01ED, 1},
+		{0x01EF, 0x01F0, 1},
+		{0x01F5, 0x01F5, 1},
+		{0x01F9, 0x01F9, 1},
+		{0x01FB, 0x01FB, 1},
+		{0x01FD, 0x01FD, 1},
+		{0x01FF, 0x01FF, 1},
+		{0x0201, 0x0201, 1},
+		{0x0203, 0x0203, 1},
+		{0x0205, 0x0205, 1},
+		{0x0207, 0x0207, 1},
+		{0x0209, 0x0209, 1},
+		{0x020B, 0x020B, 1},
+		{0x020D, 0x020D, 1},
+		{0x020F, 0x020F, 1},
+		{0x0211, 0x0211, 1},
+		{0x0213, 0x0213, 1},
+		{0x0215, 0x0215, 1},
+		{0x0217, 0x0217, 1},
+		{0x0219, 0x0219, 1},
+		{0x021B, 0x021B, 1},
+		{0x021D, 0x021D, 1},
+		{0x021F, 0x021F, 1},
+		{0x0221, 0x0221, 1},
+		{0x0223, 0x0223, 1},
+		{0x0225, 0x0225, 1},
+		{0x0227, 0x0227, 1},
+		{0x0229, 0x0229, 1},
+		{0x022B, 0x022B, 1},
+		{0x022D, 0x022D, 1},
+		{0x022F, 0x022F, 1},
+		{0x0231, 0x0231, 1},
+		{0x0233, 0x0239, 1},
+		{0x023C, 0x023C, 1},
+		{0x023F, 0x0240, 1},
+		{0x0242, 0x0242, 1},
+		{0x0247, 0x0247, 1},
+		{0x0249, 0x0249, 1},
+		{0x024B, 0x024B, 1},
+		{0x024D, 0x024D, 1},
+		{0x024F, 0x02AF, 1},
+		{0x1D00, 0x1D25, 1},
+		{0x1D2F, 0x1D2F, 1},
+		{0x1D3B, 0x1D3B, 1},
+		{0x1D4E, 0x1D4E, 1},
+		{0x1D6B, 0x1D77, 1},
+		{0x1D79, 0x1D9A, 1},
+		{0x1E01, 0x1E01, 1},
+		{0x1E03, 0x1E03, 1},
+		{0x1E05, 0x1E05, 1},
+		{0x1E07, 0x1E07, 1},
+		{0x1E09, 0x1E09, 1},
+		{0x1E0B, 0x1E0B, 1},
+		{0x1E0D, 0x1E0D, 1},
+		{0x1E0F, 0x1E0F, 1},
+		{0x1E11, 0x1E11, 1},
+		{0x1E13, 0x1E13, 1},
+		{0x1E15, 0x1E15, 1},
+		{0x1E17, 0x1E17, 1},
+		{0x1E19, 0x1E19, 1},
+		{0x1E1B, 0x1E1B, 1},
+		{0x1E1D, 0x1E1D, 1},
+		{0x1E1F, 0x1E1F, 1},
+		{0x1E21, 0x1E21, 1},
+		{0x1E23, 0x1E23, 1},
+		{0x1E25, 0x1E25, 1},
+		{0x1E27, 0x1E27, 1},
+		{0x1E29, 0x1E29, 1},
+		{0x1E2B, 0x1E2B, 1},
+		{0x1E2D, 0x1E2D, 1},
+		{0x1E2F, 0x1E2F, 1},
+		{0x1E31, 0x1E31, 1},
+		{0x1E33, 0x1E33, 1},
+		{0x1E35, 0x1E35, 1},
+		{0x1E37, 0x1E37, 1},
+		{0x1E39, 0x1E39, 1},
+		{0x1E3B, 0x1E3B, 1},
+		{0x1E3D, 0x1E3D, 1},
+		{0x1E3F, 0x1E3F, 1},
+		{0x1E41, 0x1E41, 1},
+		{0x1E43, 0x1E43, 1},
+		{0x1E45, 0x1E45, 1},
+		{0x1E47, 0x1E47, 1},
+		{0x1E49, 0x1E49, 1},
+		{0x1E4B, 0x1E4B, 1},
+		{0x1E4D, 0x1E4D, 1},
+		{0x1E4F, 0x1E4F, 1},
+		{0x1E51, 0x1E51, 1},
+		{0x1E53, 0x1E53, 1},
+		{0x1E55, 0x1E55, 1},
+		{0x1E57, 0x1E57, 1},
+		{0x1E59, 0x1E59, 1},
+		{0x1E5B, 0x1E5B, 1},
+		{0x1E5D, 0x1E5D, 1},
+		{0x1E5F, 0x1E5F, 1},
+		{0x1E61, 0x1E61, 1},
+		{0x1E63, 0x1E63, 1},
+		{0x1E65, 0x1E65, 1},
+		{0x1E67, 0x1E67, 1},
+		{0x1E69, 0x1E69, 1},
+		{0x1E6B, 0x1E6B, 1},
+		{0x1E6D, 0x1E6D, 1},
+		{0x1E6F, 0x1E6F, 1},
+		{0x1E71, 0x1E71, 1},
+		{0x1E73, 0x1E73, 1},
+		{0x1E75, 0x1E75, 1},
+		{0x1E77, 0x1E77, 1},
+		{0x1E79, 0x1E79, 1},
+		{0x1E7B, 0x1E7B, 1},
+		{0x1E7D, 0x1E7D, 1},
+		{0x1E7F, 0x1E7F, 1},
+		{0x1E81, 0x1E81, 1},
+		{0x1E83, 0x1E83, 1},
+		{0x1E85, 0x1E85, 1},
+		{0x1E87, 0x1E87, 1},
+		{0x1E89, 0x1E89, 1},
+		{0x1E8B, 0x1E8B, 1},
+		{0x1E8D, 0x1E8D, 1},
+		{0x1E8F, 0x1E8F, 1},
+		{0x1E91, 0x1E91, 1},
+		{0x1E93, 0x1E93, 1},
+		{0x1E95, 0x1E99, 1},
+		{0x1E9C, 0x1E9D, 1},
+		{0x1E9F, 0x1E9F, 1},
+		{0x1EA1, 0x1EA1, 1},
+		{0x1EA3, 0x1EA3, 1},
+		{0x1EA5, 0x1EA5, 1},
+		{0x1EA7, 0x1EA7, 1},
+		{0x1EA9, 0x1EA9, 1},
+		{0x1EAB, 0x1EAB, 1},
+		{0x1EAD, 0x1EAD, 1},
+		{0x1EAF, 0x1EAF, 1},
+		{0x1EB1, 0x1EB1, 1},
+		{0x1EB3, 0x1EB3, 1},
+		{0x1EB5, 0x1EB5, 1},
+		{0x1EB7, 0x1EB7, 1},
+		{0x1EB9, 0x1EB9, 1},
+		{0x1EBB, 0x1EBB, 1},
+		{0x1EBD, 0x1EBD, 1},
+		{0x1EBF, 0x1EBF, 1},
+		{0x1EC1, 0x1EC1, 1},
+		{0x1EC3, 0x1EC3, 1},
+		{0x1EC5, 0x1EC5, 1},
+		{0x1EC7, 0x1EC7, 1},
+		{0x1EC9, 0x1EC9, 1},
+		{0x1ECB, 0x1ECB, 1},
+		{0x1ECD, 0x1ECD, 1},
+		{0x1ECF, 0x1ECF, 1},
+		{0x1ED1, 0x1ED1, 1},
+		{0x1ED3, 0x1ED3, 1},
+		{0x1ED5, 0x1ED5, 1},
+		{0x1ED7, 0x1ED7, 1},
+		{0x1ED9, 0x1ED9, 1},
+		{0x1EDB, 0x1EDB, 1},
+		{0x1EDD, 0x1EDD, 1},
+		{0x1EDF, 0x1EDF, 1},
+		{0x1EE1, 0x1EE1, 1},
+		{0x1EE3, 0x1EE3, 1},
+		{0x1EE5, 0x1EE5, 1},
+		{0x1EE7, 0x1EE7, 1},
+		{0x1EE9, 0x1EE9, 1},
+		{0x1EEB, 0x1EEB, 1},
+		{0x1EED, 0x1EED, 1},
+		{0x1EEF, 0x1EEF, 1},
+		{0x1EF1, 0x1EF1, 1},
+		{0x1EF3, 0x1EF3, 1},
+		{0x1EF5, 0x1EF5, 1},
+		{0x1EF7, 0x1EF7, 1},
+		{0x1EF9, 0x1EF9, 1},
+		{0x1EFB, 0x1EFB, 1},
+		{0x1EFD, 0x1EFD, 1},
+		{0x1EFF, 0x1EFF, 1},
+		{0x214E, 0x214E, 1},
+		{0x2184, 0x2184, 1},
+		{0x2C61, 0x2C61, 1},
+		{0x2C65, 0x2C66, 1},
+		{0x2C68, 0x2C68, 1},
+		{0x2C6A, 0x2C6A, 1},
+		{0x2C6C, 0x2C6C, 1},
+		{0x2C71, 0x2C71, 1},
+		{0x2C73, 0x2C74, 1},
+		{0x2C76, 0x2C7B, 1},
+		{0xA723, 0xA723, 1},
+		{0xA725, 0xA725, 1},
+		{0xA727, 0xA727, 1},
+		{0xA729, 0xA729, 1},
+		{0xA72B, 0xA72B, 1},
+		{0xA72D, 0xA72D, 1},
+		{0xA72F, 0xA731, 1},
+		{0xA733, 0xA733, 1},
+		{0xA735, 0xA735, 1},
+		{0xA737, 0xA737, 1},
+		{0xA739, 0xA739, 1},
+		{0xA73B, 0xA73B, 1},
+		{0xA73D, 0xA73D, 1},
+		{0xA73F, 0xA73F, 1},
+		{0xA741, 0xA741, 1},
+		{0xA743, 0xA743, 1},
+		{0xA745, 0xA745, 1},
+		{0xA747, 0xA747, 1},
+		{0xA749, 0xA749, 1},
+		{0xA74B, 0xA74B, 1},
+		{0xA74D, 0xA74D, 1},
+		{0xA74F, 0xA74F, 1},
+		{0xA751, 0xA751, 1},
+		{0xA753, 0xA753, 1},
+		{0xA755, 0xA755, 1},
+		{0xA757, 0xA757, 1},
+		{0xA759, 0xA759, 1},
+		{0xA75B, 0xA75B, 1},
+		{0xA75D, 0xA75D, 1},
+		{0xA75F, 0xA75F, 1},
+		{0xA761, 0xA761, 1},
+		{0xA763, 0xA763, 1},
+		{0xA765, 0xA765, 1},
+		{0xA767, 0xA767, 1},
+		{0xA769, 0xA769, 1},
+		{0xA76B, 0xA76B, 1},
+		{0xA76D, 0xA76D, 1},
+		{0xA76F, 0xA76F, 1},
+		{0xA771, 0xA778, 1},
+		{0xA77A, 0xA77A, 1},
+		{0xA77C, 0xA77C, 1},
+		{0xA77F, 0xA77F, 1},
+		{0xA781, 0xA781, 1},
+		{0xA783, 0xA783, 1},
+		{0xA785, 0xA785, 1},
+		{0xA787, 0xA787, 1},
+		{0xA78C, 0xA78C, 1},
+		{0xA78E, 0xA78F, 1},
+		{0xA791, 0xA791, 1},
+		{0xA793, 0xA795, 1},
+		{0xA797, 0xA797, 1},
+		{0xA799, 0xA799, 1},
+		{0xA79B, 0xA79B, 1},
+		{0xA79D, 0xA79D, 1},
+		{0xA79F, 0xA79F, 1},
+		{0xA7A1, 0xA7A1, 1},
+		{0xA7A3, 0xA7A3, 1},
+		{0xA7A5, 0xA7A5, 1},
+		{0xA7A7, 0xA7A7, 1},
+		{0xA7A9, 0xA7A9, 1},
+		{0xA7AF, 0xA7AF, 1},
+		{0xA7B5, 0xA7B5, 1},
+		{0xA7B7, 0xA7B7, 1},
+		{0xA7B9, 0xA7B9, 1},
+		{0xA7BB, 0xA7BB, 1},
+		{0xA7BD, 0xA7BD, 1},
+		{0xA7BF, 0xA7BF, 1},
+		{0xA7C1, 0xA7C1, 1},
+		{0xA7C3, 0xA7C3, 1},
+		{0xA7C8, 0xA7C8, 1},
+		{0xA7CA, 0xA7CA, 1},
+		{0xA7D1, 0xA7D1, 1},
+		{0xA7D3, 0xA7D3, 1},
+		{0xA7D5, 0xA7D5, 1},
+		{0xA7D7, 0xA7D7, 1},
+		{0xA7D9, 0xA7D9, 1},
+		{0xA7F6, 0xA7F7, 1},
+		{0xA7FA, 0xA7FF, 1},
+		{0xAB30, 0xAB5A, 1},
+		{0xAB60, 0xAB64, 1},
+		{0xAB66, 0xAB68, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10780, 0x10780, 1},
+		{0x1DF00, 0x1DF1E, 1},
+		{0x1DF25, 0x1DF2A, 1},
+	},
+	LatinOffset: 3,
+}
+
+var normalizationTable5 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0024, 0x0024, 1},
+		{0x002D, 0x002D, 1},
+		{0x0030, 0x0039, 1},
+		{0x005F, 0x005F, 1},
+		{0x00B7, 0x00B7, 1},
+		{0x02B9, 0x02C1, 1},
+		{0x02C6, 0x02D1, 1},
+		{0x02EC, 0x02EC, 1},
+		{0x02EE, 0x02EE, 1},
+		{0x0300, 0x033F, 1},
+		{0x0342, 0x0342, 1},
+		{0x0346, 0x034E, 1},
+		{0x0350, 0x036F, 1},
+		{0x0485, 0x0486, 1},
+		{0x0640, 0x0640, 1},
+		{0x064B, 0x0655, 1},
+		{0x0670, 0x0670, 1},
+		{0x0951, 0x0954, 1},
+		{0x1AB0, 0x1ABD, 1},
+		{0x1ABF, 0x1ACE, 1},
+		{0x1CD0, 0x1CD2, 1},
+		{0x1CD4, 0x1CFA, 1},
+		{0x1DC0, 0x1DFF, 1},
+		{0x2019, 0x2019, 1},
+		{0x2027, 0x2027, 1},
+		{0x2044, 0x2044, 1},
+		{0x20D0, 0x20DC, 1},
+		{0x20E1, 0x20E1, 1},
+		{0x20E5, 0x20F0, 1},
+		{0x2E2F, 0x2E2F, 1},
+		{0x3006, 0x3006, 1},
+		{0x302A, 0x302D, 1},
+		{0x3031, 0x3035, 1},
+		{0x303C, 0x303C, 1},
+		{0x3099, 0x309A, 1},
+		{0x30FB, 0x30FC, 1},
+		{0xA717, 0xA71F, 1},
+		{0xA788, 0xA788, 1},
+		{0xA9CF, 0xA9CF, 1},
+		{0xFE20, 0xFE2D, 1},
+	},
+	R32: []unicode.Range32{
+		{0x101FD, 0x101FD, 1},
+		{0x102E0, 0x102E0, 1},
+		{0x1133B, 0x1133B, 1},
+		{0x1CF00, 0x1CF2D, 1},
+		{0x1CF30, 0x1CF46, 1},
+		{0x1D165, 0x1D169, 1},
+		{0x1D16D, 0x1D172, 1},
+		{0x1D17B, 0x1D182, 1},
+		{0x1D185, 0x1D18B, 1},
+		{0x1D1AA, 0x1D1AD, 1},
+	},
+	LatinOffset: 5,
+}
+
+var normalizationTable6 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x1E922, 0x1E94B, 1},
+		{0x1E950, 0x1E959, 1},
+	},
+}
+
+var normalizationTable7 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11700, 0x1171A, 1},
+		{0x1171D, 0x1172B, 1},
+		{0x11730, 0x11739, 1},
+		{0x11740, 0x11746, 1},
+	},
+}
+
+var normalizationTable8 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x14400, 0x14646, 1},
+	},
+}
+
+var normalizationTable9 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0610, 0x061A, 1},
+		{0x0620, 0x063F, 1},
+		{0x0641, 0x064A, 1},
+		{0x0656, 0x0669, 1},
+		{0x066E, 0x066F, 1},
+		{0x0671, 0x0674, 1},
+		{0x0679, 0x06D3, 1},
+		{0x06D5, 0x06DC, 1},
+		{0x06DF, 0x06E8, 1},
+		{0x06EA, 0x06FC, 1},
+		{0x06FF, 0x06FF, 1},
+		{0x0750, 0x077F, 1},
+		{0x0870, 0x0887, 1},
+		{0x0889, 0x088E, 1},
+		{0x0898, 0x08E1, 1},
+		{0x08E3, 0x08FF, 1},
+		{0xFE73, 0xFE73, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10EFD, 0x10EFF, 1},
+	},
+}
+
+var normalizationTable10 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0559, 0x0559, 1},
+		{0x0560, 0x0586, 1},
+		{0x0588, 0x0588, 1},
+	},
+}
+
+var normalizationTable11 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10B00, 0x10B35, 1},
+	},
+}
+
+var normalizationTable12 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1B00, 0x1B4C, 1},
+		{0x1B50, 0x1B59, 1},
+		{0x1B6B, 0x1B73, 1},
+	},
+}
+
+var normalizationTable13 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xA6A0, 0xA6E5, 1},
+		{0xA6F0, 0xA6F1, 1},
+	},
+	R32: []unicode.Range32{
+		{0x16800, 0x16A38, 1},
+	},
+}
+
+var normalizationTable14 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x16AD0, 0x16AED, 1},
+		{0x16AF0, 0x16AF4, 1},
+	},
+}
+
+var normalizationTable15 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1BC0, 0x1BF3, 1},
+	},
+}
+
+var normalizationTable16 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0980, 0x0983, 1},
+		{0x0985, 0x098C, 1},
+		{0x098F, 0x0990, 1},
+		{0x0993, 0x09A8, 1},
+		{0x09AA, 0x09B0, 1},
+		{0x09B2, 0x09B2, 1},
+		{0x09B6, 0x09B9, 1},
+		{0x09BC, 0x09C4, 1},
+		{0x09C7, 0x09C8, 1},
+		{0x09CB, 0x09CE, 1},
+		{0x09D7, 0x09D7, 1},
+		{0x09E0, 0x09E3, 1},
+		{0x09E6, 0x09F1, 1},
+		{0x09FC, 0x09FC, 1},
+		{0x09FE, 0x09FE, 1},
+	},
+}
+
+var normalizationTable17 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11C00, 0x11C08, 1},
+		{0x11C0A, 0x11C36, 1},
+		{0x11C38, 0x11C40, 1},
+		{0x11C50, 0x11C59, 1},
+	},
+}
+
+var normalizationTable18 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x3105, 0x312F, 1},
+		{0x31A0, 0x31BF, 1},
+	},
+}
+
+var normalizationTable19 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11000, 0x11046, 1},
+		{0x11066, 0x11075, 1},
+		{0x1107F, 0x1107F, 1},
+	},
+}
+
+var normalizationTable20 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1A00, 0x1A1B, 1},
+	},
+}
+
+var normalizationTable21 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1740, 0x1753, 1},
+	},
+}
+
+var normalizationTable22 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1401, 0x166C, 1},
+		{0x166F, 0x167F, 1},
+		{0x18B0, 0x18F5, 1},
+	},
+	R32: []unicode.Range32{
+		{0x11AB0, 0x11ABF, 1},
+	},
+}
+
+var normalizationTable23 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x102A0, 0x102D0, 1},
+	},
+}
+
+var normalizationTable24 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10530, 0x10563, 1},
+	},
+}
+
+var normalizationTable25 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11100, 0x11134, 1},
+		{0x11136, 0x1113F, 1},
+		{0x11144, 0x11147, 1},
+	},
+}
+
+var normalizationTable26 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xAA00, 0xAA36, 1},
+		{0xAA40, 0xAA4D, 1},
+		{0xAA50, 0xAA59, 1},
+	},
+}
+
+var normalizationTable27 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x13A0, 0x13F5, 1},
+	},
+}
+
+var normalizationTable28 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10FB0, 0x10FC4, 1},
+	},
+}
+
+var normalizationTable29 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x03E3, 0x03E3, 1},
+		{0x03E5, 0x03E5, 1},
+		{0x03E7, 0x03E7, 1},
+		{0x03E9, 0x03E9, 1},
+		{0x03EB, 0x03EB, 1},
+		{0x03ED, 0x03ED, 1},
+		{0x03EF, 0x03EF, 1},
+		{0x2C81, 0x2C81, 1},
+		{0x2C83, 0x2C83, 1},
+		{0x2C85, 0x2C85, 1},
+		{0x2C87, 0x2C87, 1},
+		{0x2C89, 0x2C89, 1},
+		{0x2C8B, 0x2C8B, 1},
+		{0x2C8D, 0x2C8D, 1},
+		{0x2C8F, 0x2C8F, 1},
+		{0x2C91, 0x2C91, 1},
+		{0x2C93, 0x2C93, 1},
+		{0x2C95, 0x2C95, 1},
+		{0x2C97, 0x2C97, 1},
+		{0x2C99, 0x2C99, 1},
+		{0x2C9B, 0x2C9B, 1},
+		{0x2C9D, 0x2C9D, 1},
+		{0x2C9F, 0x2C9F, 1},
+		{0x2CA1, 0x2CA1, 1},
+		{0x2CA3, 0x2CA3, 1},
+		{0x2CA5, 0x2CA5, 1},
+		{0x2CA7, 0x2CA7, 1},
+		{0x2CA9, 0x2CA9, 1},
+		{0x2CAB, 0x2CAB, 1},
+		{0x2CAD, 0x2CAD, 1},
+		{0x2CAF, 0x2CAF, 1},
+		{0x2CB1, 0x2CB1, 1},
+		{0x2CB3, 0x2CB3, 1},
+		{0x2CB5, 0x2CB5, 1},
+		{0x2CB7, 0x2CB7, 1},
+		{0x2CB9, 0x2CB9, 1},
+		{0x2CBB, 0x2CBB, 1},
+		{0x2CBD, 0x2CBD, 1},
+		{0x2CBF, 0x2CBF, 1},
+		{0x2CC1, 0x2CC1, 1},
+		{0x2CC3, 0x2CC3, 1},
+		{0x2CC5, 0x2CC5, 1},
+		{0x2CC7, 0x2CC7, 1},
+		{0x2CC9, 0x2CC9, 1},
+		{0x2CCB, 0x2CCB, 1},
+		{0x2CCD, 0x2CCD, 1},
+		{0x2CCF, 0x2CCF, 1},
+		{0x2CD1, 0x2CD1, 1},
+		{0x2CD3, 0x2CD3, 1},
+		{0x2CD5, 0x2CD5, 1},
+		{0x2CD7, 0x2CD7, 1},
+		{0x2CD9, 0x2CD9, 1},
+		{0x2CDB, 0x2CDB, 1},
+		{0x2CDD, 0x2CDD, 1},
+		{0x2CDF, 0x2CDF, 1},
+		{0x2CE1, 0x2CE1, 1},
+		{0x2CE3, 0x2CE4, 1},
+		{0x2CEC, 0x2CEC, 1},
+		{0x2CEE, 0x2CF1, 1},
+		{0x2CF3, 0x2CF3, 1},
+	},
+}
+
+var normalizationTable30 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x12000, 0x12399, 1},
+		{0x12480, 0x12543, 1},
+	},
+}
+
+var normalizationTable31 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10800, 0x10805, 1},
+		{0x10808, 0x10808, 1},
+		{0x1080A, 0x10835, 1},
+		{0x10837, 0x10838, 1},
+		{0x1083C, 0x1083C, 1},
+		{0x1083F, 0x1083F, 1},
+	},
+}
+
+var normalizationTable32 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x12F90, 0x12FF0, 1},
+	},
+}
+
+var normalizationTable33 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0430, 0x045F, 1},
+		{0x0461, 0x0461, 1},
+		{0x0463, 0x0463, 1},
+		{0x0465, 0x0465, 1},
+		{0x0467, 0x0467, 1},
+		{0x0469, 0x0469, 1},
+		{0x046B, 0x046B, 1},
+		{0x046D, 0x046D, 1},
+		{0x046F, 0x046F, 1},
+		{0x0471, 0x0471, 1},
+		{0x0473, 0x0473, 1},
+		{0x0475, 0x0475, 1},
+		{0x0477, 0x0477, 1},
+		{0x0479, 0x0479, 1},
+		{0x047B, 0x047B, 1},
+		{0x047D, 0x047D, 1},
+		{0x047F, 0x047F, 1},
+		{0x0481, 0x0481, 1},
+		{0x0483, 0x0484, 1},
+		{0x0487, 0x0487, 1},
+		{0x048B, 0x048B, 1},
+		{0x048D, 0x048D, 1},
+		{0x048F, 0x048F, 1},
+		{0x0491, 0x0491, 1},
+		{0x0493, 0x0493, 1},
+		{0x0495, 0x0495, 1},
+		{0x0497, 0x0497, 1},
+		{0x0499, 0x0499, 1},
+		{0x049B, 0x049B, 1},
+		{0x049D, 0x049D, 1},
+		{0x049F, 0x049F, 1},
+		{0x04A1, 0x04A1, 1},
+		{0x04A3, 0x04A3, 1},
+		{0x04A5, 0x04A5, 1},
+		{0x04A7, 0x04A7, 1},
+		{0x04A9, 0x04A9, 1},
+		{0x04AB, 0x04AB, 1},
+		{0x04AD, 0x04AD, 1},
+		{0x04AF, 0x04AF, 1},
+		{0x04B1, 0x04B1, 1},
+		{0x04B3, 0x04B3, 1},
+		{0x04B5, 0x04B5, 1},
+		{0x04B7, 0x04B7, 1},
+		{0x04B9, 0x04B9, 1},
+		{0x04BB, 0x04BB, 1},
+		{0x04BD, 0x04BD, 1},
+		{0x04BF, 0x04BF, 1},
+		{0x04C2, 0x04C2, 1},
+		{0x04C4, 0x04C4, 1},
+		{0x04C6, 0x04C6, 1},
+		{0x04C8, 0x04C8, 1},
+		{0x04CA, 0x04CA, 1},
+		{0x04CC, 0x04CC, 1},
+		{0x04CE, 0x04CF, 1},
+		{0x04D1, 0x04D1, 1},
+		{0x04D3, 0x04D3, 1},
+		{0x04D5, 0x04D5, 1},
+		{0x04D7, 0x04D7, 1},
+		{0x04D9, 0x04D9, 1},
+		{0x04DB, 0x04DB, 1},
+		{0x04DD, 0x04DD, 1},
+		{0x04DF, 0x04DF, 1},
+		{0x04E1, 0x04E1, 1},
+		{0x04E3, 0x04E3, 1},
+		{0x04E5, 0x04E5, 1},
+		{0x04E7, 0x04E7, 1},
+		{0x04E9, 0x04E9, 1},
+		{0x04EB, 0x04EB, 1},
+		{0x04ED, 0x04ED, 1},
+		{0x04EF, 0x04EF, 1},
+		{0x04F1, 0x04F1, 1},
+		{0x04F3, 0x04F3, 1},
+		{0x04F5, 0x04F5, 1},
+		{0x04F7, 0x04F7, 1},
+		{0x04F9, 0x04F9, 1},
+		{0x04FB, 0x04FB, 1},
+		{0x04FD, 0x04FD, 1},
+		{0x04FF, 0x04FF, 1},
+		{0x0501, 0x0501, 1},
+		{0x0503, 0x0503, 1},
+		{0x0505, 0x0505, 1},
+		{0x0507, 0x0507, 1},
+		{0x0509, 0x0509, 1},
+		{0x050B, 0x050B, 1},
+		{0x050D, 0x050D, 1},
+		{0x050F, 0x050F, 1},
+		{0x0511, 0x0511, 1},
+		{0x0513, 0x0513, 1},
+		{0x0515, 0x0515, 1},
+		{0x0517, 0x0517, 1},
+		{0x0519, 0x0519, 1},
+		{0x051B, 0x051B, 1},
+		{0x051D, 0x051D, 1},
+		{0x051F, 0x051F, 1},
+		{0x0521, 0x0521, 1},
+		{0x0523, 0x0523, 1},
+		{0x0525, 0x0525, 1},
+		{0x0527, 0x0527, 1},
+		{0x0529, 0x0529, 1},
+		{0x052B, 0x052B, 1},
+		{0x052D, 0x052D, 1},
+		{0x052F, 0x052F, 1},
+		{0x1D2B, 0x1D2B, 1},
+		{0x2DE0, 0x2DFF, 1},
+		{0xA641, 0xA641, 1},
+		{0xA643, 0xA643, 1},
+		{0xA645, 0xA645, 1},
+		{0xA647, 0xA647, 1},
+		{0xA649, 0xA649, 1},
+		{0xA64B, 0xA64B, 1},
+		{0xA64D, 0xA64D, 1},
+		{0xA64F, 0xA64F, 1},
+		{0xA651, 0xA651, 1},
+		{0xA653, 0xA653, 1},
+		{0xA655, 0xA655, 1},
+		{0xA657, 0xA657, 1},
+		{0xA659, 0xA659, 1},
+		{0xA65B, 0xA65B, 1},
+		{0xA65D, 0xA65D, 1},
+		{0xA65F, 0xA65F, 1},
+		{0xA661, 0xA661, 1},
+		{0xA663, 0xA663, 1},
+		{0xA665, 0xA665, 1},
+		{0xA667, 0xA667, 1},
+		{0xA669, 0xA669, 1},
+		{0xA66B, 0xA66B, 1},
+		{0xA66D, 0xA66F, 1},
+		{0xA674, 0xA67D, 1},
+		{0xA67F, 0xA67F, 1},
+		{0xA681, 0xA681, 1},
+		{0xA683, 0xA683, 1},
+		{0xA685, 0xA685, 1},
+		{0xA687, 0xA687, 1},
+		{0xA689, 0xA689, 1},
+		{0xA68B, 0xA68B, 1},
+		{0xA68D, 0xA68D, 1},
+		{0xA68F, 0xA68F, 1},
+		{0xA691, 0xA691, 1},
+		{0xA693, 0xA693, 1},
+		{0xA695, 0xA695, 1},
+		{0xA697, 0xA697, 1},
+		{0xA699, 0xA699, 1},
+		{0xA69B, 0xA69B, 1},
+		{0xA69E, 0xA69F, 1},
+		{0xFE2E, 0xFE2F, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1E08F, 0x1E08F, 1},
+	},
+}
+
+var normalizationTable34 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10428, 0x1044F, 1},
+	},
+}
+
+var normalizationTable35 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0900, 0x0950, 1},
+		{0x0955, 0x0957, 1},
+		{0x0960, 0x0963, 1},
+		{0x0966, 0x096F, 1},
+		{0x0971, 0x097F, 1},
+		{0xA8E0, 0xA8F7, 1},
+		{0xA8FB, 0xA8FB, 1},
+		{0xA8FD, 0xA8FF, 1},
+	},
+}
+
+var normalizationTable36 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11900, 0x11906, 1},
+		{0x11909, 0x11909, 1},
+		{0x1190C, 0x11913, 1},
+		{0x11915, 0x11916, 1},
+		{0x11918, 0x11935, 1},
+		{0x11937, 0x11938, 1},
+		{0x1193B, 0x11943, 1},
+		{0x11950, 0x11959, 1},
+	},
+}
+
+var normalizationTable37 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11800, 0x1183A, 1},
+	},
+}
+
+var normalizationTable38 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x1BC00, 0x1BC6A, 1},
+		{0x1BC70, 0x1BC7C, 1},
+		{0x1BC80, 0x1BC88, 1},
+		{0x1BC90, 0x1BC99, 1},
+		{0x1BC9D, 0x1BC9E, 1},
+	},
+}
+
+var normalizationTable39 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x13000, 0x1342F, 1},
+		{0x13440, 0x13455, 1},
+	},
+}
+
+var normalizationTable40 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10500, 0x10527, 1},
+	},
+}
+
+var normalizationTable41 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10FE0, 0x10FF6, 1},
+	},
+}
+
+var normalizationTable42 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1200, 0x1248, 1},
+		{0x124A, 0x124D, 1},
+		{0x1250, 0x1256, 1},
+		{0x1258, 0x1258, 1},
+		{0x125A, 0x125D, 1},
+		{0x1260, 0x1288, 1},
+		{0x128A, 0x128D, 1},
+		{0x1290, 0x12B0, 1},
+		{0x12B2, 0x12B5, 1},
+		{0x12B8, 0x12BE, 1},
+		{0x12C0, 0x12C0, 1},
+		{0x12C2, 0x12C5, 1},
+		{0x12C8, 0x12D6, 1},
+		{0x12D8, 0x1310, 1},
+		{0x1312, 0x1315, 1},
+		{0x1318, 0x135A, 1},
+		{0x135D, 0x135F, 1},
+		{0x1380, 0x138F, 1},
+		{0x2D80, 0x2D96, 1},
+		{0x2DA0, 0x2DA6, 1},
+		{0x2DA8, 0x2DAE, 1},
+		{0x2DB0, 0x2DB6, 1},
+		{0x2DB8, 0x2DBE, 1},
+		{0x2DC0, 0x2DC6, 1},
+		{0x2DC8, 0x2DCE, 1},
+		{0x2DD0, 0x2DD6, 1},
+		{0x2DD8, 0x2DDE, 1},
+		{0xAB01, 0xAB06, 1},
+		{0xAB09, 0xAB0E, 1},
+		{0xAB11, 0xAB16, 1},
+		{0xAB20, 0xAB26, 1},
+		{0xAB28, 0xAB2E, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1E7E0, 0x1E7E6, 1},
+		{0x1E7E8, 0x1E7EB, 1},
+		{0x1E7ED, 0x1E7EE, 1},
+		{0x1E7F0, 0x1E7FE, 1},
+	},
+}
+
+var normalizationTable43 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x10D0, 0x10FA, 1},
+		{0x10FD, 0x10FF, 1},
+		{0x2D00, 0x2D25, 1},
+		{0x2D27, 0x2D27, 1},
+		{0x2D2D, 0x2D2D, 1},
+	},
+}
+
+var normalizationTable44 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x2C30, 0x2C5F, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1E000, 0x1E006, 1},
+		{0x1E008, 0x1E018, 1},
+		{0x1E01B, 0x1E021, 1},
+		{0x1E023, 0x1E024, 1},
+		{0x1E026, 0x1E02A, 1},
+	},
+}
+
+var normalizationTable45 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10330, 0x10340, 1},
+		{0x10342, 0x10349, 1},
+	},
+}
+
+var normalizationTable46 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11300, 0x11303, 1},
+		{0x11305, 0x1130C, 1},
+		{0x1130F, 0x11310, 1},
+		{0x11313, 0x11328, 1},
+		{0x1132A, 0x11330, 1},
+		{0x11332, 0x11333, 1},
+		{0x11335, 0x11339, 1},
+		{0x1133C, 0x11344, 1},
+		{0x11347, 0x11348, 1},
+		{0x1134B, 0x1134D, 1},
+		{0x11350, 0x11350, 1},
+		{0x11357, 0x11357, 1},
+		{0x1135D, 0x11363, 1},
+		{0x11366, 0x1136C, 1},
+		{0x11370, 0x11374, 1},
+	},
+}
+
+var normalizationTable47 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0371, 0x0371, 1},
+		{0x0373, 0x0373, 1},
+		{0x0377, 0x0377, 1},
+		{0x037B, 0x037D, 1},
+		{0x0390, 0x0390, 1},
+		{0x03AC, 0x03CE, 1},
+		{0x03D7, 0x03D7, 1},
+		{0x03D9, 0x03D9, 1},
+		{0x03DB, 0x03DB, 1},
+		{0x03DD, 0x03DD, 1},
+		{0x03DF, 0x03DF, 1},
+		{0x03E1, 0x03E1, 1},
+		{0x03F3, 0x03F3, 1},
+		{0x03F8, 0x03F8, 1},
+		{0x03FB, 0x03FC, 1},
+		{0x1D26, 0x1D2A, 1},
+		{0x1F00, 0x1F07, 1},
+		{0x1F10, 0x1F15, 1},
+		{0x1F20, 0x1F27, 1},
+		{0x1F30, 0x1F37, 1},
+		{0x1F40, 0x1F45, 1},
+		{0x1F50, 0x1F57, 1},
+		{0x1F60, 0x1F67, 1},
+		{0x1F70, 0x1F70, 1},
+		{0x1F72, 0x1F72, 1},
+		{0x1F74, 0x1F74, 1},
+		{0x1F76, 0x1F76, 1},
+		{0x1F78, 0x1F78, 1},
+		{0x1F7A, 0x1F7A, 1},
+		{0x1F7C, 0x1F7C, 1},
+		{0x1FB0, 0x1FB1, 1},
+		{0x1FB6, 0x1FB6, 1},
+		{0x1FC6, 0x1FC6, 1},
+		{0x1FD0, 0x1FD2, 1},
+		{0x1FD6, 0x1FD7, 1},
+		{0x1FE0, 0x1FE2, 1},
+		{0x1FE4, 0x1FE7, 1},
+		{0x1FF6, 0x1FF6, 1},
+		{0xAB65, 0xAB65, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1D242, 0x1D244, 1},
+	},
+}
+
+var normalizationTable48 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0A81, 0x0A83, 1},
+		{0x0A85, 0x0A8D, 1},
+		{0x0A8F, 0x0A91, 1},
+		{0x0A93, 0x0AA8, 1},
+		{0x0AAA, 0x0AB0, 1},
+		{0x0AB2, 0x0AB3, 1},
+		{0x0AB5, 0x0AB9, 1},
+		{0x0ABC, 0x0AC5, 1},
+		{0x0AC7, 0x0AC9, 1},
+		{0x0ACB, 0x0ACD, 1},
+		{0x0AD0, 0x0AD0, 1},
+		{0x0AE0, 0x0AE3, 1},
+		{0x0AE6, 0x0AEF, 1},
+		{0x0AF9, 0x0AFF, 1},
+	},
+}
+
+var normalizationTable49 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11D60, 0x11D65, 1},
+		{0x11D67, 0x11D68, 1},
+		{0x11D6A, 0x11D8E, 1},
+		{0x11D90, 0x11D91, 1},
+		{0x11D93, 0x11D98, 1},
+		{0x11DA0, 0x11DA9, 1},
+	},
+}
+
+var normalizationTable50 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0A01, 0x0A03, 1},
+		{0x0A05, 0x0A0A, 1},
+		{0x0A0F, 0x0A10, 1},
+		{0x0A13, 0x0A28, 1},
+		{0x0A2A, 0x0A30, 1},
+		{0x0A32, 0x0A32, 1},
+		{0x0A35, 0x0A35, 1},
+		{0x0A38, 0x0A39, 1},
+		{0x0A3C, 0x0A3C, 1},
+		{0x0A3E, 0x0A42, 1},
+		{0x0A47, 0x0A48, 1},
+		{0x0A4B, 0x0A4D, 1},
+		{0x0A51, 0x0A51, 1},
+		{0x0A5C, 0x0A5C, 1},
+		{0x0A66, 0x0A75, 1},
+	},
+}
+
+var normalizationTable51 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x3005, 0x3005, 1},
+		{0x303B, 0x303B, 1},
+		{0x3400, 0x4DBF, 1},
+		{0x4E00, 0x9FFF, 1},
+		{0xFA0E, 0xFA0F, 1},
+		{0xFA11, 0xFA11, 1},
+		{0xFA13, 0xFA14, 1},
+		{0xFA1F, 0xFA1F, 1},
+		{0xFA21, 0xFA21, 1},
+		{0xFA23, 0xFA24, 1},
+		{0xFA27, 0xFA29, 1},
+	},
+	R32: []unicode.Range32{
+		{0x16FE3, 0x16FE3, 1},
+		{0x16FF0, 0x16FF1, 1},
+		{0x20000, 0x2A6DF, 1},
+		{0x2A700, 0x2B739, 1},
+		{0x2B740, 0x2B81D, 1},
+		{0x2B820, 0x2CEA1, 1},
+		{0x2CEB0, 0x2EBE0, 1},
+		{0x30000, 0x3134A, 1},
+		{0x31350, 0x323AF, 1},
+	},
+}
+
+var normalizationTable52 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1100, 0x115E, 1},
+		{0x1161, 0x11FF, 1},
+		{0x302E, 0x302F, 1},
+		{0xA960, 0xA97C, 1},
+		{0xAC00, 0xD7A3, 1},
+		{0xD7B0, 0xD7C6, 1},
+		{0xD7CB, 0xD7FB, 1},
+	},
+}
+
+var normalizationTable53 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10D00, 0x10D27, 1},
+		{0x10D30, 0x10D39, 1},
+	},
+}
+
+var normalizationTable54 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1720, 0x1734, 1},
+	},
+}
+
+var normalizationTable55 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x108E0, 0x108F2, 1},
+		{0x108F4, 0x108F5, 1},
+	},
+}
+
+var normalizationTable56 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0591, 0x05BD, 1},
+		{0x05BF, 0x05BF, 1},
+		{0x05C1, 0x05C2, 1},
+		{0x05C4, 0x05C5, 1},
+		{0x05C7, 0x05C7, 1},
+		{0x05D0, 0x05EA, 1},
+		{0x05EF, 0x05F4, 1},
+		{0xFB1E, 0xFB1E, 1},
+	},
+}
+
+var normalizationTable57 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x3041, 0x3096, 1},
+		{0x309D, 0x309E, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1B001, 0x1B11F, 1},
+		{0x1B132, 0x1B132, 1},
+		{0x1B150, 0x1B152, 1},
+	},
+}
+
+var normalizationTable58 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10840, 0x10855, 1},
+	},
+}
+
+var normalizationTable59 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10B60, 0x10B72, 1},
+	},
+}
+
+var normalizationTable60 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10B40, 0x10B55, 1},
+	},
+}
+
+var normalizationTable61 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xA980, 0xA9C0, 1},
+		{0xA9D0, 0xA9D9, 1},
+	},
+}
+
+var normalizationTable62 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11080, 0x110BA, 1},
+		{0x110C2, 0x110C2, 1},
+	},
+}
+
+var normalizationTable63 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0C80, 0x0C83, 1},
+		{0x0C85, 0x0C8C, 1},
+		{0x0C8E, 0x0C90, 1},
+		{0x0C92, 0x0CA8, 1},
+		{0x0CAA, 0x0CB3, 1},
+		{0x0CB5, 0x0CB9, 1},
+		{0x0CBC, 0x0CC4, 1},
+		{0x0CC6, 0x0CC8, 1},
+		{0x0CCA, 0x0CCD, 1},
+		{0x0CD5, 0x0CD6, 1},
+		{0x0CDD, 0x0CDE, 1},
+		{0x0CE0, 0x0CE3, 1},
+		{0x0CE6, 0x0CEF, 1},
+		{0x0CF1, 0x0CF3, 1},
+	},
+}
+
+var normalizationTable64 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x30A1, 0x30FA, 1},
+		{0x30FD, 0x30FE, 1},
+		{0x31F0, 0x31FF, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1AFF0, 0x1AFF3, 1},
+		{0x1AFF5, 0x1AFFB, 1},
+		{0x1AFFD, 0x1AFFE, 1},
+		{0x1B000, 0x1B000, 1},
+		{0x1B120, 0x1B122, 1},
+		{0x1B155, 0x1B155, 1},
+		{0x1B164, 0x1B167, 1},
+	},
+}
+
+var normalizationTable65 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11F00, 0x11F10, 1},
+		{0x11F12, 0x11F3A, 1},
+		{0x11F3E, 0x11F42, 1},
+		{0x11F50, 0x11F59, 1},
+	},
+}
+
+var normalizationTable66 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xA900, 0xA92D, 1},
+	},
+}
+
+var normalizationTable67 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10A00, 0x10A03, 1},
+		{0x10A05, 0x10A06, 1},
+		{0x10A0C, 0x10A13, 1},
+		{0x10A15, 0x10A17, 1},
+		{0x10A19, 0x10A35, 1},
+		{0x10A38, 0x10A3A, 1},
+		{0x10A3F, 0x10A3F, 1},
+	},
+}
+
+var normalizationTable68 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x16FE4, 0x16FE4, 1},
+		{0x18B00, 0x18CD5, 1},
+	},
+}
+
+var normalizationTable69 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1780, 0x17B3, 1},
+		{0x17B6, 0x17D3, 1},
+		{0x17D7, 0x17D7, 1},
+		{0x17DC, 0x17DD, 1},
+		{0x17E0, 0x17E9, 1},
+	},
+}
+
+var normalizationTable70 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11200, 0x11211, 1},
+		{0x11213, 0x11237, 1},
+		{0x1123E, 0x11241, 1},
+	},
+}
+
+var normalizationTable71 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x112B0, 0x112EA, 1},
+		{0x112F0, 0x112F9, 1},
+	},
+}
+
+var normalizationTable72 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0E81, 0x0E82, 1},
+		{0x0E84, 0x0E84, 1},
+		{0x0E86, 0x0E8A, 1},
+		{0x0E8C, 0x0EA3, 1},
+		{0x0EA5, 0x0EA5, 1},
+		{0x0EA7, 0x0EB2, 1},
+		{0x0EB4, 0x0EBD, 1},
+		{0x0EC0, 0x0EC4, 1},
+		{0x0EC6, 0x0EC6, 1},
+		{0x0EC8, 0x0ECE, 1},
+		{0x0ED0, 0x0ED9, 1},
+		{0x0EDE, 0x0EDF, 1},
+	},
+}
+
+var normalizationTable73 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1C00, 0x1C37, 1},
+		{0x1C40, 0x1C49, 1},
+		{0x1C4D, 0x1C4F, 1},
+	},
+}
+
+var normalizationTable74 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1900, 0x191E, 1},
+		{0x1920, 0x192B, 1},
+		{0x1930, 0x193B, 1},
+		{0x1946, 0x194F, 1},
+	},
+}
+
+var normalizationTable75 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10600, 0x10736, 1},
+		{0x10740, 0x10755, 1},
+		{0x10760, 0x10767, 1},
+	},
+}
+
+var normalizationTable76 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10000, 0x1000B, 1},
+		{0x1000D, 0x10026, 1},
+		{0x10028, 0x1003A, 1},
+		{0x1003C, 0x1003D, 1},
+		{0x1003F, 0x1004D, 1},
+		{0x10050, 0x1005D, 1},
+		{0x10080, 0x100FA, 1},
+	},
+}
+
+var normalizationTable77 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xA4D0, 0xA4FD, 1},
+	},
+	R32: []unicode.Range32{
+		{0x11FB0, 0x11FB0, 1},
+	},
+}
+
+var normalizationTable78 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10280, 0x1029C, 1},
+	},
+}
+
+var normalizationTable79 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10920, 0x10939, 1},
+	},
+}
+
+var normalizationTable80 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11150, 0x11173, 1},
+		{0x11176, 0x11176, 1},
+	},
+}
+
+var normalizationTable81 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11EE0, 0x11EF6, 1},
+	},
+}
+
+var normalizationTable82 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0D00, 0x0D0C, 1},
+		{0x0D0E, 0x0D10, 1},
+		{0x0D12, 0x0D44, 1},
+		{0x0D46, 0x0D48, 1},
+		{0x0D4A, 0x0D4E, 1},
+		{0x0D54, 0x0D57, 1},
+		{0x0D5F, 0x0D63, 1},
+		{0x0D66, 0x0D6F, 1},
+		{0x0D7A, 0x0D7F, 1},
+	},
+}
+
+var normalizationTable83 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0840, 0x085B, 1},
+	},
+}
+
+var normalizationTable84 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10AC0, 0x10AC7, 1},
+		{0x10AC9, 0x10AE6, 1},
+	},
+}
+
+var normalizationTable85 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11C72, 0x11C8F, 1},
+		{0x11C92, 0x11CA7, 1},
+		{0x11CA9, 0x11CB6, 1},
+	},
+}
+
+var normalizationTable86 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11D00, 0x11D06, 1},
+		{0x11D08, 0x11D09, 1},
+		{0x11D0B, 0x11D36, 1},
+		{0x11D3A, 0x11D3A, 1},
+		{0x11D3C, 0x11D3D, 1},
+		{0x11D3F, 0x11D47, 1},
+		{0x11D50, 0x11D59, 1},
+	},
+}
+
+var normalizationTable87 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x16E60, 0x16E7F, 1},
+	},
+}
+
+var normalizationTable88 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xAAE0, 0xAAEF, 1},
+		{0xAAF2, 0xAAF6, 1},
+		{0xABC0, 0xABEA, 1},
+		{0xABEC, 0xABED, 1},
+		{0xABF0, 0xABF9, 1},
+	},
+}
+
+var normalizationTable89 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x1E800, 0x1E8C4, 1},
+		{0x1E8D0, 0x1E8D6, 1},
+	},
+}
+
+var normalizationTable90 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x109A0, 0x109B7, 1},
+		{0x109BE, 0x109BF, 1},
+	},
+}
+
+var normalizationTable91 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10980, 0x1099F, 1},
+	},
+}
+
+var normalizationTable92 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x16F00, 0x16F4A, 1},
+		{0x16F4F, 0x16F87, 1},
+		{0x16F8F, 0x16F9F, 1},
+	},
+}
+
+var normalizationTable93 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11600, 0x11640, 1},
+		{0x11644, 0x11644, 1},
+		{0x11650, 0x11659, 1},
+	},
+}
+
+var normalizationTable94 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1810, 0x1819, 1},
+		{0x1820, 0x1878, 1},
+		{0x1880, 0x18AA, 1},
+	},
+}
+
+var normalizationTable95 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x16A40, 0x16A5E, 1},
+		{0x16A60, 0x16A69, 1},
+	},
+}
+
+var normalizationTable96 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11280, 0x11286, 1},
+		{0x11288, 0x11288, 1},
+		{0x1128A, 0x1128D, 1},
+		{0x1128F, 0x1129D, 1},
+		{0x1129F, 0x112A8, 1},
+	},
+}
+
+var normalizationTable97 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1000, 0x1049, 1},
+		{0x1050, 0x109D, 1},
+		{0xA9E0, 0xA9FE, 1},
+		{0xAA60, 0xAA76, 1},
+		{0xAA7A, 0xAA7F, 1},
+	},
+}
+
+var normalizationTable98 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10880, 0x1089E, 1},
+	},
+}
+
+var normalizationTable99 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x1E4D0, 0x1E4F9, 1},
+	},
+}
+
+var normalizationTable100 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x119A0, 0x119A7, 1},
+		{0x119AA, 0x119D7, 1},
+		{0x119DA, 0x119E1, 1},
+		{0x119E3, 0x119E4, 1},
+	},
+}
+
+var normalizationTable101 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1980, 0x19AB, 1},
+		{0x19B0, 0x19C9, 1},
+		{0x19D0, 0x19D9, 1},
+	},
+}
+
+var normalizationTable102 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11400, 0x1144A, 1},
+		{0x11450, 0x11459, 1},
+		{0x1145E, 0x11461, 1},
+	},
+}
+
+var normalizationTable103 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x07C0, 0x07F5, 1},
+		{0x07FA, 0x07FA, 1},
+		{0x07FD, 0x07FD, 1},
+	},
+}
+
+var normalizationTable104 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x16FE1, 0x16FE1, 1},
+		{0x1B170, 0x1B2FB, 1},
+	},
+}
+
+var normalizationTable105 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x1E100, 0x1E12C, 1},
+		{0x1E130, 0x1E13D, 1},
+		{0x1E140, 0x1E149, 1},
+		{0x1E14E, 0x1E14E, 1},
+	},
+}
+
+var normalizationTable106 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1681, 0x169A, 1},
+	},
+}
+
+var normalizationTable107 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1C50, 0x1C7D, 1},
+	},
+}
+
+var normalizationTable108 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10CC0, 0x10CF2, 1},
+	},
+}
+
+var normalizationTable109 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10300, 0x1031F, 1},
+		{0x1032D, 0x1032F, 1},
+	},
+}
+
+var normalizationTable110 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10A80, 0x10A9C, 1},
+	},
+}
+
+var normalizationTable111 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10350, 0x1037A, 1},
+	},
+}
+
+var normalizationTable112 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x103A0, 0x103C3, 1},
+		{0x103C8, 0x103CF, 1},
+	},
+}
+
+var normalizationTable113 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10F00, 0x10F1C, 1},
+		{0x10F27, 0x10F27, 1},
+	},
+}
+
+var normalizationTable114 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10A60, 0x10A7C, 1},
+	},
+}
+
+var normalizationTable115 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10C00, 0x10C48, 1},
+	},
+}
+
+var normalizationTable116 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10F70, 0x10F85, 1},
+	},
+}
+
+var normalizationTable117 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0B01, 0x0B03, 1},
+		{0x0B05, 0x0B0C, 1},
+		{0x0B0F, 0x0B10, 1},
+		{0x0B13, 0x0B28, 1},
+		{0x0B2A, 0x0B30, 1},
+		{0x0B32, 0x0B33, 1},
+		{0x0B35, 0x0B39, 1},
+		{0x0B3C, 0x0B44, 1},
+		{0x0B47, 0x0B48, 1},
+		{0x0B4B, 0x0B4D, 1},
+		{0x0B55, 0x0B57, 1},
+		{0x0B5F, 0x0B63, 1},
+		{0x0B66, 0x0B6F, 1},
+		{0x0B71, 0x0B71, 1},
+	},
+}
+
+var normalizationTable118 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x104D8, 0x104FB, 1},
+	},
+}
+
+var normalizationTable119 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10480, 0x1049D, 1},
+		{0x104A0, 0x104A9, 1},
+	},
+}
+
+var normalizationTable120 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x16B00, 0x16B36, 1},
+		{0x16B40, 0x16B43, 1},
+		{0x16B50, 0x16B59, 1},
+		{0x16B63, 0x16B77, 1},
+		{0x16B7D, 0x16B8F, 1},
+	},
+}
+
+var normalizationTable121 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10860, 0x10876, 1},
+	},
+}
+
+var normalizationTable122 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11AC0, 0x11AF8, 1},
+	},
+}
+
+var normalizationTable123 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xA840, 0xA873, 1},
+	},
+}
+
+var normalizationTable124 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10900, 0x10915, 1},
+	},
+}
+
+var normalizationTable125 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10B80, 0x10B91, 1},
+	},
+}
+
+var normalizationTable126 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xA930, 0xA953, 1},
+	},
+}
+
+var normalizationTable127 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x16A0, 0x16EA, 1},
+		{0x16F1, 0x16F8, 1},
+	},
+}
+
+var normalizationTable128 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0800, 0x082D, 1},
+	},
+}
+
+var normalizationTable129 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xA880, 0xA8C5, 1},
+		{0xA8D0, 0xA8D9, 1},
+	},
+}
+
+var normalizationTable130 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11180, 0x111C4, 1},
+		{0x111C9, 0x111CC, 1},
+		{0x111CE, 0x111DA, 1},
+		{0x111DC, 0x111DC, 1},
+	},
+}
+
+var normalizationTable131 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10450, 0x1047F, 1},
+	},
+}
+
+var normalizationTable132 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11580, 0x115B5, 1},
+		{0x115B8, 0x115C0, 1},
+		{0x115D8, 0x115DD, 1},
+	},
+}
+
+var normalizationTable133 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x1DA00, 0x1DA36, 1},
+		{0x1DA3B, 0x1DA6C, 1},
+		{0x1DA75, 0x1DA75, 1},
+		{0x1DA84, 0x1DA84, 1},
+		{0x1DA9B, 0x1DA9F, 1},
+		{0x1DAA1, 0x1DAAF, 1},
+	},
+}
+
+var normalizationTable134 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0D81, 0x0D83, 1},
+		{0x0D85, 0x0D96, 1},
+		{0x0D9A, 0x0DB1, 1},
+		{0x0DB3, 0x0DBB, 1},
+		{0x0DBD, 0x0DBD, 1},
+		{0x0DC0, 0x0DC6, 1},
+		{0x0DCA, 0x0DCA, 1},
+		{0x0DCF, 0x0DD4, 1},
+		{0x0DD6, 0x0DD6, 1},
+		{0x0DD8, 0x0DDF, 1},
+		{0x0DE6, 0x0DEF, 1},
+		{0x0DF2, 0x0DF3, 1},
+	},
+}
+
+var normalizationTable135 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10F30, 0x10F50, 1},
+	},
+}
+
+var normalizationTable136 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x110D0, 0x110E8, 1},
+		{0x110F0, 0x110F9, 1},
+	},
+}
+
+var normalizationTable137 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11A50, 0x11A99, 1},
+		{0x11A9D, 0x11A9D, 1},
+	},
+}
+
+var normalizationTable138 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1B80, 0x1BBF, 1},
+	},
+}
+
+var normalizationTable139 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xA800, 0xA827, 1},
+		{0xA82C, 0xA82C, 1},
+	},
+}
+
+var normalizationTable140 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0710, 0x074A, 1},
+		{0x074D, 0x074F, 1},
+		{0x0860, 0x086A, 1},
+	},
+}
+
+var normalizationTable141 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1700, 0x1715, 1},
+		{0x171F, 0x171F, 1},
+	},
+}
+
+var normalizationTable142 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1760, 0x176C, 1},
+		{0x176E, 0x1770, 1},
+		{0x1772, 0x1773, 1},
+	},
+}
+
+var normalizationTable143 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1950, 0x196D, 1},
+		{0x1970, 0x1974, 1},
+	},
+}
+
+var normalizationTable144 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1A20, 0x1A5E, 1},
+		{0x1A60, 0x1A7C, 1},
+		{0x1A7F, 0x1A89, 1},
+		{0x1A90, 0x1A99, 1},
+		{0x1AA7, 0x1AA7, 1},
+	},
+}
+
+var normalizationTable145 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xAA80, 0xAAC2, 1},
+		{0xAADB, 0xAADD, 1},
+	},
+}
+
+var normalizationTable146 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11680, 0x116B8, 1},
+		{0x116C0, 0x116C9, 1},
+	},
+}
+
+var normalizationTable147 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0B82, 0x0B83, 1},
+		{0x0B85, 0x0B8A, 1},
+		{0x0B8E, 0x0B90, 1},
+		{0x0B92, 0x0B95, 1},
+		{0x0B99, 0x0B9A, 1},
+		{0x0B9C, 0x0B9C, 1},
+		{0x0B9E, 0x0B9F, 1},
+		{0x0BA3, 0x0BA4, 1},
+		{0x0BA8, 0x0BAA, 1},
+		{0x0BAE, 0x0BB9, 1},
+		{0x0BBE, 0x0BC2, 1},
+		{0x0BC6, 0x0BC8, 1},
+		{0x0BCA, 0x0BCD, 1},
+		{0x0BD0, 0x0BD0, 1},
+		{0x0BD7, 0x0BD7, 1},
+		{0x0BE6, 0x0BEF, 1},
+	},
+}
+
+var normalizationTable148 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x16A70, 0x16ABE, 1},
+		{0x16AC0, 0x16AC9, 1},
+	},
+}
+
+var normalizationTable149 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x16FE0, 0x16FE0, 1},
+		{0x17000, 0x187F7, 1},
+		{0x18800, 0x18AFF, 1},
+		{0x18D00, 0x18D08, 1},
+	},
+}
+
+var normalizationTable150 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0C00, 0x0C0C, 1},
+		{0x0C0E, 0x0C10, 1},
+		{0x0C12, 0x0C28, 1},
+		{0x0C2A, 0x0C39, 1},
+		{0x0C3C, 0x0C44, 1},
+		{0x0C46, 0x0C48, 1},
+		{0x0C4A, 0x0C4D, 1},
+		{0x0C55, 0x0C56, 1},
+		{0x0C58, 0x0C5A, 1},
+		{0x0C5D, 0x0C5D, 1},
+		{0x0C60, 0x0C63, 1},
+		{0x0C66, 0x0C6F, 1},
+	},
+}
+
+var normalizationTable151 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0780, 0x07B1, 1},
+	},
+}
+
+var normalizationTable152 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0E01, 0x0E32, 1},
+		{0x0E34, 0x0E3A, 1},
+		{0x0E40, 0x0E4E, 1},
+		{0x0E50, 0x0E59, 1},
+	},
+}
+
+var normalizationTable153 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0F00, 0x0F00, 1},
+		{0x0F18, 0x0F19, 1},
+		{0x0F20, 0x0F29, 1},
+		{0x0F35, 0x0F35, 1},
+		{0x0F37, 0x0F37, 1},
+		{0x0F39, 0x0F39, 1},
+		{0x0F3E, 0x0F42, 1},
+		{0x0F44, 0x0F47, 1},
+		{0x0F49, 0x0F4C, 1},
+		{0x0F4E, 0x0F51, 1},
+		{0x0F53, 0x0F56, 1},
+		{0x0F58, 0x0F5B, 1},
+		{0x0F5D, 0x0F68, 1},
+		{0x0F6A, 0x0F6C, 1},
+		{0x0F71, 0x0F72, 1},
+		{0x0F74, 0x0F74, 1},
+		{0x0F7A, 0x0F80, 1},
+		{0x0F82, 0x0F84, 1},
+		{0x0F86, 0x0F92, 1},
+		{0x0F94, 0x0F97, 1},
+		{0x0F99, 0x0F9C, 1},
+		{0x0F9E, 0x0FA1, 1},
+		{0x0FA3, 0x0FA6, 1},
+		{0x0FA8, 0x0FAB, 1},
+		{0x0FAD, 0x0FB8, 1},
+		{0x0FBA, 0x0FBC, 1},
+		{0x0FC6, 0x0FC6, 1},
+	},
+}
+
+var normalizationTable154 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x2D30, 0x2D67, 1},
+		{0x2D7F, 0x2D7F, 1},
+	},
+}
+
+var normalizationTable155 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11480, 0x114C5, 1},
+		{0x114C7, 0x114C7, 1},
+		{0x114D0, 0x114D9, 1},
+	},
+}
+
+var normalizationTable156 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x1E290, 0x1E2AE, 1},
+	},
+}
+
+var normalizationTable157 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10380, 0x1039D, 1},
+	},
+}
+
+var normalizationTable158 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xA500, 0xA60C, 1},
+		{0xA610, 0xA62B, 1},
+	},
+}
+
+var normalizationTable159 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10597, 0x105A1, 1},
+		{0x105A3, 0x105B1, 1},
+		{0x105B3, 0x105B9, 1},
+		{0x105BB, 0x105BC, 1},
+	},
+}
+
+var normalizationTable160 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x1E2C0, 0x1E2F9, 1},
+	},
+}
+
+var normalizationTable161 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x118C0, 0x118E9, 1},
+		{0x118FF, 0x118FF, 1},
+	},
+}
+
+var normalizationTable162 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x10E80, 0x10EA9, 1},
+		{0x10EAB, 0x10EAC, 1},
+		{0x10EB0, 0x10EB1, 1},
+	},
+}
+
+var normalizationTable163 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0xA000, 0xA48C, 1},
+	},
+}
+
+var normalizationTable164 = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{0x11A00, 0x11A3E, 1},
+		{0x11A47, 0x11A47, 1},
+	},
+}
+
+var normalizationTable165 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x3005, 0x3005, 1},
+		{0x303B, 0x303B, 1},
+		{0x3041, 0x3096, 1},
+		{0x309D, 0x309E, 1},
+		{0x30A1, 0x30FA, 1},
+		{0x30FD, 0x30FE, 1},
+		{0x31F0, 0x31FF, 1},
+		{0x3400, 0x4DBF, 1},
+		{0x4E00, 0x9FFF, 1},
+		{0xFA0E, 0xFA0F, 1},
+		{0xFA11, 0xFA11, 1},
+		{0xFA13, 0xFA14, 1},
+		{0xFA1F, 0xFA1F, 1},
+		{0xFA21, 0xFA21, 1},
+		{0xFA23, 0xFA24, 1},
+		{0xFA27, 0xFA29, 1},
+	},
+	R32: []unicode.Range32{
+		{0x16FE3, 0x16FE3, 1},
+		{0x16FF0, 0x16FF1, 1},
+		{0x1AFF0, 0x1AFF3, 1},
+		{0x1AFF5, 0x1AFFB, 1},
+		{0x1AFFD, 0x1AFFE, 1},
+		{0x1B000, 0x1B122, 1},
+		{0x1B132, 0x1B132, 1},
+		{0x1B150, 0x1B152, 1},
+		{0x1B155, 0x1B155, 1},
+		{0x1B164, 0x1B167, 1},
+		{0x20000, 0x2A6DF, 1},
+		{0x2A700, 0x2B739, 1},
+		{0x2B740, 0x2B81D, 1},
+		{0x2B820, 0x2CEA1, 1},
+		{0x2CEB0, 0x2EBE0, 1},
+		{0x30000, 0x3134A, 1},
+		{0x31350, 0x323AF, 1},
+	},
+}
+
+var normalizationTable166 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0024, 0x0024, 1},
+		{0x002D, 0x002D, 1},
+		{0x0030, 0x0039, 1},
+		{0x005F, 0x005F, 1},
+		{0x0061, 0x007A, 1},
+		{0x00B7, 0x00B7, 1},
+		{0x00DF, 0x00F6, 1},
+		{0x00F8, 0x00FF, 1},
+		{0x0101, 0x0101, 1},
+		{0x0103, 0x0103, 1},
+		{0x0105, 0x0105, 1},
+		{0x0107, 0x0107, 1},
+		{0x0109, 0x0109, 1},
+		{0x010B, 0x010B, 1},
+		{0x010D, 0x010D, 1},
+		{0x010F, 0x010F, 1},
+		{0x0111, 0x0111, 1},
+		{0x0113, 0x0113, 1},
+		{0x0115, 0x0115, 1},
+		{0x0117, 0x0117, 1},
+		{0x0119, 0x0119, 1},
+		{0x011B, 0x011B, 1},
+		{0x011D, 0x011D, 1},
+		{0x011F, 0x011F, 1},
+		{0x0121, 0x0121, 1},
+		{0x0123, 0x0123, 1},
+		{0x0125, 0x0125, 1},
+		{0x0127, 0x0127, 1},
+		{0x0129, 0x0129, 1},
+		{0x012B, 0x012B, 1},
+		{0x012D, 0x012D, 1},
+		{0x012F, 0x012F, 1},
+		{0x0131, 0x0131, 1},
+		{0x0135, 0x0135, 1},
+		{0x0137, 0x0138, 1},
+		{0x013A, 0x013A, 1},
+		{0x013C, 0x013C, 1},
+		{0x013E, 0x013E, 1},
+		{0x0142, 0x0142, 1},
+		{0x0144, 0x0144, 1},
+		{0x0146, 0x0146, 1},
+		{0x0148, 0x0148, 1},
+		{0x014B, 0x014B, 1},
+		{0x014D, 0x014D, 1},
+		{0x014F, 0x014F, 1},
+		{0x0151, 0x0151, 1},
+		{0x0153, 0x0153, 1},
+		{0x0155, 0x0155, 1},
+		{0x0157, 0x0157, 1},
+		{0x0159, 0x0159, 1},
+		{0x015B, 0x015B, 1},
+		{0x015D, 0x015D, 1},
+		{0x015F, 0x015F, 1},
+		{0x0161, 0x0161, 1},
+		{0x0163, 0x0163, 1},
+		{0x0165, 0x0165, 1},
+		{0x0167, 0x0167, 1},
+		{0x0169, 0x0169, 1},
+		{0x016B, 0x016B, 1},
+		{0x016D, 0x016D, 1},
+		{0x016F, 0x016F, 1},
+		{0x0171, 0x0171, 1},
+		{0x0173, 0x0173, 1},
+		{0x0175, 0x0175, 1},
+		{0x0177, 0x0177, 1},
+		{0x017A, 0x017A, 1},
+		{0x017C, 0x017C, 1},
+		{0x017E, 0x017E, 1},
+		{0x0180, 0x0180, 1},
+		{0x0183, 0x0183, 1},
+		{0x0185, 0x0185, 1},
+		{0x0188, 0x0188, 1},
+		{0x018C, 0x018D, 1},
+		{0x0192, 0x0192, 1},
+		{0x0195, 0x0195, 1},
+		{0x0199, 0x019B, 1},
+		{0x019E, 0x019E, 1},
+		{0x01A1, 0x01A1, 1},
+		{0x01A3, 0x01A3, 1},
+		{0x01A5, 0x01A5, 1},
+		{0x01A8, 0x01A8, 1},
+		{0x01AA, 0x01AB, 1},
+		{0x01AD, 0x01AD, 1},
+		{0x01B0, 0x01B0, 1},
+		{0x01B4, 0x01B4, 1},
+		{0x01B6, 0x01B6, 1},
+		{0x01B9, 0x01BB, 1},
+		{0x01BD, 0x01C3, 1},
+		{0x01CE, 0x01CE, 1},
+		{0x01D0, 0x01D0, 1},
+		{0x01D2, 0x01D2, 1},
+		{0x01D4, 0x01D4, 1},
+		{0x01D6, 0x01D6, 1},
+		{0x01D8, 0x01D8, 1},
+		{0x01DA, 0x01DA, 1},
+		{0x01DC, 0x01DD, 1},
+		{0x01DF, 0x01DF, 1},
+		{0x01E1, 0x01E1, 1},
+		{0x01E3, 0x01E3, 1},
+		{0x01E5, 0x01E5, 1},
+		{0x01E7, 0x01E7, 1},
+		{0x01E9, 0x01E9, 1},
+		{0x01EB, 0x01EB, 1},
+		{0x01ED, 0x01ED, 1},
+		{0x01EF, 0x01F0, 1},
+		{0x01F5, 0x01F5, 1},
+		{0x01F9, 0x01F9, 1},
+		{0x01FB, 0x01FB, 1},
+		{0x01FD, 0x01FD, 1},
+		{0x01FF, 0x01FF, 1},
+		{0x0201, 0x0201, 1},
+		{0x0203, 0x0203, 1},
+		{0x0205, 0x0205, 1},
+		{0x0207, 0x0207, 1},
+		{0x0209, 0x0209, 1},
+		{0x020B, 0x020B, 1},
+		{0x020D, 0x020D, 1},
+		{0x020F, 0x020F, 1},
+		{0x0211, 0x0211, 1},
+		{0x0213, 0x0213, 1},
+		{0x0215, 0x0215, 1},
+		{0x0217, 0x0217, 1},
+		{0x0219, 0x0219, 1},
+		{0x021B, 0x021B, 1},
+		{0x021D, 0x021D, 1},
+		{0x021F, 0x021F, 1},
+		{0x0221, 0x0221, 1},
+		{0x0223, 0x0223, 1},
+		{0x0225, 0x0225, 1},
+		{0x0227, 0x0227, 1},
+		{0x0229, 0x0229, 1},
+		{0x022B, 0x022B, 1},
+		{0x022D, 0x022D, 1},
+		{0x022F, 0x022F, 1},
+		{0x0231, 0x0231, 1},
+		{0x0233, 0x0239, 1},
+		{0x023C, 0x023C, 1},
+		{0x023F, 0x0240, 1},
+		{0x0242, 0x0242, 1},
+		{0x0247, 0x0247, 1},
+		{0x0249, 0x0249, 1},
+		{0x024B, 0x024B, 1},
+		{0x024D, 0x024D, 1},
+		{0x024F, 0x02AF, 1},
+		{0x02B9, 0x02C1, 1},
+		{0x02C6, 0x02D1, 1},
+		{0x02EC, 0x02EC, 1},
+		{0x02EE, 0x02EE, 1},
+		{0x0300, 0x033F, 1},
+		{0x0342, 0x0342, 1},
+		{0x0346, 0x034E, 1},
+		{0x0350, 0x036F, 1},
+		{0x0485, 0x0486, 1},
+		{0x0640, 0x0640, 1},
+		{0x064B, 0x0655, 1},
+		{0x0670, 0x0670, 1},
+		{0x0951, 0x0954, 1},
+		{0x1AB0, 0x1ABD, 1},
+		{0x1ABF, 0x1ACE, 1},
+		{0x1CD0, 0x1CD2, 1},
+		{0x1CD4, 0x1CFA, 1},
+		{0x1D00, 0x1D25, 1},
+		{0x1D2F, 0x1D2F, 1},
+		{0x1D3B, 0x1D3B, 1},
+		{0x1D4E, 0x1D4E, 1},
+		{0x1D6B, 0x1D77, 1},
+		{0x1D79, 0x1D9A, 1},
+		{0x1DC0, 0x1DFF, 1},
+		{0x1E01, 0x1E01, 1},
+		{0x1E03, 0x1E03, 1},
+		{0x1E05, 0x1E05, 1},
+		{0x1E07, 0x1E07, 1},
+		{0x1E09, 0x1E09, 1},
+		{0x1E0B, 0x1E0B, 1},
+		{0x1E0D, 0x1E0D, 1},
+		{0x1E0F, 0x1E0F, 1},
+		{0x1E11, 0x1E11, 1},
+		{0x1E13, 0x1E13, 1},
+		{0x1E15, 0x1E15, 1},
+		{0x1E17, 0x1E17, 1},
+		{0x1E19, 0x1E19, 1},
+		{0x1E1B, 0x1E1B, 1},
+		{0x1E1D, 0x1E1D, 1},
+		{0x1E1F, 0x1E1F, 1},
+		{0x1E21, 0x1E21, 1},
+		{0x1E23, 0x1E23, 1},
+		{0x1E25, 0x1E25, 1},
+		{0x1E27, 0x1E27, 1},
+		{0x1E29, 0x1E29, 1},
+		{0x1E2B, 0x1E2B, 1},
+		{0x1E2D, 0x1E2D, 1},
+		{0x1E2F, 0x1E2F, 1},
+		{0x1E31, 0x1E31, 1},
+		{0x1E33, 0x1E33, 1},
+		{0x1E35, 0x1E35, 1},
+		{0x1E37, 0x1E37, 1},
+		{0x1E39, 0x1E39, 1},
+		{0x1E3B, 0x1E3B, 1},
+		{0x1E3D, 0x1E3D, 1},
+		{0x1E3F, 0x1E3F, 1},
+		{0x1E41, 0x1E41, 1},
+		{0x1E43, 0x1E43, 1},
+		{0x1E45, 0x1E45, 1},
+		{0x1E47, 0x1E47, 1},
+		{0x1E49, 0x1E49, 1},
+		{0x1E4B, 0x1E4B, 1},
+		{0x1E4D, 0x1E4D, 1},
+		{0x1E4F, 0x1E4F, 1},
+		{0x1E51, 0x1E51, 1},
+		{0x1E53, 0x1E53, 1},
+		{0x1E55, 0x1E55, 1},
+		{0x1E57, 0x1E57, 1},
+		{0x1E59, 0x1E59, 1},
+		{0x1E5B, 0x1E5B, 1},
+		{0x1E5D, 0x1E5D, 1},
+		{0x1E5F, 0x1E5F, 1},
+		{0x1E61, 0x1E61, 1},
+		{0x1E63, 0x1E63, 1},
+		{0x1E65, 0x1E65, 1},
+		{0x1E67, 0x1E67, 1},
+		{0x1E69, 0x1E69, 1},
+		{0x1E6B, 0x1E6B, 1},
+		{0x1E6D, 0x1E6D, 1},
+		{0x1E6F, 0x1E6F, 1},
+		{0x1E71, 0x1E71, 1},
+		{0x1E73, 0x1E73, 1},
+		{0x1E75, 0x1E75, 1},
+		{0x1E77, 0x1E77, 1},
+		{0x1E79, 0x1E79, 1},
+		{0x1E7B, 0x1E7B, 1},
+		{0x1E7D, 0x1E7D, 1},
+		{0x1E7F, 0x1E7F, 1},
+		{0x1E81, 0x1E81, 1},
+		{0x1E83, 0x1E83, 1},
+		{0x1E85, 0x1E85, 1},
+		{0x1E87, 0x1E87, 1},
+		{0x1E89, 0x1E89, 1},
+		{0x1E8B, 0x1E8B, 1},
+		{0x1E8D, 0x1E8D, 1},
+		{0x1E8F, 0x1E8F, 1},
+		{0x1E91, 0x1E91, 1},
+		{0x1E93, 0x1E93, 1},
+		{0x1E95, 0x1E99, 1},
+		{0x1E9C, 0x1E9D, 1},
+		{0x1E9F, 0x1E9F, 1},
+		{0x1EA1, 0x1EA1, 1},
+		{0x1EA3, 0x1EA3, 1},
+		{0x1EA5, 0x1EA5, 1},
+		{0x1EA7, 0x1EA7, 1},
+		{0x1EA9, 0x1EA9, 1},
+		{0x1EAB, 0x1EAB, 1},
+		{0x1EAD, 0x1EAD, 1},
+		{0x1EAF, 0x1EAF, 1},
+		{0x1EB1, 0x1EB1, 1},
+		{0x1EB3, 0x1EB3, 1},
+		{0x1EB5, 0x1EB5, 1},
+		{0x1EB7, 0x1EB7, 1},
+		{0x1EB9, 0x1EB9, 1},
+		{0x1EBB, 0x1EBB, 1},
+		{0x1EBD, 0x1EBD, 1},
+		{0x1EBF, 0x1EBF, 1},
+		{0x1EC1, 0x1EC1, 1},
+		{0x1EC3, 0x1EC3, 1},
+		{0x1EC5, 0x1EC5, 1},
+		{0x1EC7, 0x1EC7, 1},
+		{0x1EC9, 0x1EC9, 1},
+		{0x1ECB, 0x1ECB, 1},
+		{0x1ECD, 0x1ECD, 1},
+		{0x1ECF, 0x1ECF, 1},
+		{0x1ED1, 0x1ED1, 1},
+		{0x1ED3, 0x1ED3, 1},
+		{0x1ED5, 0x1ED5, 1},
+		{0x1ED7, 0x1ED7, 1},
+		{0x1ED9, 0x1ED9, 1},
+		{0x1EDB, 0x1EDB, 1},
+		{0x1EDD, 0x1EDD, 1},
+		{0x1EDF, 0x1EDF, 1},
+		{0x1EE1, 0x1EE1, 1},
+		{0x1EE3, 0x1EE3, 1},
+		{0x1EE5, 0x1EE5, 1},
+		{0x1EE7, 0x1EE7, 1},
+		{0x1EE9, 0x1EE9, 1},
+		{0x1EEB, 0x1EEB, 1},
+		{0x1EED, 0x1EED, 1},
+		{0x1EEF, 0x1EEF, 1},
+		{0x1EF1, 0x1EF1, 1},
+		{0x1EF3, 0x1EF3, 1},
+		{0x1EF5, 0x1EF5, 1},
+		{0x1EF7, 0x1EF7, 1},
+		{0x1EF9, 0x1EF9, 1},
+		{0x1EFB, 0x1EFB, 1},
+		{0x1EFD, 0x1EFD, 1},
+		{0x1EFF, 0x1EFF, 1},
+		{0x2019, 0x2019, 1},
+		{0x2027, 0x2027, 1},
+		{0x2044, 0x2044, 1},
+		{0x20D0, 0x20DC, 1},
+		{0x20E1, 0x20E1, 1},
+		{0x20E5, 0x20F0, 1},
+		{0x214E, 0x214E, 1},
+		{0x2184, 0x2184, 1},
+		{0x2C61, 0x2C61, 1},
+		{0x2C65, 0x2C66, 1},
+		{0x2C68, 0x2C68, 1},
+		{0x2C6A, 0x2C6A, 1},
+		{0x2C6C, 0x2C6C, 1},
+		{0x2C71, 0x2C71, 1},
+		{0x2C73, 0x2C74, 1},
+		{0x2C76, 0x2C7B, 1},
+		{0x2E2F, 0x2E2F, 1},
+		{0x3006, 0x3006, 1},
+		{0x302A, 0x302D, 1},
+		{0x3031, 0x3035, 1},
+		{0x303C, 0x303C, 1},
+		{0x3099, 0x309A, 1},
+		{0x30FB, 0x30FC, 1},
+		{0xA717, 0xA71F, 1},
+		{0xA723, 0xA723, 1},
+		{0xA725, 0xA725, 1},
+		{0xA727, 0xA727, 1},
+		{0xA729, 0xA729, 1},
+		{0xA72B, 0xA72B, 1},
+		{0xA72D, 0xA72D, 1},
+		{0xA72F, 0xA731, 1},
+		{0xA733, 0xA733, 1},
+		{0xA735, 0xA735, 1},
+		{0xA737, 0xA737, 1},
+		{0xA739, 0xA739, 1},
+		{0xA73B, 0xA73B, 1},
+		{0xA73D, 0xA73D, 1},
+		{0xA73F, 0xA73F, 1},
+		{0xA741, 0xA741, 1},
+		{0xA743, 0xA743, 1},
+		{0xA745, 0xA745, 1},
+		{0xA747, 0xA747, 1},
+		{0xA749, 0xA749, 1},
+		{0xA74B, 0xA74B, 1},
+		{0xA74D, 0xA74D, 1},
+		{0xA74F, 0xA74F, 1},
+		{0xA751, 0xA751, 1},
+		{0xA753, 0xA753, 1},
+		{0xA755, 0xA755, 1},
+		{0xA757, 0xA757, 1},
+		{0xA759, 0xA759, 1},
+		{0xA75B, 0xA75B, 1},
+		{0xA75D, 0xA75D, 1},
+		{0xA75F, 0xA75F, 1},
+		{0xA761, 0xA761, 1},
+		{0xA763, 0xA763, 1},
+		{0xA765, 0xA765, 1},
+		{0xA767, 0xA767, 1},
+		{0xA769, 0xA769, 1},
+		{0xA76B, 0xA76B, 1},
+		{0xA76D, 0xA76D, 1},
+		{0xA76F, 0xA76F, 1},
+		{0xA771, 0xA778, 1},
+		{0xA77A, 0xA77A, 1},
+		{0xA77C, 0xA77C, 1},
+		{0xA77F, 0xA77F, 1},
+		{0xA781, 0xA781, 1},
+		{0xA783, 0xA783, 1},
+		{0xA785, 0xA785, 1},
+		{0xA787, 0xA788, 1},
+		{0xA78C, 0xA78C, 1},
+		{0xA78E, 0xA78F, 1},
+		{0xA791, 0xA791, 1},
+		{0xA793, 0xA795, 1},
+		{0xA797, 0xA797, 1},
+		{0xA799, 0xA799, 1},
+		{0xA79B, 0xA79B, 1},
+		{0xA79D, 0xA79D, 1},
+		{0xA79F, 0xA79F, 1},
+		{0xA7A1, 0xA7A1, 1},
+		{0xA7A3, 0xA7A3, 1},
+		{0xA7A5, 0xA7A5, 1},
+		{0xA7A7, 0xA7A7, 1},
+		{0xA7A9, 0xA7A9, 1},
+		{0xA7AF, 0xA7AF, 1},
+		{0xA7B5, 0xA7B5, 1},
+		{0xA7B7, 0xA7B7, 1},
+		{0xA7B9, 0xA7B9, 1},
+		{0xA7BB, 0xA7BB, 1},
+		{0xA7BD, 0xA7BD, 1},
+		{0xA7BF, 0xA7BF, 1},
+		{0xA7C1, 0xA7C1, 1},
+		{0xA7C3, 0xA7C3, 1},
+		{0xA7C8, 0xA7C8, 1},
+		{0xA7CA, 0xA7CA, 1},
+		{0xA7D1, 0xA7D1, 1},
+		{0xA7D3, 0xA7D3, 1},
+		{0xA7D5, 0xA7D5, 1},
+		{0xA7D7, 0xA7D7, 1},
+		{0xA7D9, 0xA7D9, 1},
+		{0xA7F6, 0xA7F7, 1},
+		{0xA7FA, 0xA7FF, 1},
+		{0xA9CF, 0xA9CF, 1},
+		{0xAB30, 0xAB5A, 1},
+		{0xAB60, 0xAB64, 1},
+		{0xAB66, 0xAB68, 1},
+		{0xFE20, 0xFE2D, 1},
+	},
+	R32: []unicode.Range32{
+		{0x101FD, 0x101FD, 1},
+		{0x102E0, 0x102E0, 1},
+		{0x10780, 0x10780, 1},
+		{0x1133B, 0x1133B, 1},
+		{0x1CF00, 0x1CF2D, 1},
+		{0x1CF30, 0x1CF46, 1},
+		{0x1D165, 0x1D169, 1},
+		{0x1D16D, 0x1D172, 1},
+		{0x1D17B, 0x1D182, 1},
+		{0x1D185, 0x1D18B, 1},
+		{0x1D1AA, 0x1D1AD, 1},
+		{0x1DF00, 0x1DF1E, 1},
+		{0x1DF25, 0x1DF2A, 1},
+	},
+	LatinOffset: 8,
+}
+
+var normalizationTable167 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x3005, 0x3005, 1},
+		{0x303B, 0x303B, 1},
+		{0x3105, 0x312F, 1},
+		{0x31A0, 0x31BF, 1},
+		{0x3400, 0x4DBF, 1},
+		{0x4E00, 0x9FFF, 1},
+		{0xFA0E, 0xFA0F, 1},
+		{0xFA11, 0xFA11, 1},
+		{0xFA13, 0xFA14, 1},
+		{0xFA1F, 0xFA1F, 1},
+		{0xFA21, 0xFA21, 1},
+		{0xFA23, 0xFA24, 1},
+		{0xFA27, 0xFA29, 1},
+	},
+	R32: []unicode.Range32{
+		{0x16FE3, 0x16FE3, 1},
+		{0x16FF0, 0x16FF1, 1},
+		{0x20000, 0x2A6DF, 1},
+		{0x2A700, 0x2B739, 1},
+		{0x2B740, 0x2B81D, 1},
+		{0x2B820, 0x2CEA1, 1},
+		{0x2CEB0, 0x2EBE0, 1},
+		{0x30000, 0x3134A, 1},
+		{0x31350, 0x323AF, 1},
+	},
+}
+
+var normalizationTable168 = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1100, 0x115E, 1},
+		{0x1161, 0x11FF, 1},
+		{0x3005, 0x3005, 1},
+		{0x302E, 0x302F, 1},
+		{0x303B, 0x303B, 1},
+		{0x3400, 0x4DBF, 1},
+		{0x4E00, 0x9FFF, 1},
+		{0xA960, 0xA97C, 1},
+		{0xAC00, 0xD7A3, 1},
+		{0xD7B0, 0xD7C6, 1},
+		{0xD7CB, 0xD7FB, 1},
+		{0xFA0E, 0xFA0F, 1},
+		{0xFA11, 0xFA11, 1},
+		{0xFA13, 0xFA14, 1},
+		{0xFA1F, 0xFA1F, 1},
+		{0xFA21, 0xFA21, 1},
+		{0xFA23, 0xFA24, 1},
+		{0xFA27, 0xFA29, 1},
+	},
+	R32: []unicode.Range32{
+		{0x16FE3, 0x16FE3, 1},
+		{0x16FF0, 0x16FF1, 1},
+		{0x20000, 0x2A6DF, 1},
+		{0x2A700, 0x2B739, 1},
+		{0x2B740, 0x2B81D, 1},
+		{0x2B820, 0x2CEA1, 1},
+		{0x2CEB0, 0x2EBE0, 1},
+		{0x30000, 0x3134A, 1},
+		{0x31350, 0x323AF, 1},
+	},
+}
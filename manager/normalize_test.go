@@ -0,0 +1,120 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// normalizationVectors is the path of the ENSIP-15 test vectors, tests.json,
+// published with the reference implementation ens-normalize.js in validate
+var normalizationVectors = filepath.Join("testdata", "ensip15-tests.json")
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name       string
+		normalized string
+		invalid    bool
+	}{
+		{name: "nick.eth", normalized: "nick.eth"},
+		{name: "Nick.ETH", normalized: "nick.eth"},
+		{name: "CAFÉ.eth", normalized: "café.eth"},
+		{name: "_nick.eth", normalized: "_nick.eth"},
+		{name: "\U0001F44D.eth", normalized: "\U0001F44D.eth"},
+		{name: "\U0001F44D️.eth", normalized: "\U0001F44D.eth"},
+		{name: "nick..eth", invalid: true},
+		{name: "ni_ck.eth", invalid: true},
+		{name: "ab--cd.eth", invalid: true},
+		{name: "ni ck.eth", invalid: true},
+		{name: "'nick.eth", invalid: true},
+		{name: "́nick.eth", invalid: true},
+		// Latin with a Cyrillic 'а'
+		{name: "pаypal.eth", invalid: true},
+		// Wholly Cyrillic letters that look like Latin "ace"
+		{name: "асе.eth", invalid: true},
+	}
+	for _, test := range tests {
+		normalized, err := Normalize(test.name)
+		if test.invalid {
+			if err == nil {
+				t.Errorf("%q normalized to %q, expected an error", test.name, normalized)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q failed to normalize: %v", test.name, err)
+		} else if normalized != test.normalized {
+			t.Errorf("%q normalized to %q, expected %q", test.name, normalized, test.normalized)
+		}
+	}
+}
+
+func TestConfusable(t *testing.T) {
+	// Latin alpha in place of 'a'
+	if lookalike, confusable := Confusable("p\u0251ypal.eth"); !confusable || lookalike != "paypal.eth" {
+		t.Errorf("p\u0251ypal.eth gave %q, %v", lookalike, confusable)
+	}
+	if lookalike, confusable := Confusable("café.eth"); confusable {
+		t.Errorf("café.eth gave %q", lookalike)
+	}
+	if lookalike, confusable := Confusable("nick.eth"); confusable {
+		t.Errorf("nick.eth gave %q", lookalike)
+	}
+}
+
+// TestNormalizeVectors checks normalization against the ENSIP-15 test
+// vectors, which must match the data that the tables are generated from
+func TestNormalizeVectors(t *testing.T) {
+	data, err := ioutil.ReadFile(normalizationVectors)
+	if os.IsNotExist(err) {
+		t.Skipf("no test vectors at %s; run go generate to download them along with spec.json (tables are %s)", normalizationVectors, normalizationSource)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	vectors := make([]struct {
+		Name  string  `json:"name"`
+		Norm  *string `json:"norm"`
+		Error bool    `json:"error"`
+	}, 0)
+	if err = json.Unmarshal(data, &vectors); err != nil {
+		t.Fatal(err)
+	}
+	for _, vector := range vectors {
+		if vector.Name == "" {
+			// Empty names are valid for ENSIP-15 but refused here
+			continue
+		}
+		normalized, err := Normalize(vector.Name)
+		if vector.Error {
+			if err == nil {
+				t.Errorf("%+q normalized to %+q, expected an error", vector.Name, normalized)
+			}
+			continue
+		}
+		expected := vector.Name
+		if vector.Norm != nil {
+			expected = *vector.Norm
+		}
+		if err != nil {
+			t.Errorf("%+q failed to normalize: %v", vector.Name, err)
+		} else if normalized != expected {
+			t.Errorf("%+q normalized to %+q, expected %+q", vector.Name, normalized, expected)
+		}
+	}
+}
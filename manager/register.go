@@ -0,0 +1,245 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/orinocopay/go-etherutils/ens"
+)
+
+// ErrNoController is returned when the registrar controller for 'eth' cannot
+// be found
+var ErrNoController = errors.New("no registrar controller for eth")
+
+// MinRegistrationDuration is the shortest period for which a name can be
+// registered or renewed
+var MinRegistrationDuration = 28 * 24 * time.Hour
+
+// controllerInterfaceID is the interface ID of the registrar controller, as
+// published by the resolver for 'eth'
+var controllerInterfaceID = [4]byte{0x01, 0x8f, 0xac, 0x06}
+
+const controllerABIJSON = `[
+{"constant":true,"inputs":[{"name":"name","type":"string"}],"name":"available","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[{"name":"name","type":"string"},{"name":"duration","type":"uint256"}],"name":"rentPrice","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[{"name":"name","type":"string"},{"name":"owner","type":"address"},{"name":"secret","type":"bytes32"}],"name":"makeCommitment","outputs":[{"name":"","type":"bytes32"}],"payable":false,"stateMutability":"pure","type":"function"},
+{"constant":true,"inputs":[{"name":"","type":"bytes32"}],"name":"commitments","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[],"name":"minCommitmentAge","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[],"name":"maxCommitmentAge","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":false,"inputs":[{"name":"commitment","type":"bytes32"}],"name":"commit","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"name","type":"string"},{"name":"owner","type":"address"},{"name":"duration","type":"uint256"},{"name":"secret","type":"bytes32"}],"name":"register","outputs":[],"payable":true,"stateMutability":"payable","type":"function"},
+{"constant":false,"inputs":[{"name":"name","type":"string"},{"name":"duration","type":"uint256"}],"name":"renew","outputs":[],"payable":true,"stateMutability":"payable","type":"function"}
+]`
+
+const interfaceResolverABIJSON = `[
+{"constant":true,"inputs":[{"name":"node","type":"bytes32"},{"name":"interfaceID","type":"bytes4"}],"name":"interfaceImplementer","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"}
+]`
+
+var controllerABI = mustParseABI(controllerABIJSON)
+var interfaceResolverABI = mustParseABI(interfaceResolverABIJSON)
+
+// WithController returns a manager that uses the registrar controller at the
+// given address rather than the one published for 'eth'
+func (m *Manager) WithController(address common.Address) *Manager {
+	withController := *m
+	withController.controllerAddress = address
+	return &withController
+}
+
+// Controller returns the address of the registrar controller for 'eth'
+func (m *Manager) Controller(ctx context.Context) (common.Address, error) {
+	if m.controllerAddress != ens.UnknownAddress {
+		return m.controllerAddress, nil
+	}
+	resolverAddress, err := m.Resolver(ctx, "eth")
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	if resolverAddress == ens.UnknownAddress {
+		return ens.UnknownAddress, ErrNoController
+	}
+	var address common.Address
+	err = m.bound(resolverAddress, interfaceResolverABI).Call(&bind.CallOpts{Context: ctx}, &address, "interfaceImplementer", ens.NameHash("eth"), controllerInterfaceID)
+	if err != nil || address == ens.UnknownAddress {
+		return ens.UnknownAddress, ErrNoController
+	}
+	return address, nil
+}
+
+// controller returns the registrar controller contract
+func (m *Manager) controller(ctx context.Context) (*bind.BoundContract, error) {
+	address, err := m.Controller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return m.bound(address, controllerABI), nil
+}
+
+// label returns the label of a name directly under 'eth'
+func label(name string) (string, error) {
+	if ens.DomainLevel(name) != 1 || !strings.HasSuffix(name, ".eth") {
+		return "", fmt.Errorf("%s is not directly under eth", name)
+	}
+	return strings.TrimSuffix(name, ".eth"), nil
+}
+
+// Available returns true if a name directly under 'eth' can be registered
+func (m *Manager) Available(ctx context.Context, name string) (bool, error) {
+	nameLabel, err := label(name)
+	if err != nil {
+		return false, err
+	}
+	controller, err := m.controller(ctx)
+	if err != nil {
+		return false, err
+	}
+	var available bool
+	err = controller.Call(&bind.CallOpts{Context: ctx}, &available, "available", nameLabel)
+	return available, err
+}
+
+// RentPrice returns the price to register or renew a name directly under
+// 'eth' for the given duration
+func (m *Manager) RentPrice(ctx context.Context, name string, duration time.Duration) (*big.Int, error) {
+	nameLabel, err := label(name)
+	if err != nil {
+		return nil, err
+	}
+	controller, err := m.controller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	price := new(*big.Int)
+	err = controller.Call(&bind.CallOpts{Context: ctx}, price, "rentPrice", nameLabel, seconds(duration))
+	if err != nil {
+		return nil, err
+	}
+	return *price, nil
+}
+
+// CommitmentAges returns the time that must pass after a commitment is mined
+// before the name can be registered, and the time after which the commitment
+// expires
+func (m *Manager) CommitmentAges(ctx context.Context) (time.Duration, time.Duration, error) {
+	controller, err := m.controller(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	minAge := new(*big.Int)
+	if err = controller.Call(opts, minAge, "minCommitmentAge"); err != nil {
+		return 0, 0, err
+	}
+	maxAge := new(*big.Int)
+	if err = controller.Call(opts, maxAge, "maxCommitmentAge"); err != nil {
+		return 0, 0, err
+	}
+	return time.Duration((*minAge).Int64()) * time.Second, time.Duration((*maxAge).Int64()) * time.Second, nil
+}
+
+// MakeCommitment returns the commitment for registering a name to an owner
+// with a secret
+func (m *Manager) MakeCommitment(ctx context.Context, name string, owner common.Address, secret [32]byte) ([32]byte, error) {
+	var commitment [32]byte
+	nameLabel, err := label(name)
+	if err != nil {
+		return commitment, err
+	}
+	controller, err := m.controller(ctx)
+	if err != nil {
+		return commitment, err
+	}
+	err = controller.Call(&bind.CallOpts{Context: ctx}, &commitment, "makeCommitment", nameLabel, owner, secret)
+	return commitment, err
+}
+
+// CommitmentTime returns the time at which a commitment was mined, or the
+// zero time if the controller does not hold the commitment
+func (m *Manager) CommitmentTime(ctx context.Context, commitment [32]byte) (time.Time, error) {
+	controller, err := m.controller(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	timestamp := new(*big.Int)
+	if err = controller.Call(&bind.CallOpts{Context: ctx}, timestamp, "commitments", commitment); err != nil {
+		return time.Time{}, err
+	}
+	if (*timestamp).Sign() == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix((*timestamp).Int64(), 0), nil
+}
+
+// Commit sends a commitment to the registrar controller from the given
+// address
+func (m *Manager) Commit(ctx context.Context, from common.Address, commitment [32]byte, opts *TxOpts) (*types.Transaction, error) {
+	controller, err := m.controller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transactOpts, err := m.transactOpts(ctx, from, opts)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := controller.Transact(transactOpts, "commit", commitment)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// Register registers a name directly under 'eth' to an owner for the given
+// duration, sending the transaction from the given address with the given
+// value.  The name must have been committed with the same owner and secret
+// at least the minimum commitment age ago.  Value above the rent price is
+// refunded by the controller
+func (m *Manager) Register(ctx context.Context, from common.Address, name string, owner common.Address, duration time.Duration, secret [32]byte, value *big.Int, opts *TxOpts) (*types.Transaction, error) {
+	if duration < MinRegistrationDuration {
+		return nil, fmt.Errorf("duration must be at least %v", MinRegistrationDuration)
+	}
+	nameLabel, err := label(name)
+	if err != nil {
+		return nil, err
+	}
+	controller, err := m.controller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transactOpts, err := m.transactOpts(ctx, from, opts)
+	if err != nil {
+		return nil, err
+	}
+	transactOpts.Value = value
+	tx, err := controller.Transact(transactOpts, "register", nameLabel, owner, seconds(duration), secret)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// seconds returns a duration as a number of seconds
+func seconds(duration time.Duration) *big.Int {
+	return big.NewInt(int64(duration / time.Second))
+}
@@ -0,0 +1,216 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/orinocopay/go-etherutils/ens"
+)
+
+// ErrNoBulkRenewal is returned when there is no bulk renewal contract
+var ErrNoBulkRenewal = errors.New("no bulk renewal contract for eth")
+
+// bulkRenewalInterfaceID is the interface ID of the bulk renewal contract, as
+// published by the resolver for 'eth'
+var bulkRenewalInterfaceID = [4]byte{0x31, 0x50, 0xbf, 0xba}
+
+// Bulk renewal selectors.  The contract takes string arrays, which this
+// version of the ABI package does not encode, so calls are encoded directly
+var bulkRentPriceSelector = crypto.Keccak256([]byte("rentPrice(string[],uint256)"))[:4]
+var bulkRenewAllSelector = crypto.Keccak256([]byte("renewAll(string[],uint256)"))[:4]
+
+// BulkRenewal returns the address of the bulk renewal contract for 'eth'
+func (m *Manager) BulkRenewal(ctx context.Context) (common.Address, error) {
+	resolverAddress, err := m.Resolver(ctx, "eth")
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	if resolverAddress == ens.UnknownAddress {
+		return ens.UnknownAddress, ErrNoBulkRenewal
+	}
+	var address common.Address
+	err = m.bound(resolverAddress, interfaceResolverABI).Call(&bind.CallOpts{Context: ctx}, &address, "interfaceImplementer", ens.NameHash("eth"), bulkRenewalInterfaceID)
+	if err != nil || address == ens.UnknownAddress {
+		return ens.UnknownAddress, ErrNoBulkRenewal
+	}
+	return address, nil
+}
+
+// labels returns the labels of names directly under 'eth'
+func labels(names []string) ([]string, error) {
+	res := make([]string, len(names))
+	for i, name := range names {
+		nameLabel, err := label(name)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = nameLabel
+	}
+	return res, nil
+}
+
+// BulkRentPrice returns the price to renew all of the given names directly
+// under 'eth' for the given duration with the bulk renewal contract
+func (m *Manager) BulkRentPrice(ctx context.Context, names []string, duration time.Duration) (*big.Int, error) {
+	nameLabels, err := labels(names)
+	if err != nil {
+		return nil, err
+	}
+	bulkRenewal, err := m.BulkRenewal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	output, err := m.backend.CallContract(ctx, ethereum.CallMsg{
+		To:   &bulkRenewal,
+		Data: packStringsCall(bulkRentPriceSelector, nameLabels, seconds(duration)),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(output) != 32 {
+		return nil, fmt.Errorf("unexpected rent price from bulk renewal contract")
+	}
+	return new(big.Int).SetBytes(output), nil
+}
+
+// Renew extends the registration of a name directly under 'eth' by the given
+// duration, sending the transaction from the given address with the given
+// value.  Value above the rent price is refunded by the controller
+func (m *Manager) Renew(ctx context.Context, from common.Address, name string, duration time.Duration, value *big.Int, opts *TxOpts) (*types.Transaction, error) {
+	if duration < MinRegistrationDuration {
+		return nil, fmt.Errorf("duration must be at least %v", MinRegistrationDuration)
+	}
+	nameLabel, err := label(name)
+	if err != nil {
+		return nil, err
+	}
+	controller, err := m.controller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transactOpts, err := m.transactOpts(ctx, from, opts)
+	if err != nil {
+		return nil, err
+	}
+	transactOpts.Value = value
+	tx, err := controller.Transact(transactOpts, "renew", nameLabel, seconds(duration))
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// RenewAll extends the registrations of names directly under 'eth' by the
+// given duration in a single transaction with the bulk renewal contract
+func (m *Manager) RenewAll(ctx context.Context, from common.Address, names []string, duration time.Duration, value *big.Int, opts *TxOpts) (*types.Transaction, error) {
+	if duration < MinRegistrationDuration {
+		return nil, fmt.Errorf("duration must be at least %v", MinRegistrationDuration)
+	}
+	nameLabels, err := labels(names)
+	if err != nil {
+		return nil, err
+	}
+	bulkRenewal, err := m.BulkRenewal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transactOpts, err := m.transactOpts(ctx, from, opts)
+	if err != nil {
+		return nil, err
+	}
+	transactOpts.Value = value
+	tx, err := m.transactRaw(transactOpts, bulkRenewal, packStringsCall(bulkRenewAllSelector, nameLabels, seconds(duration)))
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// transactRaw sends a transaction with pre-encoded input, as bound contracts
+// do for encoded methods
+func (m *Manager) transactRaw(opts *bind.TransactOpts, to common.Address, input []byte) (*types.Transaction, error) {
+	var err error
+	value := opts.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	var nonce uint64
+	if opts.Nonce == nil {
+		nonce, err = m.backend.PendingNonceAt(opts.Context, opts.From)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		nonce = opts.Nonce.Uint64()
+	}
+	gasPrice := opts.GasPrice
+	if gasPrice == nil {
+		gasPrice, err = m.backend.SuggestGasPrice(opts.Context)
+		if err != nil {
+			return nil, err
+		}
+	}
+	gasLimit := opts.GasLimit
+	if gasLimit == nil {
+		gasLimit, err = m.backend.EstimateGas(opts.Context, ethereum.CallMsg{From: opts.From, To: &to, Value: value, Data: input})
+		if err != nil {
+			return nil, err
+		}
+	}
+	tx, err := opts.Signer(types.HomesteadSigner{}, opts.From, types.NewTransaction(nonce, to, value, gasLimit, gasPrice, input))
+	if err != nil {
+		return nil, err
+	}
+	if err = m.backend.SendTransaction(opts.Context, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// packStringsCall encodes a call to a function that takes a string array and
+// an integer
+func packStringsCall(selector []byte, values []string, number *big.Int) []byte {
+	word := func(n int) []byte {
+		return math.PaddedBigBytes(big.NewInt(int64(n)), 32)
+	}
+
+	// Array contents: offsets to each string followed by the strings
+	offsets := make([]byte, 0, 32*len(values))
+	contents := make([]byte, 0)
+	for _, value := range values {
+		offsets = append(offsets, word(32*len(values)+len(contents))...)
+		contents = append(contents, word(len(value))...)
+		contents = append(contents, common.RightPadBytes([]byte(value), (len(value)+31)/32*32)...)
+	}
+
+	data := append([]byte{}, selector...)
+	data = append(data, word(64)...)
+	data = append(data, math.PaddedBigBytes(number, 32)...)
+	data = append(data, word(len(values))...)
+	data = append(data, offsets...)
+	return append(data, contents...)
+}
@@ -0,0 +1,186 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/orinocopay/go-etherutils/ens"
+)
+
+// extendedResolverInterfaceID is the interface ID of resolvers that support
+// wildcard resolution with resolve() (ENSIP-10)
+var extendedResolverInterfaceID = [4]byte{0x90, 0x61, 0xb9, 0x23}
+
+// ABI content types
+var (
+	abiJSON     = big.NewInt(1)
+	abiZlibJSON = big.NewInt(2)
+)
+
+const resolverABIJSON = `[
+{"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"addr","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[{"name":"node","type":"bytes32"},{"name":"key","type":"string"}],"name":"text","outputs":[{"name":"","type":"string"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[{"name":"node","type":"bytes32"},{"name":"contentTypes","type":"uint256"}],"name":"ABI","outputs":[{"name":"contentType","type":"uint256"},{"name":"data","type":"bytes"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"contenthash","outputs":[{"name":"","type":"bytes"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[{"name":"interfaceID","type":"bytes4"}],"name":"supportsInterface","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[{"name":"name","type":"bytes"},{"name":"data","type":"bytes"}],"name":"resolve","outputs":[{"name":"","type":"bytes"}],"payable":false,"stateMutability":"view","type":"function"}
+]`
+
+var resolverABI = mustParseABI(resolverABIJSON)
+
+// foundResolver is the resolver found for a name
+type foundResolver struct {
+	address common.Address
+	// name is the name for which the resolver is set, which is a parent of
+	// the name queried for wildcard resolution
+	name string
+	// extended is true if the resolver supports resolve()
+	extended bool
+}
+
+// supportsInterface returns true if the contract at the given address
+// reports that it supports the interface.  Contracts that predate interface
+// detection report nothing
+func (m *Manager) supportsInterface(ctx context.Context, address common.Address, interfaceID [4]byte) bool {
+	var supported bool
+	err := m.bound(address, resolverABI).Call(&bind.CallOpts{Context: ctx}, &supported, "supportsInterface", interfaceID)
+	return err == nil && supported
+}
+
+// findResolver finds the resolver for a name, or returns nil if there is
+// none.  If the name has no resolver then its parents are searched in turn,
+// and a resolver found for a parent is used only if it supports wildcard
+// resolution
+func (m *Manager) findResolver(ctx context.Context, name string) (*foundResolver, error) {
+	current := name
+	for {
+		address, err := m.Resolver(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		if address != ens.UnknownAddress {
+			extended := m.supportsInterface(ctx, address, extendedResolverInterfaceID)
+			if current != name && !extended {
+				return nil, nil
+			}
+			return &foundResolver{address: address, name: current, extended: extended}, nil
+		}
+		dot := strings.Index(current, ".")
+		if dot == -1 {
+			return nil, nil
+		}
+		current = current[dot+1:]
+	}
+}
+
+// FindResolver returns the resolver for a name and the name for which it is
+// set.  If the name has no resolver of its own then the resolver of the
+// closest parent that has one is returned, provided that it supports
+// wildcard resolution (ENSIP-10).  If there is no resolver then it returns
+// the zero address
+func (m *Manager) FindResolver(ctx context.Context, name string) (common.Address, string, error) {
+	found, err := m.findResolver(ctx, name)
+	if err != nil || found == nil {
+		return ens.UnknownAddress, "", err
+	}
+	return found.address, found.name, nil
+}
+
+// resolverQuery calls a method of the resolver for a name, unpacking the
+// answer in to result.  Resolvers that support wildcard resolution are
+// queried through resolve() with the DNS-encoded name, and off-chain lookups
+// requested by the resolver are followed
+func (m *Manager) resolverQuery(ctx context.Context, name string, result interface{}, method string, args ...interface{}) error {
+	found, err := m.findResolver(ctx, name)
+	if err != nil {
+		return err
+	}
+	if found == nil {
+		return fmt.Errorf("no resolver for %s", name)
+	}
+	data, err := resolverABI.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+	if found.extended {
+		encoded, err := dnsEncode(name)
+		if err != nil {
+			return err
+		}
+		if data, err = resolverABI.Pack("resolve", encoded, data); err != nil {
+			return err
+		}
+	}
+
+	answer, err := m.call(ctx, found.address, data)
+	if err != nil {
+		return err
+	}
+	if found.extended {
+		var resolved []byte
+		if err = resolverABI.Unpack(&resolved, "resolve", answer); err != nil {
+			return err
+		}
+		answer = resolved
+	}
+	return resolverABI.Unpack(result, method, answer)
+}
+
+// Text returns a text record of a name
+func (m *Manager) Text(ctx context.Context, name string, key string) (string, error) {
+	var text string
+	err := m.resolverQuery(ctx, name, &text, "text", ens.NameHash(name), key)
+	return text, err
+}
+
+// ABI returns the JSON ABI of a name.  ABIs held compressed are decompressed
+func (m *Manager) ABI(ctx context.Context, name string) ([]byte, error) {
+	record := new(struct {
+		ContentType *big.Int
+		Data        []byte
+	})
+	err := m.resolverQuery(ctx, name, record, "ABI", ens.NameHash(name), new(big.Int).Or(abiJSON, abiZlibJSON))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case record.ContentType == nil || record.ContentType.Sign() == 0:
+		return nil, fmt.Errorf("no ABI for %s", name)
+	case record.ContentType.Cmp(abiZlibJSON) == 0:
+		reader, err := zlib.NewReader(bytes.NewReader(record.Data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	default:
+		return record.Data, nil
+	}
+}
+
+// ContentHash returns the content hash of a name (EIP-1577)
+func (m *Manager) ContentHash(ctx context.Context, name string) ([]byte, error) {
+	var hash []byte
+	err := m.resolverQuery(ctx, name, &hash, "contenthash", ens.NameHash(name))
+	return hash, err
+}
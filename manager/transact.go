@@ -0,0 +1,551 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/orinocopay/go-etherutils/ens"
+	"github.com/orinocopay/go-etherutils/ens/deedcontract"
+	"github.com/orinocopay/go-etherutils/ens/resolvercontract"
+)
+
+// ErrUnsuitableState is returned when a name is not in a suitable state for
+// the requested operation
+var ErrUnsuitableState = errors.New("name not in a suitable state")
+
+// ErrReadOnly is returned when a read-only manager is asked to send a
+// transaction
+var ErrReadOnly = errors.New("read-only mode")
+
+// ErrNoOwner is returned when a name has no owner
+var ErrNoOwner = errors.New("owner is not set")
+
+// ErrNoAccount is returned when a transaction is requested without a means
+// of obtaining the sending account
+var ErrNoAccount = errors.New("no source for the sending account")
+
+// minimumNameLength is the minimum length of a name under 'eth' with the
+// auction registrar, and permanentMinimumNameLength with the permanent
+// registrar
+var minimumNameLength = 7
+var permanentMinimumNameLength = 3
+
+// MinimumNameLength returns the minimum length of a name under 'eth'
+func (m *Manager) MinimumNameLength() int {
+	if m.Permanent() {
+		return permanentMinimumNameLength
+	}
+	return minimumNameLength
+}
+
+// TxOpts are the options for sending a transaction.  The wallet for the
+// sending account is obtained from Account, and must be unlockable with the
+// passphrase
+type TxOpts struct {
+	Passphrase string
+	GasPrice   *big.Int
+	// Account obtains the wallet and account for the sending address
+	Account func(address common.Address, passphrase string) (accounts.Wallet, *accounts.Account, error)
+	// Nonce, if supplied, sets the nonce of the transaction.  Otherwise the
+	// node selects the nonce
+	Nonce func(opts *bind.TransactOpts) error
+	// Sent, if supplied, is called with each transaction once it is sent
+	Sent func(from common.Address, tx *types.Transaction)
+}
+
+// Bid is a sealed bid for a name
+type Bid struct {
+	Owner  common.Address
+	Amount *big.Int
+	// Mask is the amount of Ether sent with the bid, to hide its true value.
+	// It is raised to the amount if lower
+	Mask    *big.Int
+	Salt    string
+	Dummies int
+}
+
+// account obtains the wallet and account for an address
+func (m *Manager) account(address common.Address, opts *TxOpts) (accounts.Wallet, *accounts.Account, error) {
+	if m.readOnly {
+		return nil, nil, ErrReadOnly
+	}
+	if opts.Account == nil {
+		return nil, nil, ErrNoAccount
+	}
+	return opts.Account(address, opts.Passphrase)
+}
+
+// prepare sets up the transaction options for a session
+func (o *TxOpts) prepare(ctx context.Context, opts *bind.TransactOpts) error {
+	opts.Context = ctx
+	if o.Nonce != nil {
+		return o.Nonce(opts)
+	}
+	return nil
+}
+
+// sent notes that a transaction has been sent
+func (o *TxOpts) sent(from common.Address, tx *types.Transaction) {
+	if o.Sent != nil {
+		o.Sent(from, tx)
+	}
+}
+
+// ownedBy returns the owner of a name, which must be set.  For wrapped names
+// this is the owner in the name wrapper
+func (m *Manager) ownedBy(ctx context.Context, name string) (common.Address, error) {
+	owner, err := m.EffectiveOwner(ctx, name)
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	if owner == ens.UnknownAddress {
+		return ens.UnknownAddress, ErrNoOwner
+	}
+	return owner, nil
+}
+
+// inState returns an error unless a name directly under 'eth' is in the
+// given registrar state
+func (m *Manager) inState(ctx context.Context, name string, state string) error {
+	current, err := m.State(ctx, name)
+	if err != nil {
+		return err
+	}
+	if current != state {
+		return ErrUnsuitableState
+	}
+	return nil
+}
+
+// resolverSession returns a session with the resolver of a name, sending
+// transactions from the owner of the name
+func (m *Manager) resolverSession(ctx context.Context, name string, opts *TxOpts) (*resolvercontract.ResolverContractSession, error) {
+	if IsETH2LD(name) {
+		if err := m.inState(ctx, name, "Owned"); err != nil {
+			return nil, err
+		}
+	}
+	owner, err := m.ownedBy(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	wallet, account, err := m.account(owner, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resolverAddress, err := m.Resolver(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if resolverAddress == ens.UnknownAddress {
+		return nil, fmt.Errorf("no resolver for %s", name)
+	}
+	resolverContract, err := resolvercontract.NewResolverContract(resolverAddress, m.backend)
+	if err != nil {
+		return nil, err
+	}
+	session := ens.CreateResolverSession(m.chainID, &wallet, account, opts.Passphrase, resolverContract, opts.GasPrice)
+	if err = opts.prepare(ctx, &session.TransactOpts); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// SetAddress sets the address of a name with its resolver.  The transaction
+// is sent from the owner of the name
+func (m *Manager) SetAddress(ctx context.Context, name string, address common.Address, opts *TxOpts) (*types.Transaction, error) {
+	session, err := m.resolverSession(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := ens.SetResolution(session, name, &address)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(session.TransactOpts.From, tx)
+	return tx, nil
+}
+
+// SetABI sets the ABI of a name with its resolver, stored as JSON or, if
+// compressed, as zlib-compressed JSON.  The transaction is sent from the
+// owner of the name
+func (m *Manager) SetABI(ctx context.Context, name string, abi string, compressed bool, opts *TxOpts) (*types.Transaction, error) {
+	session, err := m.resolverSession(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	contentType := big.NewInt(1)
+	if compressed {
+		contentType = big.NewInt(2)
+	}
+	tx, err := ens.SetAbi(session, name, abi, contentType)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(session.TransactOpts.From, tx)
+	return tx, nil
+}
+
+// Transfer transfers the registration of a name directly under 'eth' to a
+// new registrant, or the ownership of a wrapped name at any level with the
+// name wrapper.  The transaction is sent from the current registrant, or
+// owner of the wrapped name.  With the permanent registrar the owner of the
+// name in the registry, its controller, is unchanged; see SetOwner
+func (m *Manager) Transfer(ctx context.Context, name string, to common.Address, opts *TxOpts) (*types.Transaction, error) {
+	wrapped, err := m.Wrapped(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if wrapped != nil {
+		return m.transferWrapped(ctx, name, wrapped, to, opts)
+	}
+	if !IsETH2LD(name) {
+		return nil, fmt.Errorf("%s is not directly under eth", name)
+	}
+	if err := m.inState(ctx, name, "Owned"); err != nil {
+		return nil, err
+	}
+	registrant, err := m.Registrant(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if registrant == ens.UnknownAddress {
+		return nil, ErrNoOwner
+	}
+	if m.Permanent() {
+		return m.transferRegistration(ctx, name, registrant, to, opts)
+	}
+	wallet, account, err := m.account(registrant, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	session := ens.CreateRegistrarSession(m.chainID, &wallet, account, opts.Passphrase, m.registrar, opts.GasPrice)
+	if err = opts.prepare(ctx, &session.TransactOpts); err != nil {
+		return nil, err
+	}
+	tx, err := ens.Transfer(session, name, to)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(session.TransactOpts.From, tx)
+	return tx, nil
+}
+
+// transferRegistration transfers the registration of a name in the permanent
+// registrar
+func (m *Manager) transferRegistration(ctx context.Context, name string, registrant common.Address, to common.Address, opts *TxOpts) (*types.Transaction, error) {
+	id, err := labelID(name)
+	if err != nil {
+		return nil, err
+	}
+	transactOpts, err := m.transactOpts(ctx, registrant, opts)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := m.bound(m.registrarAddress, baseRegistrarABI).Transact(transactOpts, "transferFrom", registrant, to, id)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// SetOwner sets the owner of a name in the registry, which is the controller
+// of the name's records.  For names directly under 'eth' with the permanent
+// registrar the ownership is reclaimed from the registrar by the registrant,
+// otherwise it is set by the current owner.  Wrapped names are controlled by
+// their owner in the name wrapper, so cannot have their owner set
+func (m *Manager) SetOwner(ctx context.Context, name string, owner common.Address, opts *TxOpts) (*types.Transaction, error) {
+	current, err := m.Owner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := m.wrapped(ctx, name, current)
+	if err != nil {
+		return nil, err
+	}
+	if wrapped != nil {
+		return nil, fmt.Errorf("%s is wrapped, so is controlled by its owner in the name wrapper", name)
+	}
+
+	if IsETH2LD(name) && m.Permanent() {
+		registrant, err := m.Registrant(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if registrant == ens.UnknownAddress {
+			return nil, ErrNoOwner
+		}
+		id, err := labelID(name)
+		if err != nil {
+			return nil, err
+		}
+		transactOpts, err := m.transactOpts(ctx, registrant, opts)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := m.bound(m.registrarAddress, baseRegistrarABI).Transact(transactOpts, "reclaim", id, owner)
+		if err != nil {
+			return nil, err
+		}
+		opts.sent(transactOpts.From, tx)
+		return tx, nil
+	}
+
+	if current == ens.UnknownAddress {
+		return nil, ErrNoOwner
+	}
+	transactOpts, err := m.transactOpts(ctx, current, opts)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := m.registry.SetOwner(transactOpts, ens.NameHash(name), owner)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// transferWrapped transfers the ownership of a wrapped name
+func (m *Manager) transferWrapped(ctx context.Context, name string, wrapped *WrappedName, to common.Address, opts *TxOpts) (*types.Transaction, error) {
+	if wrapped.Owner == ens.UnknownAddress {
+		return nil, ErrNoOwner
+	}
+	if err := checkFuse(name, wrapped, CannotTransfer); err != nil {
+		return nil, err
+	}
+	transactOpts, err := m.transactOpts(ctx, wrapped.Owner, opts)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := m.NameWrapperContract(wrapped.NameWrapper).Transfer(transactOpts, ens.NameHash(name), to)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// StartAuction starts the auction for a name directly under 'eth', sending
+// the transaction from the given address.  If a bid is supplied then it is
+// placed along with starting the auction
+func (m *Manager) StartAuction(ctx context.Context, name string, from common.Address, bid *Bid, opts *TxOpts) (*types.Transaction, error) {
+	if !IsETH2LD(name) {
+		return nil, fmt.Errorf("%s is not directly under eth", name)
+	}
+	if utf8.RuneCountInString(strings.TrimSuffix(name, ".eth")) < minimumNameLength {
+		return nil, fmt.Errorf("name must be at least %d characters long", minimumNameLength)
+	}
+	if err := m.inState(ctx, name, "Available"); err != nil {
+		return nil, err
+	}
+	wallet, account, err := m.account(from, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	session := ens.CreateRegistrarSession(m.chainID, &wallet, account, opts.Passphrase, m.registrar, opts.GasPrice)
+	if err = opts.prepare(ctx, &session.TransactOpts); err != nil {
+		return nil, err
+	}
+	var tx *types.Transaction
+	if bid == nil || bid.Amount == nil || bid.Amount.Sign() == 0 {
+		tx, err = ens.StartAuction(session, name)
+	} else {
+		if bid.Salt == "" {
+			return nil, errors.New("salt is required")
+		}
+		mask := new(big.Int).Set(bid.Amount)
+		if bid.Mask != nil && bid.Mask.Cmp(bid.Amount) > 0 {
+			mask.Set(bid.Mask)
+		}
+		session.TransactOpts.Value = mask
+		tx, err = ens.StartAuctionAndBid(session, name, &bid.Owner, *bid.Amount, bid.Salt, bid.Dummies)
+	}
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(session.TransactOpts.From, tx)
+	return tx, nil
+}
+
+// PlaceBid places a sealed bid in the auction for a name directly under
+// 'eth'.  The transaction is sent from the owner of the bid
+func (m *Manager) PlaceBid(ctx context.Context, name string, bid *Bid, opts *TxOpts) (*types.Transaction, error) {
+	if bid.Salt == "" {
+		return nil, errors.New("salt is required")
+	}
+	if err := m.inState(ctx, name, "Bidding"); err != nil {
+		return nil, err
+	}
+	wallet, account, err := m.account(bid.Owner, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	session := ens.CreateRegistrarSession(m.chainID, &wallet, account, opts.Passphrase, m.registrar, opts.GasPrice)
+	if err = opts.prepare(ctx, &session.TransactOpts); err != nil {
+		return nil, err
+	}
+	mask := new(big.Int).Set(bid.Amount)
+	if bid.Mask != nil && bid.Mask.Cmp(bid.Amount) > 0 {
+		mask.Set(bid.Mask)
+	}
+	session.TransactOpts.Value = mask
+	tx, err := ens.NewBid(session, name, &bid.Owner, *bid.Amount, bid.Salt)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(session.TransactOpts.From, tx)
+	return tx, nil
+}
+
+// RevealBid reveals a bid in the auction for a name directly under 'eth'.
+// The amount and salt must match those of the sealed bid, and the
+// transaction is sent from the owner of the bid
+func (m *Manager) RevealBid(ctx context.Context, name string, bid *Bid, opts *TxOpts) (*types.Transaction, error) {
+	if bid.Salt == "" {
+		return nil, errors.New("salt is required")
+	}
+	if err := m.inState(ctx, name, "Revealing"); err != nil {
+		return nil, err
+	}
+	wallet, account, err := m.account(bid.Owner, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	session := ens.CreateRegistrarSession(m.chainID, &wallet, account, opts.Passphrase, m.registrar, opts.GasPrice)
+	if err = opts.prepare(ctx, &session.TransactOpts); err != nil {
+		return nil, err
+	}
+	tx, err := ens.RevealBid(session, name, &bid.Owner, *bid.Amount, bid.Salt)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(session.TransactOpts.From, tx)
+	return tx, nil
+}
+
+// FinishAuction finishes the auction for a name directly under 'eth',
+// registering it to the winner.  The transaction is sent from the owner of
+// the winning deed
+func (m *Manager) FinishAuction(ctx context.Context, name string, opts *TxOpts) (*types.Transaction, error) {
+	if err := m.inState(ctx, name, "Won"); err != nil {
+		return nil, err
+	}
+	// The name has no owner until the auction is finished
+	owner, err := m.Owner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if owner != ens.UnknownAddress {
+		return nil, errors.New("auction already finished")
+	}
+	_, deedAddress, _, _, _, err := m.Entry(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	deed, err := deedcontract.NewDeedContract(deedAddress, m.backend)
+	if err != nil {
+		return nil, err
+	}
+	winner, err := deed.Owner(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+	wallet, account, err := m.account(winner, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	session := ens.CreateRegistrarSession(m.chainID, &wallet, account, opts.Passphrase, m.registrar, opts.GasPrice)
+	if err = opts.prepare(ctx, &session.TransactOpts); err != nil {
+		return nil, err
+	}
+	tx, err := ens.FinishAuction(session, name)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(session.TransactOpts.From, tx)
+	return tx, nil
+}
+
+// Invalidate invalidates the registration of a name directly under 'eth'
+// in the auction registrar that is shorter than the minimum length, sending
+// the transaction from the given address
+func (m *Manager) Invalidate(ctx context.Context, name string, from common.Address, opts *TxOpts) (*types.Transaction, error) {
+	if m.Permanent() {
+		return nil, errors.New("names cannot be invalidated with the permanent registrar")
+	}
+	state, err := m.State(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if state != "Won" && state != "Owned" {
+		return nil, ErrUnsuitableState
+	}
+	wallet, account, err := m.account(from, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	session := ens.CreateRegistrarSession(m.chainID, &wallet, account, opts.Passphrase, m.registrar, opts.GasPrice)
+	if err = opts.prepare(ctx, &session.TransactOpts); err != nil {
+		return nil, err
+	}
+	tx, err := ens.InvalidateName(session, name)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(session.TransactOpts.From, tx)
+	return tx, nil
+}
+
+// SetReverseName sets the name for an address with the reverse registrar.
+// The transaction is sent from the address
+func (m *Manager) SetReverseName(ctx context.Context, address common.Address, name string, opts *TxOpts) (*types.Transaction, error) {
+	reverseRegistrar, err := m.ReverseRegistrar(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wallet, account, err := m.account(address, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	session := ens.CreateReverseRegistrarSession(m.chainID, &wallet, account, opts.Passphrase, reverseRegistrar, opts.GasPrice)
+	if err = opts.prepare(ctx, &session.TransactOpts); err != nil {
+		return nil, err
+	}
+	tx, err := ens.SetName(session, name)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(session.TransactOpts.From, tx)
+	return tx, nil
+}
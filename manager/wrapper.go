@@ -0,0 +1,608 @@
+// Copyright © 2017 Orinoco Payments
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/orinocopay/go-etherutils/ens"
+)
+
+// ErrNoNameWrapper is returned when the name wrapper cannot be found
+var ErrNoNameWrapper = errors.New("no name wrapper for eth")
+
+// ErrNotWrapped is returned when a name that must be wrapped is not
+var ErrNotWrapped = errors.New("name is not wrapped")
+
+// nameWrapperInterfaceID is the interface ID of the name wrapper, as
+// published by the resolver for 'eth'
+var nameWrapperInterfaceID = [4]byte{0x01, 0x9a, 0x38, 0xbf}
+
+// Fuses of wrapped names.  Owner-controlled fuses are burned by the owner of
+// a name, parent-controlled fuses by the owner of its parent
+const (
+	CannotUnwrap          uint32 = 1
+	CannotBurnFuses       uint32 = 2
+	CannotTransfer        uint32 = 4
+	CannotSetResolver     uint32 = 8
+	CannotSetTTL          uint32 = 16
+	CannotCreateSubdomain uint32 = 32
+	CannotApprove         uint32 = 64
+	ParentCannotControl   uint32 = 1 << 16
+	IsDotEth              uint32 = 1 << 17
+	CanExtendExpiry       uint32 = 1 << 18
+
+	OwnerControlledFuses  uint32 = 0xffff
+	ParentControlledFuses uint32 = 0xffff0000
+)
+
+// fuseNames are the names of the fuses, as used by the name wrapper
+var fuseNames = []struct {
+	name string
+	fuse uint32
+}{
+	{"CANNOT_UNWRAP", CannotUnwrap},
+	{"CANNOT_BURN_FUSES", CannotBurnFuses},
+	{"CANNOT_TRANSFER", CannotTransfer},
+	{"CANNOT_SET_RESOLVER", CannotSetResolver},
+	{"CANNOT_SET_TTL", CannotSetTTL},
+	{"CANNOT_CREATE_SUBDOMAIN", CannotCreateSubdomain},
+	{"CANNOT_APPROVE", CannotApprove},
+	{"PARENT_CANNOT_CONTROL", ParentCannotControl},
+	{"IS_DOT_ETH", IsDotEth},
+	{"CAN_EXTEND_EXPIRY", CanExtendExpiry},
+}
+
+// FuseNames returns the names of the fuses that are burned.  Fuses without
+// a name are given as hex values
+func FuseNames(fuses uint32) []string {
+	names := make([]string, 0)
+	for _, fuseName := range fuseNames {
+		if fuses&fuseName.fuse != 0 {
+			names = append(names, fuseName.name)
+			fuses &^= fuseName.fuse
+		}
+	}
+	for bit := uint(0); bit < 32; bit++ {
+		if fuses&(1<<bit) != 0 {
+			names = append(names, fmt.Sprintf("0x%x", uint32(1)<<bit))
+		}
+	}
+	return names
+}
+
+// ParseFuse parses the name of a fuse, for example CANNOT_UNWRAP
+func ParseFuse(name string) (uint32, error) {
+	for _, fuseName := range fuseNames {
+		if strings.EqualFold(name, fuseName.name) {
+			return fuseName.fuse, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown fuse %s", name)
+}
+
+const nameWrapperABIJSON = `[
+{"constant":true,"inputs":[],"name":"ens","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[{"name":"id","type":"uint256"}],"name":"getData","outputs":[{"name":"owner","type":"address"},{"name":"fuses","type":"uint32"},{"name":"expiry","type":"uint64"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":false,"inputs":[{"name":"label","type":"string"},{"name":"wrappedOwner","type":"address"},{"name":"ownerControlledFuses","type":"uint16"},{"name":"resolver","type":"address"}],"name":"wrapETH2LD","outputs":[{"name":"expiry","type":"uint64"}],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"name","type":"bytes"},{"name":"wrappedOwner","type":"address"},{"name":"resolver","type":"address"}],"name":"wrap","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"labelhash","type":"bytes32"},{"name":"registrant","type":"address"},{"name":"controller","type":"address"}],"name":"unwrapETH2LD","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"parentNode","type":"bytes32"},{"name":"labelhash","type":"bytes32"},{"name":"controller","type":"address"}],"name":"unwrap","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"node","type":"bytes32"},{"name":"ownerControlledFuses","type":"uint16"}],"name":"setFuses","outputs":[{"name":"","type":"uint32"}],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"parentNode","type":"bytes32"},{"name":"labelhash","type":"bytes32"},{"name":"fuses","type":"uint32"},{"name":"expiry","type":"uint64"}],"name":"setChildFuses","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"node","type":"bytes32"},{"name":"resolver","type":"address"}],"name":"setResolver","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"node","type":"bytes32"},{"name":"ttl","type":"uint64"}],"name":"setTTL","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"parentNode","type":"bytes32"},{"name":"label","type":"string"},{"name":"owner","type":"address"},{"name":"fuses","type":"uint32"},{"name":"expiry","type":"uint64"}],"name":"setSubnodeOwner","outputs":[{"name":"","type":"bytes32"}],"payable":false,"stateMutability":"nonpayable","type":"function"},
+{"constant":false,"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"id","type":"uint256"},{"name":"amount","type":"uint256"},{"name":"data","type":"bytes"}],"name":"safeTransferFrom","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}
+]`
+
+// approvalABIJSON covers operator approval, which the registry and the
+// permanent registrar both provide
+const approvalABIJSON = `[
+{"constant":true,"inputs":[{"name":"owner","type":"address"},{"name":"operator","type":"address"}],"name":"isApprovedForAll","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"view","type":"function"},
+{"constant":false,"inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}],"name":"setApprovalForAll","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}
+]`
+
+var nameWrapperABI = mustParseABI(nameWrapperABIJSON)
+var approvalABI = mustParseABI(approvalABIJSON)
+
+// WrappedName is the state of a name in the name wrapper
+type WrappedName struct {
+	NameWrapper common.Address
+	Owner       common.Address
+	Fuses       uint32
+	// Expiry is the time at which the fuses, and for names with
+	// PARENT_CANNOT_CONTROL burned the ownership, expire
+	Expiry time.Time
+}
+
+// NameWrapperContract is the name wrapper, with the transactions that manage
+// wrapped names in place of the registry
+type NameWrapperContract struct {
+	Address  common.Address
+	contract *bind.BoundContract
+}
+
+// SetSubnodeOwner sets the owner of a subdomain of a wrapped name, which
+// wraps the subdomain
+func (c *NameWrapperContract) SetSubnodeOwner(opts *bind.TransactOpts, parentNode [32]byte, label string, owner common.Address) (*types.Transaction, error) {
+	return c.contract.Transact(opts, "setSubnodeOwner", parentNode, label, owner, uint32(0), uint64(0))
+}
+
+// SetResolver sets the resolver of a wrapped name
+func (c *NameWrapperContract) SetResolver(opts *bind.TransactOpts, node [32]byte, resolver common.Address) (*types.Transaction, error) {
+	return c.contract.Transact(opts, "setResolver", node, resolver)
+}
+
+// SetTTL sets the TTL of a wrapped name
+func (c *NameWrapperContract) SetTTL(opts *bind.TransactOpts, node [32]byte, ttl uint64) (*types.Transaction, error) {
+	return c.contract.Transact(opts, "setTTL", node, ttl)
+}
+
+// Transfer transfers the ownership of a wrapped name
+func (c *NameWrapperContract) Transfer(opts *bind.TransactOpts, node [32]byte, to common.Address) (*types.Transaction, error) {
+	return c.contract.Transact(opts, "safeTransferFrom", opts.From, to, new(big.Int).SetBytes(node[:]), big.NewInt(1), []byte{})
+}
+
+// WithNameWrapper returns a manager that uses the name wrapper at the given
+// address rather than the one published for 'eth'
+func (m *Manager) WithNameWrapper(address common.Address) *Manager {
+	withNameWrapper := *m
+	withNameWrapper.nameWrapperAddress = address
+	return &withNameWrapper
+}
+
+// NameWrapper returns the address of the name wrapper
+func (m *Manager) NameWrapper(ctx context.Context) (common.Address, error) {
+	if m.nameWrapperAddress != ens.UnknownAddress {
+		return m.nameWrapperAddress, nil
+	}
+	resolverAddress, err := m.Resolver(ctx, "eth")
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	if resolverAddress == ens.UnknownAddress {
+		return ens.UnknownAddress, ErrNoNameWrapper
+	}
+	var address common.Address
+	err = m.bound(resolverAddress, interfaceResolverABI).Call(&bind.CallOpts{Context: ctx}, &address, "interfaceImplementer", ens.NameHash("eth"), nameWrapperInterfaceID)
+	if err != nil || address == ens.UnknownAddress {
+		return ens.UnknownAddress, ErrNoNameWrapper
+	}
+	return address, nil
+}
+
+// NameWrapperContract returns the name wrapper contract at the given address
+func (m *Manager) NameWrapperContract(address common.Address) *NameWrapperContract {
+	return &NameWrapperContract{Address: address, contract: m.bound(address, nameWrapperABI)}
+}
+
+// isNameWrapper returns true if the contract at the given address is a name
+// wrapper for the manager's registry.  Unless the name wrapper was given to
+// the manager this is found by asking the contract, as names can be wrapped
+// by a wrapper other than the one currently published for 'eth'
+func (m *Manager) isNameWrapper(ctx context.Context, address common.Address) bool {
+	if address == ens.UnknownAddress {
+		return false
+	}
+	if m.nameWrapperAddress != ens.UnknownAddress {
+		return address == m.nameWrapperAddress
+	}
+	if known, exists := m.nameWrappers.Load(address); exists {
+		return known.(bool)
+	}
+	contract := m.bound(address, nameWrapperABI)
+	var registryAddress common.Address
+	if err := contract.Call(&bind.CallOpts{Context: ctx}, &registryAddress, "ens"); err != nil {
+		// Most owners are accounts, or contracts without this method
+		m.nameWrappers.Store(address, false)
+		return false
+	}
+	isNameWrapper := registryAddress == m.registryAddress
+	if isNameWrapper {
+		// Other contracts that refer to the registry do not hold names
+		data := new(wrapperData)
+		isNameWrapper = contract.Call(&bind.CallOpts{Context: ctx}, data, "getData", big.NewInt(0)) == nil
+	}
+	m.nameWrappers.Store(address, isNameWrapper)
+	return isNameWrapper
+}
+
+// wrapperData is the data held by the name wrapper for a name
+type wrapperData struct {
+	Owner  common.Address
+	Fuses  uint32
+	Expiry uint64
+}
+
+// Wrapped returns the state of a name in the name wrapper, or nil if the
+// name is not wrapped
+func (m *Manager) Wrapped(ctx context.Context, name string) (*WrappedName, error) {
+	owner, err := m.Owner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return m.wrapped(ctx, name, owner)
+}
+
+// wrapped returns the state of a name with the given registry owner in the
+// name wrapper, or nil if the name is not wrapped
+func (m *Manager) wrapped(ctx context.Context, name string, owner common.Address) (*WrappedName, error) {
+	if !m.isNameWrapper(ctx, owner) {
+		return nil, nil
+	}
+	nameHash := ens.NameHash(name)
+	data := new(wrapperData)
+	err := m.bound(owner, nameWrapperABI).Call(&bind.CallOpts{Context: ctx}, data, "getData", new(big.Int).SetBytes(nameHash[:]))
+	if err != nil {
+		return nil, err
+	}
+	wrapped := &WrappedName{
+		NameWrapper: owner,
+		Owner:       data.Owner,
+		Fuses:       data.Fuses,
+	}
+	if data.Expiry != 0 {
+		wrapped.Expiry = time.Unix(int64(data.Expiry), 0)
+	}
+	return wrapped, nil
+}
+
+// EffectiveOwner returns the owner of a name: the owner in the name wrapper
+// if the name is wrapped, otherwise the owner in the registry
+func (m *Manager) EffectiveOwner(ctx context.Context, name string) (common.Address, error) {
+	owner, err := m.Owner(ctx, name)
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	wrapped, err := m.wrapped(ctx, name, owner)
+	if err != nil {
+		return ens.UnknownAddress, err
+	}
+	if wrapped != nil {
+		return wrapped.Owner, nil
+	}
+	return owner, nil
+}
+
+// checkFuse returns an error if the given fuse of a wrapped name is burned
+func checkFuse(name string, wrapped *WrappedName, fuse uint32) error {
+	if wrapped != nil && wrapped.Fuses&fuse != 0 {
+		return fmt.Errorf("%s has %s burned", name, FuseNames(fuse)[0])
+	}
+	return nil
+}
+
+// wrappedOwner returns the state of a wrapped name, which must have an owner
+func (m *Manager) wrappedOwner(ctx context.Context, name string) (*WrappedName, error) {
+	wrapped, err := m.Wrapped(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if wrapped == nil {
+		return nil, ErrNotWrapped
+	}
+	if wrapped.Owner == ens.UnknownAddress {
+		return nil, ErrNoOwner
+	}
+	return wrapped, nil
+}
+
+// splitName splits a name in to its first label and its parent
+func splitName(name string) (string, string, error) {
+	nameBits := strings.SplitN(name, ".", 2)
+	if len(nameBits) != 2 || nameBits[0] == "" || nameBits[1] == "" {
+		return "", "", fmt.Errorf("%s has no parent", name)
+	}
+	return nameBits[0], nameBits[1], nil
+}
+
+// dnsEncode encodes a name in DNS wire format, as the name wrapper expects
+func dnsEncode(name string) ([]byte, error) {
+	encoded := make([]byte, 0, len(name)+2)
+	for _, nameLabel := range strings.Split(name, ".") {
+		if len(nameLabel) == 0 || len(nameLabel) > 63 {
+			return nil, fmt.Errorf("invalid label in %s", name)
+		}
+		encoded = append(encoded, byte(len(nameLabel)))
+		encoded = append(encoded, nameLabel...)
+	}
+	return append(encoded, 0), nil
+}
+
+// IsETH2LD returns true if a name is directly under 'eth'
+func IsETH2LD(name string) bool {
+	return ens.DomainLevel(name) == 1 && strings.HasSuffix(name, ".eth")
+}
+
+// wrapSource returns the address that can wrap a name, and the contract
+// with which the name wrapper must be approved to do so: the registrant and
+// the permanent registrar for names directly under 'eth', otherwise the
+// owner and the registry
+func (m *Manager) wrapSource(ctx context.Context, name string) (common.Address, common.Address, error) {
+	if IsETH2LD(name) {
+		if !m.Permanent() {
+			return ens.UnknownAddress, ens.UnknownAddress, errors.New("names under eth can only be wrapped with the permanent registrar")
+		}
+		registrant, err := m.Registrant(ctx, name)
+		if err == nil && registrant == ens.UnknownAddress {
+			err = ErrNoOwner
+		}
+		return registrant, m.registrarAddress, err
+	}
+	owner, err := m.ownedBy(ctx, name)
+	return owner, m.registryAddress, err
+}
+
+// ApproveNameWrapper approves the name wrapper to take the name from its
+// current holder, which it must be before the name can be wrapped.  The
+// transaction is sent from the registrant of a name directly under 'eth',
+// otherwise from the owner of the name.  If the name wrapper is already
+// approved then this returns no transaction
+func (m *Manager) ApproveNameWrapper(ctx context.Context, name string, opts *TxOpts) (*types.Transaction, error) {
+	nameWrapper, err := m.NameWrapper(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, approver, err := m.wrapSource(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	contract := m.bound(approver, approvalABI)
+	var approved bool
+	if err = contract.Call(&bind.CallOpts{Context: ctx}, &approved, "isApprovedForAll", from, nameWrapper); err != nil {
+		return nil, err
+	}
+	if approved {
+		return nil, nil
+	}
+	transactOpts, err := m.transactOpts(ctx, from, opts)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := contract.Transact(transactOpts, "setApprovalForAll", nameWrapper, true)
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// Wrap wraps a name in the name wrapper, with the given owner and resolver.
+// If the owner is zero then the name is wrapped to the address that wraps
+// it.  The name wrapper must have been approved with ApproveNameWrapper
+func (m *Manager) Wrap(ctx context.Context, name string, owner common.Address, resolver common.Address, opts *TxOpts) (*types.Transaction, error) {
+	if wrapped, err := m.Wrapped(ctx, name); err != nil {
+		return nil, err
+	} else if wrapped != nil {
+		return nil, fmt.Errorf("%s is already wrapped", name)
+	}
+	nameWrapper, err := m.NameWrapper(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, _, err := m.wrapSource(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if owner == ens.UnknownAddress {
+		owner = from
+	}
+	transactOpts, err := m.transactOpts(ctx, from, opts)
+	if err != nil {
+		return nil, err
+	}
+	contract := m.NameWrapperContract(nameWrapper).contract
+	var tx *types.Transaction
+	if IsETH2LD(name) {
+		nameLabel, _ := label(name)
+		tx, err = contract.Transact(transactOpts, "wrapETH2LD", nameLabel, owner, uint16(0), resolver)
+	} else {
+		var encoded []byte
+		encoded, err = dnsEncode(name)
+		if err != nil {
+			return nil, err
+		}
+		tx, err = contract.Transact(transactOpts, "wrap", encoded, owner, resolver)
+	}
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// Unwrap unwraps a name from the name wrapper, leaving it with the given
+// owner; for names directly under 'eth' this is both the registrant and the
+// owner in the registry.  If the owner is zero then the owner of the wrapped
+// name is used.  The transaction is sent from the owner of the wrapped name
+func (m *Manager) Unwrap(ctx context.Context, name string, owner common.Address, opts *TxOpts) (*types.Transaction, error) {
+	wrapped, err := m.wrappedOwner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkFuse(name, wrapped, CannotUnwrap); err != nil {
+		return nil, err
+	}
+	if owner == ens.UnknownAddress {
+		owner = wrapped.Owner
+	}
+	nameLabel, parent, err := splitName(name)
+	if err != nil {
+		return nil, err
+	}
+	transactOpts, err := m.transactOpts(ctx, wrapped.Owner, opts)
+	if err != nil {
+		return nil, err
+	}
+	contract := m.NameWrapperContract(wrapped.NameWrapper).contract
+	var tx *types.Transaction
+	if IsETH2LD(name) {
+		tx, err = contract.Transact(transactOpts, "unwrapETH2LD", ens.LabelHash(nameLabel), owner, owner)
+	} else {
+		tx, err = contract.Transact(transactOpts, "unwrap", ens.NameHash(parent), ens.LabelHash(nameLabel), owner)
+	}
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// BurnFuses burns fuses of a wrapped name.  Owner-controlled fuses are
+// burned by the owner of the name, and parent-controlled fuses by the owner
+// of its parent, which must also be wrapped; the two cannot be burned
+// together.  Burned fuses cannot be restored until the name's wrapper expiry
+func (m *Manager) BurnFuses(ctx context.Context, name string, fuses uint32, opts *TxOpts) (*types.Transaction, error) {
+	if fuses == 0 {
+		return nil, errors.New("no fuses to burn")
+	}
+	if fuses&OwnerControlledFuses != 0 && fuses&ParentControlledFuses != 0 {
+		return nil, errors.New("owner-controlled and parent-controlled fuses must be burned separately")
+	}
+	wrapped, err := m.wrappedOwner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkFuse(name, wrapped, CannotBurnFuses); err != nil {
+		return nil, err
+	}
+	nameLabel, parent, err := splitName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if fuses&ParentControlledFuses != 0 {
+		parentWrapped, err := m.wrappedOwner(ctx, parent)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", parent, err)
+		}
+		transactOpts, err := m.transactOpts(ctx, parentWrapped.Owner, opts)
+		if err != nil {
+			return nil, err
+		}
+		// The wrapper keeps the later of the current and supplied expiry
+		tx, err := m.NameWrapperContract(parentWrapped.NameWrapper).contract.Transact(transactOpts, "setChildFuses", ens.NameHash(parent), ens.LabelHash(nameLabel), fuses, uint64(0))
+		if err != nil {
+			return nil, err
+		}
+		opts.sent(transactOpts.From, tx)
+		return tx, nil
+	}
+
+	// The name wrapper only allows owner-controlled fuses to be burned once
+	// the name cannot be unwrapped, and that only once its parent has given
+	// up control
+	burned := wrapped.Fuses | fuses
+	if burned&ParentCannotControl == 0 {
+		return nil, fmt.Errorf("%s does not have PARENT_CANNOT_CONTROL burned by its parent", name)
+	}
+	if fuses&^CannotUnwrap != 0 && burned&CannotUnwrap == 0 {
+		return nil, fmt.Errorf("CANNOT_UNWRAP must be burned along with or before other fuses")
+	}
+	transactOpts, err := m.transactOpts(ctx, wrapped.Owner, opts)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := m.NameWrapperContract(wrapped.NameWrapper).contract.Transact(transactOpts, "setFuses", ens.NameHash(name), uint16(fuses))
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// SetResolver sets the resolver of a name, with the name wrapper if the name
+// is wrapped.  The transaction is sent from the owner of the name
+func (m *Manager) SetResolver(ctx context.Context, name string, resolver common.Address, opts *TxOpts) (*types.Transaction, error) {
+	owner, err := m.Owner(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := m.wrapped(ctx, name, owner)
+	if err != nil {
+		return nil, err
+	}
+	if wrapped != nil {
+		if err = checkFuse(name, wrapped, CannotSetResolver); err != nil {
+			return nil, err
+		}
+		owner = wrapped.Owner
+	}
+	if owner == ens.UnknownAddress {
+		return nil, ErrNoOwner
+	}
+	transactOpts, err := m.transactOpts(ctx, owner, opts)
+	if err != nil {
+		return nil, err
+	}
+	var tx *types.Transaction
+	if wrapped != nil {
+		tx, err = m.NameWrapperContract(wrapped.NameWrapper).SetResolver(transactOpts, ens.NameHash(name), resolver)
+	} else {
+		tx, err = m.registry.SetResolver(transactOpts, ens.NameHash(name), resolver)
+	}
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}
+
+// SetSubdomainOwner sets the owner of a subdomain, with the name wrapper if
+// its parent is wrapped.  The transaction is sent from the owner of the
+// parent
+func (m *Manager) SetSubdomainOwner(ctx context.Context, name string, owner common.Address, opts *TxOpts) (*types.Transaction, error) {
+	nameLabel, parent, err := splitName(name)
+	if err != nil {
+		return nil, err
+	}
+	parentOwner, err := m.Owner(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := m.wrapped(ctx, parent, parentOwner)
+	if err != nil {
+		return nil, err
+	}
+	if wrapped != nil {
+		parentOwner = wrapped.Owner
+	}
+	if parentOwner == ens.UnknownAddress {
+		return nil, ErrNoOwner
+	}
+	transactOpts, err := m.transactOpts(ctx, parentOwner, opts)
+	if err != nil {
+		return nil, err
+	}
+	var tx *types.Transaction
+	if wrapped != nil {
+		tx, err = m.NameWrapperContract(wrapped.NameWrapper).SetSubnodeOwner(transactOpts, ens.NameHash(parent), nameLabel, owner)
+	} else {
+		tx, err = m.registry.SetSubnodeOwner(transactOpts, ens.NameHash(parent), ens.LabelHash(nameLabel), owner)
+	}
+	if err != nil {
+		return nil, err
+	}
+	opts.sent(transactOpts.From, tx)
+	return tx, nil
+}